@@ -0,0 +1,193 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package cache
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DiskLimits describes limits applied to a kind of entry saved by Disk.
+type DiskLimits struct {
+	// TTL is the maximum age of entries. Older entries are treated as missing.
+	// If zero, entries do not expire.
+	TTL time.Duration
+	// MaxEntries is the maximum number of entries to save.
+	// The oldest entries are deleted when the limit is exceeded.
+	// If zero, the number of entries is unlimited.
+	MaxEntries int
+}
+
+// Disk implements Store by saving each value to a file within a directory.
+// It can be used concurrently from multiple goroutines, and multiple processes
+// can share the same directory.
+type Disk struct {
+	dir    string                // base directory containing a subdirectory per kind
+	limits map[string]DiskLimits // keyed by kind
+	mu     sync.Mutex            // serializes pruning
+	now    func() time.Time      // called to get current time
+}
+
+// NewDisk returns a new Disk that saves values within dir, which is created if needed.
+// limits contains per-kind limits; kinds that aren't present in limits are unlimited.
+func NewDisk(dir string, limits map[string]DiskLimits) (*Disk, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &Disk{dir: dir, limits: limits, now: time.Now}, nil
+}
+
+const diskTempPrefix = ".tmp-" // prefix for partially-written files
+
+// diskKindRegexp matches valid kind names.
+var diskKindRegexp = regexp.MustCompile(`^[-_a-z0-9]+$`)
+
+// diskNameRegexp matches the names of files written by Disk.
+var diskNameRegexp = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// path returns the path of the file used to save key within kind.
+func (d *Disk) path(kind, key string) (string, error) {
+	if !diskKindRegexp.MatchString(kind) {
+		return "", fmt.Errorf("invalid kind %q", kind)
+	}
+	sum := sha1.Sum([]byte(key))
+	return filepath.Join(d.dir, kind, hex.EncodeToString(sum[:])), nil
+}
+
+// Get implements Store.
+func (d *Disk) Get(kind, key string) (val []byte, ok bool, err error) {
+	p, err := d.path(kind, key)
+	if err != nil {
+		return nil, false, err
+	}
+	fi, err := os.Stat(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	if ttl := d.limits[kind].TTL; ttl > 0 && d.now().Sub(fi.ModTime()) > ttl {
+		if err := os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, false, err
+		}
+		return nil, false, nil
+	}
+	val, err = os.ReadFile(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, false, nil // deleted by someone else
+	} else if err != nil {
+		return nil, false, err
+	}
+	return val, true, nil
+}
+
+// Set implements Store.
+func (d *Disk) Set(kind, key string, val []byte) error {
+	p, err := d.path(kind, key)
+	if err != nil {
+		return err
+	}
+	kdir := filepath.Dir(p)
+	if err := os.MkdirAll(kdir, 0755); err != nil {
+		return err
+	}
+
+	// Write to a temp file and then rename it so readers never see partial data.
+	f, err := os.CreateTemp(kdir, diskTempPrefix+"*")
+	if err != nil {
+		return err
+	}
+	_, werr := f.Write(val)
+	if cerr := f.Close(); werr == nil {
+		werr = cerr
+	}
+	if werr == nil {
+		now := d.now()
+		werr = os.Chtimes(f.Name(), now, now)
+	}
+	if werr == nil {
+		werr = os.Rename(f.Name(), p)
+	}
+	if werr != nil {
+		os.Remove(f.Name())
+		return werr
+	}
+
+	if max := d.limits[kind].MaxEntries; max > 0 {
+		return d.prune(kdir, max)
+	}
+	return nil
+}
+
+// prune deletes the oldest files in kdir until at most max remain.
+func (d *Disk) prune(kdir string, max int) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	ents, err := os.ReadDir(kdir)
+	if err != nil {
+		return err
+	}
+	type file struct {
+		name  string
+		mtime time.Time
+	}
+	files := make([]file, 0, len(ents))
+	for _, ent := range ents {
+		if !diskNameRegexp.MatchString(ent.Name()) {
+			continue
+		}
+		if fi, err := ent.Info(); err == nil {
+			files = append(files, file{ent.Name(), fi.ModTime()})
+		}
+	}
+	if len(files) <= max {
+		return nil
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].mtime.Before(files[j].mtime) })
+	for _, f := range files[:len(files)-max] {
+		if err := os.Remove(filepath.Join(kdir, f.name)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Clear implements Store by removing all files written by d.
+// Other files in d's directory are left alone.
+func (d *Disk) Clear() error {
+	ents, err := os.ReadDir(d.dir)
+	if err != nil {
+		return err
+	}
+	for _, ent := range ents {
+		if !ent.IsDir() || !diskKindRegexp.MatchString(ent.Name()) {
+			continue
+		}
+		kdir := filepath.Join(d.dir, ent.Name())
+		files, err := os.ReadDir(kdir)
+		if err != nil {
+			return err
+		}
+		for _, f := range files {
+			if n := f.Name(); diskNameRegexp.MatchString(n) || strings.HasPrefix(n, diskTempPrefix) {
+				if err := os.Remove(filepath.Join(kdir, n)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+					return err
+				}
+			}
+		}
+		os.Remove(kdir) // fails if other files are present
+	}
+	return nil
+}
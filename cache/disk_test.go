@@ -0,0 +1,107 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestDisk(t *testing.T) {
+	const (
+		kind1 = "kind1" // expiring
+		kind2 = "kind2" // size-limited
+		kind3 = "kind3" // unlimited
+	)
+	dir := t.TempDir()
+	d, err := NewDisk(dir, map[string]DiskLimits{
+		kind1: {TTL: time.Hour},
+		kind2: {MaxEntries: 2},
+	})
+	if err != nil {
+		t.Fatal("NewDisk failed: ", err)
+	}
+	now := time.Unix(1000000, 0)
+	d.now = func() time.Time { return now }
+
+	// ln returns the caller's caller's line number.
+	ln := func(skip int) int {
+		_, _, line, _ := runtime.Caller(skip + 1)
+		return line
+	}
+	set := func(kind, key, val string) {
+		if err := d.Set(kind, key, []byte(val)); err != nil {
+			t.Errorf("L%d: Set(%q, %q, %q) failed: %v", ln(1), kind, key, val, err)
+		}
+	}
+	get := func(kind, key, wantVal string, wantOK bool) {
+		if val, ok, err := d.Get(kind, key); err != nil {
+			t.Errorf("L%d: Get(%q, %q) failed: %v", ln(1), kind, key, err)
+		} else if string(val) != wantVal || ok != wantOK {
+			t.Errorf("L%d: Get(%q, %q) = %q, %v; want %q, %v", ln(1), kind, key, val, ok, wantVal, wantOK)
+		}
+	}
+
+	// Set and update a key.
+	get(kind1, "a", "", false)
+	set(kind1, "a", "foo")
+	get(kind1, "a", "foo", true)
+	set(kind1, "a", "bar")
+	get(kind1, "a", "bar", true)
+
+	// Keys are scoped to kinds.
+	get(kind3, "a", "", false)
+	set(kind3, "a", "baz")
+	get(kind3, "a", "baz", true)
+	get(kind1, "a", "bar", true)
+
+	// Values should be visible to other objects using the same directory.
+	d2, err := NewDisk(dir, nil)
+	if err != nil {
+		t.Fatal("NewDisk failed: ", err)
+	}
+	if val, ok, err := d2.Get(kind1, "a"); err != nil || !ok || string(val) != "bar" {
+		t.Errorf("Get(%q, %q) on second object = %q, %v, %v; want %q, true, nil", kind1, "a", val, ok, err, "bar")
+	}
+
+	// Entries should expire after the kind's TTL.
+	now = now.Add(time.Hour)
+	get(kind1, "a", "bar", true)
+	get(kind3, "a", "baz", true)
+	now = now.Add(time.Second)
+	get(kind1, "a", "", false)
+	get(kind3, "a", "baz", true)
+
+	// The oldest entries should be deleted when the max is exceeded.
+	set(kind2, "a", "1")
+	now = now.Add(time.Second)
+	set(kind2, "b", "2")
+	now = now.Add(time.Second)
+	set(kind2, "c", "3")
+	get(kind2, "a", "", false)
+	get(kind2, "b", "2", true)
+	get(kind2, "c", "3", true)
+
+	// Invalid kinds should be rejected.
+	if err := d.Set("../bad", "a", []byte("foo")); err == nil {
+		t.Error("Set with invalid kind unexpectedly succeeded")
+	}
+
+	// Clear should remove all entries but leave unrelated files alone.
+	other := filepath.Join(dir, "other.txt")
+	if err := os.WriteFile(other, []byte("hi"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Clear(); err != nil {
+		t.Fatal("Clear failed: ", err)
+	}
+	get(kind2, "b", "", false)
+	get(kind3, "a", "", false)
+	if _, err := os.Stat(other); err != nil {
+		t.Error("Unrelated file was removed: ", err)
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package cache
+
+// Store persistently saves serialized values so they can be reused across runs.
+// Values are grouped by kind (e.g. "database-ids"), and keys are only unique within a kind.
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// Get returns the value saved for key within kind.
+	// If the key isn't present or its entry has expired, nil and false are returned.
+	Get(kind, key string) (val []byte, ok bool, err error)
+	// Set saves val for key within kind, replacing any existing value.
+	Set(kind, key string, val []byte) error
+	// Clear removes all saved values.
+	Clear() error
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/derat/yambs/mbdb"
+	"github.com/derat/yambs/seed"
+	"github.com/derat/yambs/sources/musicbrainz"
+)
+
+// checkDuplicates searches for existing releases that appear to be the same as the
+// new releases in edits and describes them to w. The returned map contains warnings
+// for each edit that should be displayed alongside the edit.
+//
+// If editDup is true and exactly one duplicate is found for an edit, the edit is
+// converted into an edit of the existing release, but only if musicbrainz.Diff is
+// able to limit it to changed fields. Otherwise, the edit is omitted from the returned
+// slice so that the existing release's data won't be overwritten.
+func checkDuplicates(ctx context.Context, db *mbdb.DB, edits []seed.Edit, serverURL string,
+	editDup bool, w io.Writer) ([]seed.Edit, map[seed.Edit][]string) {
+	kept := make([]seed.Edit, 0, len(edits))
+	warnings := make(map[seed.Edit][]string)
+	for _, ed := range edits {
+		dups, err := musicbrainz.FindDuplicates(ctx, db, ed)
+		if err != nil {
+			fmt.Fprintf(w, "Failed checking %s for duplicates: %v\n", ed.Description(), err)
+			kept = append(kept, ed)
+			continue
+		}
+		if len(dups) == 1 && editDup {
+			desc := ed.Description()
+			rel := ed.(*seed.Release)
+			rel.MBID = dups[0].MBID
+			if _, err := musicbrainz.Diff(ctx, db, ed); err != nil {
+				rel.MBID = ""
+				fmt.Fprintf(w, "Skipping %s: can't limit edit of existing release %s to changed fields (%v)\n",
+					desc, dups[0].Describe(serverURL), err)
+				continue
+			}
+			msg := "Editing existing release " + dups[0].Describe(serverURL)
+			fmt.Fprintf(w, "Warning: %s: %s\n", ed.Description(), msg)
+			warnings[ed] = append(warnings[ed], msg)
+			kept = append(kept, ed)
+			continue
+		}
+		for _, d := range dups {
+			msg := "Possible duplicate of " + d.Describe(serverURL)
+			fmt.Fprintf(w, "Warning: %s: %s\n", ed.Description(), msg)
+			warnings[ed] = append(warnings[ed], msg)
+		}
+		kept = append(kept, ed)
+	}
+	return kept, warnings
+}
@@ -0,0 +1,66 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/derat/yambs/mbdb/mbdbtest"
+	"github.com/derat/yambs/seed"
+)
+
+func TestCheckDuplicates(t *testing.T) {
+	const (
+		srvURL  = "https://musicbrainz.org"
+		dupMBID = "7f4c5b9e-0d0e-4ae5-9b0a-fb6f6c4a0e7a"
+		linkURL = "https://tidal.com/album/1234"
+	)
+	srv := mbdbtest.NewServer(t)
+	srv.AddURLRel(linkURL, "release", dupMBID, "Album")
+	db := srv.NewDB()
+	ctx := context.Background()
+
+	newEdits := func() (dup, other *seed.Release) {
+		dup = &seed.Release{
+			Title: "Album",
+			URLs:  []seed.URL{{URL: linkURL, LinkType: seed.LinkType_Streaming_Release_URL}},
+		}
+		return dup, &seed.Release{Title: "Other"}
+	}
+
+	// Without editDup, duplicates should just be reported.
+	dup, other := newEdits()
+	var b bytes.Buffer
+	edits, warnings := checkDuplicates(ctx, db, []seed.Edit{dup, other}, srvURL, false, &b)
+	if len(edits) != 2 {
+		t.Errorf("checkDuplicates without editDup returned %d edit(s); want 2", len(edits))
+	}
+	if len(warnings[dup]) != 1 || !strings.Contains(warnings[dup][0], dupMBID) {
+		t.Errorf("checkDuplicates without editDup returned warnings %q for duplicate", warnings[dup])
+	}
+	if len(warnings[other]) != 0 {
+		t.Errorf("checkDuplicates without editDup returned warnings %q for other", warnings[other])
+	}
+
+	// With editDup, the duplicate should be skipped rather than seeding all of
+	// its fields into the existing release's edit form.
+	dup, other = newEdits()
+	b.Reset()
+	edits, warnings = checkDuplicates(ctx, db, []seed.Edit{dup, other}, srvURL, true, &b)
+	if len(edits) != 1 || edits[0] != other {
+		t.Errorf("checkDuplicates with editDup returned %v; want only other edit", edits)
+	}
+	if dup.MBID != "" {
+		t.Errorf("checkDuplicates with editDup left MBID %q in skipped edit", dup.MBID)
+	}
+	if len(warnings) != 0 {
+		t.Errorf("checkDuplicates with editDup returned warnings %q", warnings)
+	}
+	if !strings.Contains(b.String(), "Skipping") {
+		t.Errorf("checkDuplicates with editDup wrote %q; want skip message", b.String())
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/derat/yambs/mbdb"
+	"github.com/derat/yambs/seed"
+	"github.com/derat/yambs/sources/musicbrainz"
+	"github.com/derat/yambs/sources/text"
+)
+
+// exportEntities fetches the existing entities described by args (MusicBrainz URLs or MBIDs)
+// and writes them to w in the supplied format. If args is empty, whitespace-separated
+// URLs or MBIDs are read from r instead. typ is used for bare MBIDs.
+func exportEntities(ctx context.Context, w io.Writer, args []string, r io.Reader,
+	typ seed.Entity, format text.Format, db *mbdb.DB) error {
+	if len(args) == 0 {
+		sc := bufio.NewScanner(r)
+		sc.Split(bufio.ScanWords)
+		for sc.Scan() {
+			args = append(args, sc.Text())
+		}
+		if err := sc.Err(); err != nil {
+			return err
+		}
+	}
+	if len(args) == 0 {
+		return errors.New("no entities supplied")
+	}
+
+	var edits []seed.Edit
+	for _, arg := range args {
+		t, mbid, ok := musicbrainz.ParseURL(arg)
+		if !ok {
+			if !mbdb.IsMBID(arg) {
+				return fmt.Errorf("%q isn't a MusicBrainz URL or MBID", arg)
+			} else if typ == "" {
+				return errors.New("must specify entity type via -type for MBIDs")
+			}
+			t, mbid = typ, strings.ToLower(arg)
+		}
+		ed, err := musicbrainz.Fetch(ctx, db, t, mbid)
+		if err != nil {
+			return err
+		}
+		edits = append(edits, ed)
+	}
+
+	fields, err := text.Write(w, edits, format)
+	if err != nil {
+		return err
+	}
+	// Tell the user how to read the edits back in.
+	if format != text.KeyVal {
+		fmt.Fprintln(os.Stderr, "Fields (read with -skip-empty):", strings.Join(fields, ","))
+	}
+	return nil
+}
@@ -5,24 +5,27 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
 	"log"
-	"net/http"
-	"net/url"
 	"os"
 	"os/exec"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/derat/yambs/mbdb"
 	"github.com/derat/yambs/render"
 	"github.com/derat/yambs/seed"
 	"github.com/derat/yambs/sources/mp3"
+	"github.com/derat/yambs/sources/musicbrainz"
 	"github.com/derat/yambs/sources/online"
 	"github.com/derat/yambs/sources/text"
 	"github.com/derat/yambs/web"
@@ -31,16 +34,21 @@ import (
 var version = "[non-release]"
 
 const (
-	actionOpen  = "open"  // open the page from a temp file
-	actionPrint = "print" // print URLs
-	actionServe = "serve" // open the page from a local HTTP server
-	actionWrite = "write" // write the page to stdout
+	actionExport = "export" // export existing entities as text
+	actionJSON   = "json"   // write edits' URLs and params as a JSON array to stdout
+	actionNDJSON = "ndjson" // write edits' URLs and params as newline-delimited JSON to stdout
+	actionOpen   = "open"   // open the page from a temp file
+	actionPrint  = "print"  // print URLs (file: URLs of self-submitting pages for POST edits)
+	actionSave   = "save"   // write edits as JSON to stdout
+	actionServe  = "serve"  // open the page from a local HTTP server
+	actionSubmit = "submit" // submit API-only data (e.g. ISRCs) after confirmation
+	actionWrite  = "write"  // write the page to stdout
 )
 
 func main() {
 	action := enumFlag{
 		val:     defaultAction(),
-		allowed: []string{actionOpen, actionPrint, actionServe, actionWrite},
+		allowed: []string{actionExport, actionJSON, actionNDJSON, actionOpen, actionPrint, actionSave, actionServe, actionSubmit, actionWrite},
 	}
 	var entity enumFlag // empty default
 	for _, t := range seed.EntityTypes {
@@ -54,20 +62,38 @@ func main() {
 
 	flag.Usage = func() {
 		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %v [flag]... <FILE/URL>\n"+
-			"Seeds MusicBrainz edits.\n\n", os.Args[0])
+			"Seeds MusicBrainz edits.\n\n"+
+			"JSON written by -action=save can be supplied (as a file or via stdin) to reload edits.\n"+
+			"With -action=json or -action=ndjson, each edit's type, description,\n"+
+			"method, URL, parameters, and data are written as JSON.\n"+
+			"With -action=export, existing entities are instead written in -format.\n"+
+			"With -action=submit, data that can't be seeded (ISRCs for existing recordings\n"+
+			"and barcodes, tags, and ratings for existing entities) is submitted via the\n"+
+			"API after confirmation. Supply -username\n"+
+			"and $"+passwordEnv+", or $"+tokenEnv+" containing an OAuth2 access token.\n"+
+			"Supply MusicBrainz URLs or MBIDs (with -type) as arguments or via stdin.\n\n",
+			os.Args[0])
 		flag.PrintDefaults()
 	}
 	flag.Var(&action, "action", fmt.Sprintf("Action to perform with seed URLs (%v)", action.allowedList()))
 	addr := flag.String("addr", "localhost:8999", `Address to listen on for -action=serve`)
+	cacheDir := flag.String("cache-dir", "", "Directory for caching MusicBrainz lookups across runs")
+	checkDups := flag.Bool("check-duplicates", false, "Search for existing releases with the same URLs, barcode, or catalog number")
+	clearCache := flag.Bool("clear-cache", false, "Clear -cache-dir and exit")
 	country := flag.String("country", "", `Country code for querying Tidal API (ISO 3166, e.g. "US" or "DE"; "XW" for all)`)
+	diff := flag.Bool("diff", false, "Only seed fields that differ from existing entities' current data")
+	dumpDir := flag.String("dump-dir", "", "Directory containing MusicBrainz JSON dump files to use instead of the API")
+	editDup := flag.Bool("edit-duplicate", false, "Edit the existing release if exactly one duplicate is found (skips the edit if changed fields can't be determined)")
 	extractTrackArtists := flag.Bool("extract-track-artists", false, `Extract artist names from track titles in Bandcamp pages`)
 	fields := flag.String("fields", "", `Comma-separated fields for CSV/TSV columns (e.g. "artist,name,length")`)
-	flag.Var(&format, "format", fmt.Sprintf("Format for text input (%v)", format.allowedList()))
+	flag.Var(&format, "format", fmt.Sprintf("Format for text input or exported entities (%v)", format.allowedList()))
 	listFields := flag.Bool("list-fields", false, "Print available fields for -type and exit")
 	server := flag.String("server", "musicbrainz.org", "MusicBrainz server hostname")
 	flag.Var(&setCmds, "set", `Set a field for all entities (e.g. "edit_note=from https://www.example.org")`)
+	skipEmpty := flag.Bool("skip-empty", false, "Ignore empty CSV/TSV values (needed for -action=export output)")
 	timeout := flag.Duration("timeout", 0, `Timeout for generating edits (e.g. "30s" or "2m")`)
-	flag.Var(&entity, "type", fmt.Sprintf("Entity type for text or MP3 input (%v)", entity.allowedList()))
+	flag.Var(&entity, "type", fmt.Sprintf("Entity type for text or MP3 input or exported MBIDs (%v)", entity.allowedList()))
+	username := flag.String("username", "", "MusicBrainz username for -action=submit (password is read from $"+passwordEnv+")")
 	verbose := flag.Bool("verbose", false, "Enable verbose logging")
 	printVersion := flag.Bool("version", false, "Print the version and exit")
 	flag.Parse()
@@ -102,6 +128,54 @@ func main() {
 			log.SetOutput(io.Discard)
 		}
 
+		dbOpts := []mbdb.Option{mbdb.ServerURL("https://" + *server), mbdb.Version(version)}
+		if *cacheDir != "" {
+			store, err := mbdb.NewDiskStore(*cacheDir)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "Failed opening cache:", err)
+				return 1
+			}
+			if *clearCache {
+				if err := store.Clear(); err != nil {
+					fmt.Fprintln(os.Stderr, "Failed clearing cache:", err)
+					return 1
+				}
+				return 0
+			}
+			dbOpts = append(dbOpts, mbdb.Store(store))
+		} else if *clearCache {
+			fmt.Fprintln(os.Stderr, "Must specify cache directory via -cache-dir")
+			return 2
+		}
+		if *dumpDir != "" {
+			dump, err := mbdb.LoadDump(*dumpDir)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "Failed loading dump:", err)
+				return 1
+			}
+			dbOpts = append(dbOpts, mbdb.UseDump(dump))
+		}
+		if tok := os.Getenv(tokenEnv); tok != "" {
+			dbOpts = append(dbOpts, mbdb.AccessToken(tok))
+		} else if *username != "" {
+			dbOpts = append(dbOpts, mbdb.Login(*username, os.Getenv(passwordEnv)))
+		} else if action.val == actionSubmit {
+			fmt.Fprintln(os.Stderr, "Must specify -username or $"+tokenEnv+" for submitting data")
+			return 2
+		}
+
+		if action.val == actionExport {
+			ctx, cancel := newContext(*timeout)
+			defer cancel()
+			db := mbdb.NewDB(dbOpts...)
+			if err := exportEntities(ctx, os.Stdout, flag.Args(), os.Stdin,
+				seed.Entity(entity.val), text.Format(format.val), db); err != nil {
+				fmt.Fprintln(os.Stderr, "Failed exporting entities:", err)
+				return 1
+			}
+			return 0
+		}
+
 		var r io.Reader
 		var srcURL string
 		switch flag.NArg() {
@@ -124,17 +198,11 @@ func main() {
 			return 2
 		}
 
-		var ctx context.Context
-		var cancel context.CancelFunc
-		if *timeout > 0 {
-			ctx, cancel = context.WithTimeout(context.Background(), *timeout)
-		} else {
-			ctx, cancel = context.WithCancel(context.Background())
-		}
+		ctx, cancel := newContext(*timeout)
 		defer cancel()
 
 		serverURL := "https://" + *server
-		db := mbdb.NewDB(mbdb.ServerURL(serverURL), mbdb.Version(version))
+		db := mbdb.NewDB(dbOpts...)
 		web.SetUserAgent(fmt.Sprintf("yambs/%s (+https://github.com/derat/yambs)", version))
 
 		var edits []seed.Edit
@@ -148,52 +216,108 @@ func main() {
 				fmt.Fprintln(os.Stderr, "Failed fetching page:", err)
 				return 1
 			}
-		} else {
+		} else if f, ok := r.(*os.File); !ok || !strings.HasSuffix(strings.ToLower(f.Name()), ".mp3") {
+			// JSON written by -action=save already contains the edits' types.
+			var err error
+			var saved bool
+			if saved, edits, r, err = readSavedEdits(r); err == nil && !saved && ok &&
+				strings.HasSuffix(strings.ToLower(f.Name()), ".json") {
+				err = errors.New("not written by -action=save")
+			}
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "Failed reading JSON edits:", err)
+				return 1
+			}
+		}
+		if srcURL == "" && edits == nil {
 			if entity.val == "" {
 				fmt.Fprintln(os.Stderr, "Must specify entity type via -type")
 				return 2
 			}
 			var err error
 			if f, ok := r.(*os.File); ok && strings.HasSuffix(strings.ToLower(f.Name()), ".mp3") {
-				if edits, err = mp3.ReadFile(f, seed.Entity(entity.val), setCmds); err != nil {
+				if edits, err = mp3.ReadFile(ctx, f, seed.Entity(entity.val), setCmds, db); err != nil {
 					fmt.Fprintln(os.Stderr, "Failed reading MP3 file:", err)
 					return 1
 				}
 			} else {
+				var opts []text.Option
+				if *skipEmpty {
+					opts = append(opts, text.SkipEmptyValues())
+				}
 				if edits, err = text.Read(ctx, r, text.Format(format.val), seed.Entity(entity.val),
-					strings.Split(*fields, ","), setCmds, db); err != nil {
+					strings.Split(*fields, ","), setCmds, db, opts...); err != nil {
 					fmt.Fprintln(os.Stderr, "Failed reading edits:", err)
 					return 1
 				}
 			}
 		}
 
+		if *diff {
+			for _, ed := range edits {
+				changes, err := musicbrainz.Diff(ctx, db, ed)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Failed diffing %s: %v\n", ed.Description(), err)
+					return 1
+				}
+				if changes != nil && len(changes) == 0 {
+					fmt.Fprintf(os.Stderr, "Warning: %s: no changes\n", ed.Description())
+				}
+			}
+		}
+
+		// Warn about possible problems, but don't refuse to continue,
+		// since the user can still fix the fields in the edit form.
+		for _, ed := range edits {
+			for _, p := range seed.Validate(ed) {
+				fmt.Fprintf(os.Stderr, "Warning: %s: %s\n", ed.Description(), p)
+			}
+		}
+
+		// Check whether new releases are already in the database.
+		var warnings map[seed.Edit][]string
+		if *checkDups || *editDup {
+			if edits, warnings = checkDuplicates(ctx, db, edits, serverURL, *editDup, os.Stderr); len(edits) == 0 {
+				fmt.Fprintln(os.Stderr, "No edits left after skipping duplicates")
+				return 1
+			}
+		}
+
 		opts := []render.Option{
 			render.ServerURL(serverURL),
 			render.Version(version), // not actually displayed
+			render.Warnings(warnings),
 		}
 
 		switch action.val {
+		case actionJSON, actionNDJSON:
+			if err := render.WriteJSON(os.Stdout, edits, action.val == actionNDJSON, opts...); err != nil {
+				fmt.Fprintln(os.Stderr, "Failed writing JSON:", err)
+				return 1
+			}
 		case actionOpen:
 			if err := render.OpenFile(edits, opts...); err != nil {
 				fmt.Fprintln(os.Stderr, "Failed opening page:", err)
 				return 1
 			}
 		case actionPrint:
+			// Edits that require POST requests (e.g. releases) are written to temp files
+			// containing self-submitting forms, and the files' URLs are printed.
 			for _, ed := range edits {
-				if ed.Method() != http.MethodGet {
-					fmt.Fprintf(os.Stderr, "Can't print bare URL; %s edit requires %s request\n",
-						ed.Entity(), ed.Method())
-					return 1
-				}
-				u, err := url.Parse(ed.URL(serverURL))
+				u, err := render.EditURL(ed, serverURL, "")
 				if err != nil {
-					fmt.Fprintln(os.Stderr, "Failed parsing URL:", err)
+					fmt.Fprintln(os.Stderr, "Failed getting URL:", err)
 					return 1
 				}
-				u.RawQuery = ed.Params().Encode()
-				fmt.Println(u.String())
+				fmt.Println(u)
+			}
+		case actionSave:
+			b, err := seed.MarshalEdits(edits)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "Failed marshaling edits:", err)
+				return 1
 			}
+			os.Stdout.Write(append(b, '\n'))
 		case actionServe:
 			// Don't use ctx here; it's just for generating the edits.
 			// The local HTTP server needs to continue running until the user opens them.
@@ -201,6 +325,23 @@ func main() {
 				fmt.Fprintln(os.Stderr, "Failed serving page:", err)
 				return 1
 			}
+		case actionSubmit:
+			// Read the confirmation from the terminal if stdin was used for input.
+			in := io.Reader(os.Stdin)
+			if flag.NArg() == 0 {
+				tty, err := os.Open("/dev/tty")
+				if err != nil {
+					fmt.Fprintln(os.Stderr, "Failed opening terminal for confirmation:", err)
+					return 1
+				}
+				defer tty.Close()
+				in = tty
+			}
+			// Don't use ctx here; the user may take a while to confirm the submission.
+			if err := submitData(context.Background(), db, edits, serverURL, in, os.Stderr); err != nil {
+				fmt.Fprintln(os.Stderr, "Failed submitting data:", err)
+				return 1
+			}
 		case actionWrite:
 			if err := render.Write(os.Stdout, edits, opts...); err != nil {
 				fmt.Fprintln(os.Stderr, "Failed writing page:", err)
@@ -212,6 +353,34 @@ func main() {
 	}())
 }
 
+// readSavedEdits checks whether r contains JSON edits written by -action=save.
+// If it does, saved is true and the unmarshaled edits are returned.
+// Otherwise, rest can be used to read r's original data.
+func readSavedEdits(r io.Reader) (saved bool, edits []seed.Edit, rest io.Reader, err error) {
+	br := bufio.NewReader(r)
+	start, _ := br.Peek(512)
+	if !bytes.HasPrefix(bytes.TrimSpace(start), []byte("{")) {
+		return false, nil, br, nil
+	}
+	b, err := io.ReadAll(br)
+	if err != nil {
+		return false, nil, nil, err
+	}
+	if !seed.IsMarshaledEdits(b) {
+		return false, nil, bytes.NewReader(b), nil
+	}
+	edits, err = seed.UnmarshalEdits(b)
+	return true, edits, nil, err
+}
+
+// newContext returns a context that is canceled after timeout (if positive).
+func newContext(timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout > 0 {
+		return context.WithTimeout(context.Background(), timeout)
+	}
+	return context.WithCancel(context.Background())
+}
+
 func defaultAction() string {
 	// If we're running in a Chrome OS Crostini container, the external Chrome process won't
 	// be able to access files that we write to /tmp, so start a web server instead.
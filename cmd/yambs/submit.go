@@ -0,0 +1,59 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/derat/yambs/mbdb"
+	"github.com/derat/yambs/seed"
+)
+
+const (
+	passwordEnv = "YAMBS_PASSWORD"     // environment variable containing password for -username
+	tokenEnv    = "YAMBS_ACCESS_TOKEN" // environment variable containing OAuth2 access token
+)
+
+// errNotConfirmed is returned by submitData if the user declines the submission.
+var errNotConfirmed = errors.New("not confirmed")
+
+// submitData collects data from edits that can only be submitted via the MusicBrainz API
+// (e.g. ISRCs for existing recordings), describes it to w, and submits it using db after
+// the user confirms by typing "y" into in.
+func submitData(ctx context.Context, db *mbdb.DB, edits []seed.Edit,
+	serverURL string, in io.Reader, w io.Writer) error {
+	sub, err := seed.GetSubmission(ctx, db, edits)
+	if err != nil {
+		return err
+	}
+	if sub.Empty() {
+		fmt.Fprintln(w, "Nothing to submit")
+		return nil
+	}
+	if err := sub.Validate(); err != nil {
+		return err
+	}
+
+	for _, ln := range sub.Lines(serverURL) {
+		fmt.Fprintln(w, ln)
+	}
+	fmt.Fprint(w, "Submit? [y/N] ")
+	ln, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return err
+	}
+	if resp := strings.ToLower(strings.TrimSpace(ln)); resp != "y" && resp != "yes" {
+		return errNotConfirmed
+	}
+	if err := sub.Submit(ctx, db); err != nil {
+		return err
+	}
+	fmt.Fprintln(w, "Submitted")
+	return nil
+}
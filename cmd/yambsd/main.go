@@ -25,6 +25,7 @@ import (
 	"github.com/derat/yambs/mbdb"
 	"github.com/derat/yambs/render"
 	"github.com/derat/yambs/seed"
+	"github.com/derat/yambs/sources/musicbrainz"
 	"github.com/derat/yambs/sources/online"
 	"github.com/derat/yambs/sources/online/tidal"
 	"github.com/derat/yambs/sources/text"
@@ -36,6 +37,7 @@ const (
 	maxReqBytes        = 128 * 1024
 	textEditsTimeout   = 10 * time.Second
 	onlineEditsTimeout = time.Minute // can take longer due to MB API rate limit
+	duplicatesTimeout  = 10 * time.Second
 	maxEdits           = 200
 	maxFields          = 1000
 
@@ -59,6 +61,8 @@ func main() {
 		flag.PrintDefaults()
 	}
 	addr := flag.String("addr", "localhost:8999", `Address to listen on for HTTP requests`)
+	cacheDir := flag.String("cache-dir", "", "Directory for caching MusicBrainz lookups across restarts")
+	checkDups := flag.Bool("check-duplicates", false, "Search for existing releases with the same URLs, barcode, or catalog number")
 	server := flag.String("server", "musicbrainz.org", "MusicBrainz server hostname")
 	flag.Parse()
 
@@ -70,7 +74,15 @@ func main() {
 	form := b.Bytes()
 
 	serverURL := "https://" + *server
-	db := mbdb.NewDB(mbdb.Version(version))
+	dbOpts := []mbdb.Option{mbdb.Version(version)}
+	if *cacheDir != "" {
+		store, err := mbdb.NewDiskStore(*cacheDir)
+		if err != nil {
+			log.Fatal("Failed opening cache: ", err)
+		}
+		dbOpts = append(dbOpts, mbdb.Store(store))
+	}
+	db := mbdb.NewDB(dbOpts...)
 	web.SetUserAgent(fmt.Sprintf("yambs/%s (+https://github.com/derat/yambs)", version))
 	rm := newRateMap(editsDelay, editsRateMapSize)
 
@@ -92,7 +104,7 @@ func main() {
 	// Generate edits requested via the form.
 	http.HandleFunc("/edits", func(w http.ResponseWriter, req *http.Request) {
 		caddr := clientAddr(req)
-		infos, err := getEditsForRequest(w, req, serverURL, rm, db)
+		infos, err := getEditsForRequest(w, req, serverURL, rm, db, *checkDups)
 		if err != nil {
 			var msg string
 			code := http.StatusInternalServerError
@@ -171,8 +183,9 @@ func httpErrorf(code int, format string, args ...interface{}) *httpError {
 }
 
 // getEditsForRequest generates render.EditInfo objects in response to an /edits request to the server.
+// If checkDups is true, new releases are also checked for duplicates (see addDuplicateProblems).
 func getEditsForRequest(w http.ResponseWriter, req *http.Request,
-	serverURL string, rm *rateMap, db *mbdb.DB) ([]*render.EditInfo, error) {
+	serverURL string, rm *rateMap, db *mbdb.DB, checkDups bool) ([]*render.EditInfo, error) {
 	if req.Method != http.MethodPost {
 		return nil, httpErrorf(http.StatusMethodNotAllowed, "bad method %q", req.Method)
 	}
@@ -268,7 +281,44 @@ func getEditsForRequest(w http.ResponseWriter, req *http.Request,
 	default:
 		return nil, httpErrorf(http.StatusBadRequest, "bad source %q", req.FormValue("source"))
 	}
-	return render.NewEditInfos(edits, serverURL)
+
+	infos, err := render.NewEditInfos(edits, serverURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if checkDups {
+		ctx, cancel := context.WithTimeout(req.Context(), duplicatesTimeout)
+		defer cancel()
+		addDuplicateProblems(ctx, db, edits, infos, serverURL)
+	}
+	return infos, nil
+}
+
+// addDuplicateProblems adds problems to infos describing existing releases that seem
+// to be the same as the new releases in the corresponding edits. If a check fails
+// (e.g. because ctx timed out), the edit and all remaining releases are reported as
+// unchecked rather than being silently skipped.
+func addDuplicateProblems(ctx context.Context, db *mbdb.DB, edits []seed.Edit,
+	infos []*render.EditInfo, serverURL string) {
+	var checkErr error
+	for i, ed := range edits {
+		if rel, ok := ed.(*seed.Release); !ok || rel.MBID != "" {
+			continue
+		}
+		if checkErr == nil {
+			dups, err := musicbrainz.FindDuplicates(ctx, db, ed)
+			if err == nil {
+				for _, d := range dups {
+					infos[i].Problems = append(infos[i].Problems, "Possible duplicate of "+d.Describe(serverURL))
+				}
+				continue
+			}
+			log.Printf("Failed checking %s for duplicates: %v", ed.Description(), err)
+			checkErr = err
+		}
+		infos[i].Problems = append(infos[i].Problems, fmt.Sprint("Not checked for duplicates: ", checkErr))
+	}
 }
 
 // countryCodeRegexp is used to validate the optional "country" query parameter.
@@ -0,0 +1,70 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/derat/yambs/mbdb/mbdbtest"
+	"github.com/derat/yambs/render"
+	"github.com/derat/yambs/seed"
+)
+
+func TestAddDuplicateProblems(t *testing.T) {
+	const (
+		srvURL  = "https://musicbrainz.org"
+		dupMBID = "7f4c5b9e-0d0e-4ae5-9b0a-fb6f6c4a0e7a"
+		linkURL = "https://tidal.com/album/1234"
+	)
+	srv := mbdbtest.NewServer(t)
+	srv.AddURLRel(linkURL, "release", dupMBID, "Album")
+	db := srv.NewDB()
+
+	edits := []seed.Edit{
+		&seed.Release{
+			Title: "Album",
+			URLs:  []seed.URL{{URL: linkURL, LinkType: seed.LinkType_Streaming_Release_URL}},
+		},
+		&seed.Recording{Name: "Recording"},
+		&seed.Release{Title: "Other"},
+	}
+	newInfos := func() []*render.EditInfo {
+		infos, err := render.NewEditInfos(edits, srvURL)
+		if err != nil {
+			t.Fatal("NewEditInfos failed: ", err)
+		}
+		return infos
+	}
+	problems := func(infos []*render.EditInfo) [][]string {
+		ps := make([][]string, len(infos))
+		for i, info := range infos {
+			ps[i] = info.Problems
+		}
+		return ps
+	}
+
+	infos := newInfos()
+	addDuplicateProblems(context.Background(), db, edits, infos, srvURL)
+	if ps := problems(infos); len(ps[0]) != 1 || !strings.Contains(ps[0][0], dupMBID) ||
+		len(ps[1]) != 0 || len(ps[2]) != 0 {
+		t.Errorf("addDuplicateProblems added %q", ps)
+	}
+
+	// If checks fail, all of the new releases should be reported as unchecked.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	infos = newInfos()
+	addDuplicateProblems(ctx, srv.NewDB(), edits, infos, srvURL) // new DB to avoid cached results
+	ps := problems(infos)
+	for _, i := range []int{0, 2} {
+		if len(ps[i]) != 1 || !strings.HasPrefix(ps[i][0], "Not checked for duplicates") {
+			t.Errorf("addDuplicateProblems with cancelled context added %q for edit %d", ps[i], i)
+		}
+	}
+	if len(ps[1]) != 0 {
+		t.Errorf("addDuplicateProblems with cancelled context added %q for recording", ps[1])
+	}
+}
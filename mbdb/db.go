@@ -12,12 +12,13 @@ import (
 	"fmt"
 	"io"
 	"log"
-	"net/http"
 	"net/url"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/derat/yambs/cache"
+	"github.com/derat/yambs/web"
 	"golang.org/x/time/rate"
 )
 
@@ -25,11 +26,19 @@ const (
 	// https://musicbrainz.org/doc/MusicBrainz_API/Rate_Limiting
 	maxQPS         = 1
 	rateBucketSize = 1
+	retryDelay     = 2 * time.Second  // initial delay before retrying failed queries
+	maxRetryDelay  = 32 * time.Second // maximum delay between retries
 	userAgentFmt   = "yambs/%s ( https://github.com/derat/yambs )"
 
-	// TODO: Should cache entries also expire after a certain amount of time?
-	cacheSize     = 256         // size for various caches
-	cacheMissTime = time.Minute // TTL for negative caches
+	cacheSize     = 256            // size for various in-memory caches
+	cacheMissTime = time.Minute    // TTL for in-memory negative caches
+	urlRelsTime   = 24 * time.Hour // TTL for URL relationships
+
+	// Kinds used for values saved in DB's cache.Store.
+	databaseIDsKind = "database-ids"
+	urlRelsKind     = "url-rels"
+	urlMissKind     = "url-misses"
+	isrcRecsKind    = "isrc-recordings"
 
 	defaultServerURL = "https://musicbrainz.org"
 )
@@ -38,21 +47,47 @@ const (
 type entityType string
 
 const (
-	artistType entityType = "artist"
-	labelType  entityType = "label"
+	artistType       entityType = "artist"
+	eventType        entityType = "event"
+	labelType        entityType = "label"
+	placeType        entityType = "place"
+	recordingType    entityType = "recording"
+	releaseType      entityType = "release"
+	releaseGroupType entityType = "release-group"
+	workType         entityType = "work"
 )
 
+// urlRelTypes contains the entity types that can be related to URLs.
+var urlRelTypes = []entityType{
+	artistType,
+	eventType,
+	labelType,
+	placeType,
+	recordingType,
+	releaseType,
+	releaseGroupType,
+	workType,
+}
+
 // DB queries the MusicBrainz database using its API.
 // See https://musicbrainz.org/doc/MusicBrainz_API.
 type DB struct {
 	databaseIDs *cache.LRU                // string MBID to int32 database ID
-	urlRels     map[entityType]*cache.LRU // string URL to []EntityInfo
+	urlRels     map[entityType]*cache.LRU // string URL to urlRelsEntry
 	urlMiss     map[entityType]*cache.LRU // string URL to time.Time of negative lookup
+	isrcRecs    *cache.LRU                // string ISRC to []RecordingInfo
+	store       cache.Store               // optional persistent cache
+	dump        *Dump                     // optional local data used instead of the API
 
-	limiter         *rate.Limiter    // rate-limits network requests
+	client          *web.Client      // sends network requests
+	maxQPS          rate.Limit       // max queries per second sent to serverURL
+	retryDelay      time.Duration    // initial delay before retrying failed queries
 	disallowQueries bool             // don't allow network traffic
 	serverURL       string           // base server URL without trailing slash
 	version         string           // included in User-Agent header
+	username        string           // for digest auth when submitting data
+	password        string           // for digest auth when submitting data
+	accessToken     string           // OAuth2 access token for submitting data
 	now             func() time.Time // called to get current time
 }
 
@@ -60,21 +95,31 @@ type DB struct {
 func NewDB(opts ...Option) *DB {
 	db := DB{
 		databaseIDs: cache.NewLRU(cacheSize),
-		urlRels: map[entityType]*cache.LRU{
-			artistType: cache.NewLRU(cacheSize),
-			labelType:  cache.NewLRU(cacheSize),
-		},
-		urlMiss: map[entityType]*cache.LRU{
-			artistType: cache.NewLRU(cacheSize),
-			labelType:  cache.NewLRU(cacheSize),
-		},
-		limiter:   rate.NewLimiter(maxQPS, rateBucketSize),
-		serverURL: defaultServerURL,
-		now:       time.Now,
+		urlRels:     make(map[entityType]*cache.LRU),
+		urlMiss:     make(map[entityType]*cache.LRU),
+		isrcRecs:    cache.NewLRU(cacheSize),
+		maxQPS:      maxQPS,
+		retryDelay:  retryDelay,
+		serverURL:   defaultServerURL,
+		now:         time.Now,
+	}
+	for _, t := range urlRelTypes {
+		db.urlRels[t] = cache.NewLRU(cacheSize)
+		db.urlMiss[t] = cache.NewLRU(cacheSize)
 	}
 	for _, o := range opts {
 		o(&db)
 	}
+
+	var host string
+	if u, err := url.Parse(db.serverURL); err == nil {
+		host = u.Host
+	}
+	db.client = web.NewClient(
+		web.HostRateLimit(host, db.maxQPS, rateBucketSize),
+		web.Backoff(db.retryDelay, maxRetryDelay),
+		web.UserAgent(fmt.Sprintf(userAgentFmt, db.version)),
+	)
 	return &db
 }
 
@@ -95,8 +140,32 @@ func Version(v string) Option { return func(db *DB) { db.version = v } }
 // NowFunc injects a function that is called instead of time.Now to get the current time.
 func NowFunc(fn func() time.Time) Option { return func(db *DB) { db.now = fn } }
 
+// Store returns an Option that configures DB to additionally save the results of
+// lookups in s so they can be reused later (possibly by other processes).
+// See NewDiskStore.
+func Store(s cache.Store) Option { return func(db *DB) { db.store = s } }
+
+// storeLimits contains limits for the different kinds of values saved in DB's cache.Store.
+var storeLimits = map[string]cache.DiskLimits{
+	databaseIDsKind: {TTL: 30 * 24 * time.Hour, MaxEntries: 10000},
+	urlRelsKind:     {TTL: urlRelsTime, MaxEntries: 5000},
+	urlMissKind:     {TTL: time.Hour, MaxEntries: 5000},
+	isrcRecsKind:    {TTL: urlRelsTime, MaxEntries: 5000},
+}
+
+// NewDiskStore returns a cache.Disk that saves values within dir, which is created if needed.
+// The returned object can be passed to Store.
+func NewDiskStore(dir string) (*cache.Disk, error) { return cache.NewDisk(dir, storeLimits) }
+
+// UseDump returns an Option that configures DB to perform lookups using d instead of
+// querying the server. Lookups of data that isn't present in d fail or return empty results.
+func UseDump(d *Dump) Option { return func(db *DB) { db.dump = d } }
+
 // MaxQPS overrides the default QPS limit for testing.
-func MaxQPS(qps int) Option { return func(db *DB) { db.limiter.SetLimit(rate.Limit(qps)) } }
+func MaxQPS(qps int) Option { return func(db *DB) { db.maxQPS = rate.Limit(qps) } }
+
+// RetryDelay overrides the initial delay before retrying failed queries for testing.
+func RetryDelay(d time.Duration) Option { return func(db *DB) { db.retryDelay = d } }
 
 // GetDatabaseID returns the database ID (e.g. artist.id) corresponding to
 // the entity with the specified MBID (e.g. artist.gid).
@@ -104,10 +173,18 @@ func (db *DB) GetDatabaseID(ctx context.Context, mbid string) (int32, error) {
 	if !IsMBID(mbid) {
 		return 0, errors.New("malformed MBID")
 	}
+	if db.dump != nil {
+		return db.dump.databaseID(mbid)
+	}
 
 	if id, ok := db.databaseIDs.Get(mbid); ok {
 		return id.(int32), nil
 	}
+	var id int32
+	if db.loadStored(databaseIDsKind, mbid, &id) {
+		db.databaseIDs.Set(mbid, id)
+		return id, nil
+	}
 
 	// Actually query the database. The /ws/js endpoints apparently exist
 	// for field completion rather than being part of the API (/ws/2).
@@ -129,6 +206,7 @@ func (db *DB) GetDatabaseID(ctx context.Context, mbid string) (int32, error) {
 	}
 	log.Print("Got database ID ", data.ID)
 	db.databaseIDs.Set(mbid, data.ID)
+	db.saveStored(databaseIDsKind, mbid, data.ID)
 	return data.ID, nil
 }
 
@@ -136,7 +214,8 @@ func (db *DB) GetDatabaseID(ctx context.Context, mbid string) (int32, error) {
 type EntityInfo struct {
 	// MBID contains the entity's UUID.
 	MBID string
-	// Name contains the entity's name as it appears in the database.
+	// Name contains the entity's name (or title, for e.g. releases and works)
+	// as it appears in the database.
 	Name string
 }
 
@@ -146,37 +225,177 @@ func (db *DB) GetArtistsFromURL(ctx context.Context, linkURL string) ([]EntityIn
 	return db.getURLRels(ctx, linkURL, artistType)
 }
 
+// GetArtistsFromURLs is a batched version of GetArtistsFromURL that looks up multiple URLs
+// using as few queries as possible. The returned map is keyed by the supplied URLs.
+func (db *DB) GetArtistsFromURLs(ctx context.Context, linkURLs []string) (map[string][]EntityInfo, error) {
+	return db.getURLRelsBatch(ctx, linkURLs, artistType)
+}
+
 // GetLabelsFromURL returns labels related to linkURL.
 // If no label is related to the URL, an empty slice is returned.
 func (db *DB) GetLabelsFromURL(ctx context.Context, linkURL string) ([]EntityInfo, error) {
 	return db.getURLRels(ctx, linkURL, labelType)
 }
 
+// GetLabelsFromURLs is a batched version of GetLabelsFromURL that looks up multiple URLs
+// using as few queries as possible. The returned map is keyed by the supplied URLs.
+func (db *DB) GetLabelsFromURLs(ctx context.Context, linkURLs []string) (map[string][]EntityInfo, error) {
+	return db.getURLRelsBatch(ctx, linkURLs, labelType)
+}
+
+// GetEventsFromURL returns events related to linkURL.
+// If no event is related to the URL, an empty slice is returned.
+func (db *DB) GetEventsFromURL(ctx context.Context, linkURL string) ([]EntityInfo, error) {
+	return db.getURLRels(ctx, linkURL, eventType)
+}
+
+// GetPlacesFromURL returns places related to linkURL.
+// If no place is related to the URL, an empty slice is returned.
+func (db *DB) GetPlacesFromURL(ctx context.Context, linkURL string) ([]EntityInfo, error) {
+	return db.getURLRels(ctx, linkURL, placeType)
+}
+
+// GetRecordingsFromURL returns recordings related to linkURL.
+// If no recording is related to the URL, an empty slice is returned.
+func (db *DB) GetRecordingsFromURL(ctx context.Context, linkURL string) ([]EntityInfo, error) {
+	return db.getURLRels(ctx, linkURL, recordingType)
+}
+
+// GetReleasesFromURL returns releases related to linkURL.
+// If no release is related to the URL, an empty slice is returned.
+func (db *DB) GetReleasesFromURL(ctx context.Context, linkURL string) ([]EntityInfo, error) {
+	return db.getURLRels(ctx, linkURL, releaseType)
+}
+
+// GetReleasesFromURLs is a batched version of GetReleasesFromURL that looks up multiple URLs
+// using as few queries as possible. The returned map is keyed by the supplied URLs.
+func (db *DB) GetReleasesFromURLs(ctx context.Context, linkURLs []string) (map[string][]EntityInfo, error) {
+	return db.getURLRelsBatch(ctx, linkURLs, releaseType)
+}
+
+// GetReleaseGroupsFromURL returns release groups related to linkURL.
+// If no release group is related to the URL, an empty slice is returned.
+func (db *DB) GetReleaseGroupsFromURL(ctx context.Context, linkURL string) ([]EntityInfo, error) {
+	return db.getURLRels(ctx, linkURL, releaseGroupType)
+}
+
+// GetWorksFromURL returns works related to linkURL.
+// If no work is related to the URL, an empty slice is returned.
+func (db *DB) GetWorksFromURL(ctx context.Context, linkURL string) ([]EntityInfo, error) {
+	return db.getURLRels(ctx, linkURL, workType)
+}
+
+// urlRelsEntry is stored in DB.urlRels.
+type urlRelsEntry struct {
+	infos   []EntityInfo
+	expires time.Time // zero if the entry doesn't expire
+}
+
+// maxURLsPerQuery is the maximum number of resource parameters to send in a single /ws/2/url query.
+const maxURLsPerQuery = 100
+
 // getURLRels returns entities of the specified type related to linkURL.
 func (db *DB) getURLRels(ctx context.Context, linkURL string, entity entityType) ([]EntityInfo, error) {
-	// Check the cache first.
-	cache := db.urlRels[entity]
-	if infos, ok := cache.Get(linkURL); ok {
-		return infos.([]EntityInfo), nil
+	res, err := db.getURLRelsBatch(ctx, []string{linkURL}, entity)
+	if err != nil {
+		return nil, err
 	}
+	return res[linkURL], nil
+}
 
-	// If we're being called from a test, just pretend like the URL is missing.
+// getURLRelsBatch returns entities of the specified type related to each of linkURLs.
+func (db *DB) getURLRelsBatch(ctx context.Context, linkURLs []string,
+	entity entityType) (map[string][]EntityInfo, error) {
+	if db.dump != nil {
+		return db.dump.urlRelsBatch(linkURLs, entity), nil
+	}
+
+	res := make(map[string][]EntityInfo, len(linkURLs))
+	var needed []string
+	for _, u := range linkURLs {
+		if _, ok := res[u]; ok {
+			continue // duplicate
+		}
+		infos, ok := db.getCachedURLRels(u, entity)
+		res[u] = infos
+		if !ok {
+			needed = append(needed, u)
+		}
+	}
+
+	// If we're being called from a test, just pretend like the URLs are missing.
 	if db.disallowQueries {
-		return nil, nil
+		return res, nil
+	}
+
+	for len(needed) > 0 {
+		n := len(needed)
+		if n > maxURLsPerQuery {
+			n = maxURLsPerQuery
+		}
+		found, err := db.queryURLRels(ctx, needed[:n], entity)
+		if err != nil {
+			return nil, err
+		}
+		for u, infos := range found {
+			res[u] = infos
+		}
+		needed = needed[n:]
+	}
+	return res, nil
+}
+
+// getCachedURLRels checks db's caches for entities of the specified type related to linkURL.
+// ok is true if the URL was found in a cache (possibly as a recent negative lookup).
+func (db *DB) getCachedURLRels(linkURL string, entity entityType) (infos []EntityInfo, ok bool) {
+	cache := db.urlRels[entity]
+	if v, ok := cache.Get(linkURL); ok {
+		if ent := v.(urlRelsEntry); ent.expires.IsZero() || db.now().Before(ent.expires) {
+			return ent.infos, true
+		}
+	}
+	storeKey := urlRelsStoreKey(linkURL, entity)
+	if db.loadStored(urlRelsKind, storeKey, &infos) {
+		cache.Set(linkURL, urlRelsEntry{infos, db.now().Add(urlRelsTime)})
+		return infos, true
 	}
 
 	// Give up if we already checked recently.
 	missCache := db.urlMiss[entity]
 	if v, ok := missCache.Get(linkURL); ok && db.now().Sub(v.(time.Time)) <= cacheMissTime {
-		return nil, nil
+		return nil, true
+	}
+	var miss bool
+	if db.loadStored(urlMissKind, storeKey, &miss) {
+		missCache.Set(linkURL, db.now())
+		return nil, true
 	}
+	return nil, false
+}
 
-	log.Printf("Requesting %v relations for %v", entity, linkURL)
-	path := fmt.Sprintf("/ws/2/url?resource=%s&inc=%s-rels", url.QueryEscape(linkURL), entity)
-	r, err := db.doQuery(ctx, path)
+// urlRelsStoreKey returns the key used for linkURL in db.store.
+func urlRelsStoreKey(linkURL string, entity entityType) string { return string(entity) + " " + linkURL }
+
+// queryURLRels queries the server for entities of the specified type related to linkURLs
+// and saves the results to db's caches. The returned map is keyed by the supplied URLs.
+func (db *DB) queryURLRels(ctx context.Context, linkURLs []string,
+	entity entityType) (map[string][]EntityInfo, error) {
+	log.Printf("Requesting %v relations for %v", entity, strings.Join(linkURLs, " "))
+	var path strings.Builder
+	path.WriteString("/ws/2/url?")
+	for _, u := range linkURLs {
+		path.WriteString("resource=" + url.QueryEscape(u) + "&")
+	}
+	path.WriteString("inc=" + string(entity) + "-rels")
+
+	res := make(map[string][]EntityInfo, len(linkURLs))
+	r, err := db.doQuery(ctx, path.String())
 	if err == notFoundError {
-		missCache.Set(linkURL, db.now())
-		return nil, nil
+		for _, u := range linkURLs {
+			res[u] = nil
+			db.saveURLMiss(u, entity)
+		}
+		return res, nil
 	} else if err != nil {
 		return nil, err
 	}
@@ -200,24 +419,96 @@ func (db *DB) getURLRels(ctx context.Context, linkURL string, entity entityType)
 	//  	</relation-list>
 	//    </url>
 	//  </metadata>
+	//
+	// Relations with other types of entities contain e.g. <release> or <work> elements
+	// with <title> rather than <name> children.
+	//
+	// When multiple resources are requested, the <url> elements are instead wrapped in a
+	// <url-list> element, and URLs that aren't in the database are omitted.
 	var md struct {
-		XMLName       xml.Name `xml:"metadata"`
-		RelationLists []struct {
-			TargetType string `xml:"target-type,attr"`
-			Relations  []struct {
-				Target     string `xml:"target"`
-				ArtistName string `xml:"artist>name"`
-				LabelName  string `xml:"label>name"`
-			} `xml:"relation"`
-		} `xml:"url>relation-list"`
+		XMLName xml.Name  `xml:"metadata"`
+		URLs    []urlData `xml:"url"`
+		URLList []urlData `xml:"url-list>url"`
 	}
 	if err := xml.NewDecoder(r).Decode(&md); err != nil {
 		return nil, err
 	}
+	urls := append(md.URLs, md.URLList...)
+
+	// The server may return a normalized version of the URL (e.g. with a trailing slash added),
+	// so fall back to loose matching if needed.
+	var unmatched int
+	for _, ud := range urls {
+		var match string
+		if len(linkURLs) == 1 && len(urls) == 1 {
+			match = linkURLs[0]
+		} else {
+			for _, u := range linkURLs {
+				if u == ud.Resource {
+					match = u
+					break
+				} else if match == "" && looseURL(u) == looseURL(ud.Resource) {
+					match = u
+				}
+			}
+		}
+		if match == "" {
+			log.Printf("Got unrequested URL %v", ud.Resource)
+			unmatched++
+			continue
+		}
+		infos := ud.infos(entity)
+		log.Printf("Got %d %v relation(s) for %v", len(infos), entity, match)
+		res[match] = infos
+		db.urlRels[entity].Set(match, urlRelsEntry{infos, db.now().Add(urlRelsTime)})
+		db.saveStored(urlRelsKind, urlRelsStoreKey(match, entity), infos)
+	}
+
+	// If we were able to match all of the returned URLs, treat the remaining URLs as missing.
+	// Otherwise, don't cache anything for them, since they may have just been normalized
+	// in an unexpected way.
+	for _, u := range linkURLs {
+		if _, ok := res[u]; !ok {
+			res[u] = nil
+			if unmatched == 0 {
+				db.saveURLMiss(u, entity)
+			}
+		}
+	}
+	return res, nil
+}
+
+// saveURLMiss records in db's caches that no entities of the specified type are related to linkURL.
+func (db *DB) saveURLMiss(linkURL string, entity entityType) {
+	db.urlMiss[entity].Set(linkURL, db.now())
+	db.saveStored(urlMissKind, urlRelsStoreKey(linkURL, entity), true)
+}
 
+// urlData is used to parse <url> elements in /ws/2/url responses.
+type urlData struct {
+	Resource      string `xml:"resource"`
+	RelationLists []struct {
+		TargetType string `xml:"target-type,attr"`
+		Relations  []struct {
+			Target            string `xml:"target"`
+			ArtistName        string `xml:"artist>name"`
+			EventName         string `xml:"event>name"`
+			LabelName         string `xml:"label>name"`
+			PlaceName         string `xml:"place>name"`
+			RecordingTitle    string `xml:"recording>title"`
+			ReleaseTitle      string `xml:"release>title"`
+			ReleaseGroupTitle string `xml:"release-group>title"`
+			WorkTitle         string `xml:"work>title"`
+		} `xml:"relation"`
+	} `xml:"relation-list"`
+}
+
+// infos returns information about entities of the specified type related to the URL.
+func (ud *urlData) infos(entity entityType) []EntityInfo {
 	var infos []EntityInfo
-	for _, list := range md.RelationLists {
-		if entityType(list.TargetType) != entity {
+	for _, list := range ud.RelationLists {
+		// Target types use underscores, e.g. "release_group".
+		if entityType(strings.ReplaceAll(list.TargetType, "_", "-")) != entity {
 			continue
 		}
 		for _, rel := range list.Relations {
@@ -225,17 +516,171 @@ func (db *DB) getURLRels(ctx context.Context, linkURL string, entity entityType)
 			switch entity {
 			case artistType:
 				name = rel.ArtistName
+			case eventType:
+				name = rel.EventName
 			case labelType:
 				name = rel.LabelName
+			case placeType:
+				name = rel.PlaceName
+			case recordingType:
+				name = rel.RecordingTitle
+			case releaseType:
+				name = rel.ReleaseTitle
+			case releaseGroupType:
+				name = rel.ReleaseGroupTitle
+			case workType:
+				name = rel.WorkTitle
 			}
 			infos = append(infos, EntityInfo{MBID: rel.Target, Name: name})
 		}
 	}
-	log.Printf("Got %d %v relation(s) for %v", len(infos), entity, linkURL)
-	cache.Set(linkURL, infos)
+	return infos
+}
+
+// loadStored JSON-decodes the value saved for key within kind in db.store into dst.
+// False is returned if db.store is nil or the value isn't present.
+// Errors are logged rather than returned since the store is just an optimization.
+func (db *DB) loadStored(kind, key string, dst interface{}) bool {
+	if db.store == nil {
+		return false
+	}
+	b, ok, err := db.store.Get(kind, key)
+	if err != nil {
+		log.Printf("Failed loading %v %q from store: %v", kind, key, err)
+		return false
+	} else if !ok {
+		return false
+	}
+	if err := json.Unmarshal(b, dst); err != nil {
+		log.Printf("Failed decoding %v %q from store: %v", kind, key, err)
+		return false
+	}
+	return true
+}
+
+// saveStored JSON-encodes val and saves it for key within kind in db.store.
+// Nothing is done if db.store is nil.
+func (db *DB) saveStored(kind, key string, val interface{}) {
+	if db.store == nil {
+		return
+	}
+	b, err := json.Marshal(val)
+	if err == nil {
+		err = db.store.Set(kind, key, b)
+	}
+	if err != nil {
+		log.Printf("Failed saving %v %q to store: %v", kind, key, err)
+	}
+}
+
+// GetEntity fetches the entity of the specified type (e.g. "artist" or "release") with the
+// supplied MBID from the /ws/2 API and JSON-decodes it into dst.
+// inc contains additional data to include (e.g. "artist-credits" or "recordings").
+// See https://musicbrainz.org/doc/MusicBrainz_API#Lookups.
+func (db *DB) GetEntity(ctx context.Context, typ, mbid string, inc []string, dst interface{}) error {
+	if !IsMBID(mbid) {
+		return errors.New("malformed MBID")
+	}
+	if db.dump != nil {
+		return db.dump.entity(typ, mbid, dst)
+	}
+
+	log.Printf("Requesting %v %v", typ, mbid)
+	path := fmt.Sprintf("/ws/2/%s/%s?fmt=json", url.PathEscape(typ), mbid)
+	if len(inc) > 0 {
+		path += "&inc=" + strings.Join(inc, "+")
+	}
+	r, err := db.doQuery(ctx, path)
+	if err == notFoundError {
+		return fmt.Errorf("%v %v not found", typ, mbid)
+	} else if err != nil {
+		return err
+	}
+	defer r.Close()
+	return json.NewDecoder(r).Decode(dst)
+}
+
+// RecordingInfo contains high-level information about a recording.
+type RecordingInfo struct {
+	// MBID contains the recording's UUID.
+	MBID string
+	// Title contains the recording's title as it appears in the database.
+	Title string
+	// Length contains the recording's duration. It is 0 if the length is unknown.
+	Length time.Duration
+}
+
+// GetRecordingsFromISRC returns recordings with the supplied ISRC.
+// If no recordings have the ISRC, an empty slice is returned.
+func (db *DB) GetRecordingsFromISRC(ctx context.Context, isrc string) ([]RecordingInfo, error) {
+	isrc = strings.ToUpper(isrc)
+	if db.dump != nil {
+		return db.dump.recordingsFromISRC(isrc), nil
+	}
+	if v, ok := db.isrcRecs.Get(isrc); ok {
+		return v.([]RecordingInfo), nil
+	}
+	var infos []RecordingInfo
+	if db.loadStored(isrcRecsKind, isrc, &infos) {
+		db.isrcRecs.Set(isrc, infos)
+		return infos, nil
+	}
+
+	// If we're being called from a test, just pretend like the ISRC is missing.
+	if db.disallowQueries {
+		return nil, nil
+	}
+
+	log.Print("Requesting recordings for ISRC ", isrc)
+	r, err := db.doQuery(ctx, "/ws/2/isrc/"+url.PathEscape(isrc)+"?fmt=json")
+	if err != nil && err != notFoundError {
+		return nil, err
+	} else if err == nil {
+		defer r.Close()
+		var data struct {
+			Recordings []struct {
+				ID     string `json:"id"`
+				Title  string `json:"title"`
+				Length int64  `json:"length"` // milliseconds
+			} `json:"recordings"`
+		}
+		if err := json.NewDecoder(r).Decode(&data); err != nil {
+			return nil, err
+		}
+		for _, rec := range data.Recordings {
+			infos = append(infos, RecordingInfo{
+				MBID:   rec.ID,
+				Title:  rec.Title,
+				Length: time.Duration(rec.Length) * time.Millisecond,
+			})
+		}
+	}
+	log.Printf("Got %d recording(s) for ISRC %v", len(infos), isrc)
+	db.isrcRecs.Set(isrc, infos)
+	db.saveStored(isrcRecsKind, isrc, infos)
 	return infos, nil
 }
 
+// Search performs a search for entities of the specified type (e.g. "release") using the
+// supplied Lucene query (e.g. `barcode:0123456789012`) and JSON-decodes the response into dst.
+// limit specifies the maximum number of results to return. If a Dump was supplied via UseDump,
+// only a subset of the query syntax is supported.
+// See https://musicbrainz.org/doc/MusicBrainz_API/Search.
+func (db *DB) Search(ctx context.Context, typ, query string, limit int, dst interface{}) error {
+	log.Printf("Searching for %v %q", typ, query)
+	if db.dump != nil {
+		return db.dump.search(typ, query, limit, dst)
+	}
+	path := fmt.Sprintf("/ws/2/%s?query=%s&limit=%d&fmt=json",
+		url.PathEscape(typ), url.QueryEscape(query), limit)
+	r, err := db.doQuery(ctx, path)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	return json.NewDecoder(r).Decode(dst)
+}
+
 // notFoundError is returned by doQuery if a 404 error was received.
 var notFoundError = errors.New("not found")
 
@@ -246,22 +691,13 @@ func (db *DB) doQuery(ctx context.Context, path string) (io.ReadCloser, error) {
 		return nil, errors.New("querying not allowed")
 	}
 
-	// Wait until we can perform a query.
+	// Transient errors (e.g. 503s sent when the server is throttling us) are retried
+	// by db.client, which also rate-limits requests.
 	// TODO: We could be smarter here and bail out early if someone else
 	// successfully fetches the same thing while we're waiting.
-	if err := db.limiter.Wait(ctx); err != nil {
-		return nil, err
-	}
-
 	u := db.serverURL + path
 	log.Print("Sending GET request for ", u)
-	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("User-Agent", fmt.Sprintf(userAgentFmt, db.version))
-
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := db.client.Get(ctx, u)
 	if err != nil {
 		return nil, err
 	}
@@ -283,12 +719,17 @@ func (db *DB) SetDatabaseIDForTest(mbid string, id int32) {
 
 // SetArtistsFromURLForTest hardcodes artists for GetArtistsFromURL to return.
 func (db *DB) SetArtistsFromURLForTest(url string, artists []EntityInfo) {
-	db.urlRels[artistType].Set(url, artists)
+	db.urlRels[artistType].Set(url, urlRelsEntry{infos: artists})
 }
 
 // SetLabelsFromURLForTest hardcodes labels for GetLabelsFromURL to return.
 func (db *DB) SetLabelsFromURLForTest(url string, labels []EntityInfo) {
-	db.urlRels[labelType].Set(url, labels)
+	db.urlRels[labelType].Set(url, urlRelsEntry{infos: labels})
+}
+
+// SetRecordingsFromISRCForTest hardcodes recordings for GetRecordingsFromISRC to return.
+func (db *DB) SetRecordingsFromISRCForTest(isrc string, recs []RecordingInfo) {
+	db.isrcRecs.Set(strings.ToUpper(isrc), recs)
 }
 
 // MakeEntityInfosForTest is a helper function for tests that creates EntityInfo objects given a
@@ -131,3 +131,184 @@ func TestDB_GetArtistsFromURL(t *testing.T) {
 		t.Errorf("Got %d request(s) for %q; want 2", cnt, missingPath)
 	}
 }
+
+func TestDB_Store(t *testing.T) {
+	const (
+		mbid    = "b10bbbfc-cf9e-42e0-be17-e2c3e1d2600d"
+		id      = 303
+		idPath  = "/ws/js/entity/" + mbid
+		linkURL = "https://listen.tidal.com/artist/3634161"
+		relData = `<?xml version="1.0" encoding="UTF-8"?>
+<metadata xmlns="http://musicbrainz.org/ns/mmd-2.0#"><url><relation-list target-type="artist"><relation><target>b10bbbfc-cf9e-42e0-be17-e2c3e1d2600d</target><artist><name>The Beatles</name></artist></relation></relation-list></url></metadata>`
+		missURL = "http://example.org/bogus-url"
+	)
+	relPath := "/ws/2/url?resource=" + url.QueryEscape(linkURL) + "&inc=artist-rels"
+	missPath := "/ws/2/url?resource=" + url.QueryEscape(missURL) + "&inc=artist-rels"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch p := r.URL.Path + "?" + r.URL.RawQuery; p {
+		case idPath + "?":
+			io.WriteString(w, `{"id":303}`)
+		case relPath:
+			io.WriteString(w, relData)
+		case missPath:
+			http.NotFound(w, r)
+		default:
+			t.Fatalf("Got request for %q", p)
+		}
+	}))
+	defer srv.Close()
+
+	store, err := NewDiskStore(t.TempDir())
+	if err != nil {
+		t.Fatal("NewDiskStore failed: ", err)
+	}
+	ctx := context.Background()
+	wantRels := []EntityInfo{{mbid, "The Beatles"}}
+
+	// Perform lookups using a DB that sends queries to the server.
+	db := NewDB(ServerURL(srv.URL), MaxQPS(999), Store(store))
+	if got, err := db.GetDatabaseID(ctx, mbid); err != nil || got != id {
+		t.Errorf("GetDatabaseID(ctx, %q) = %v, %v; want %v, nil", mbid, got, err, id)
+	}
+	if got, err := db.GetArtistsFromURL(ctx, linkURL); err != nil || !reflect.DeepEqual(got, wantRels) {
+		t.Errorf("GetArtistsFromURL(ctx, %q) = %v, %v; want %v, nil", linkURL, got, err, wantRels)
+	}
+	if got, err := db.GetArtistsFromURL(ctx, missURL); err != nil || got != nil {
+		t.Errorf("GetArtistsFromURL(ctx, %q) = %v, %v; want nil, nil", missURL, got, err)
+	}
+
+	// A new DB that's unable to send queries should get the results from the store.
+	db = NewDB(DisallowQueries, Store(store))
+	if got, err := db.GetDatabaseID(ctx, mbid); err != nil || got != id {
+		t.Errorf("GetDatabaseID(ctx, %q) = %v, %v; want %v, nil", mbid, got, err, id)
+	}
+	if got, err := db.GetArtistsFromURL(ctx, linkURL); err != nil || !reflect.DeepEqual(got, wantRels) {
+		t.Errorf("GetArtistsFromURL(ctx, %q) = %v, %v; want %v, nil", linkURL, got, err, wantRels)
+	}
+	// Labels are stored separately from artists.
+	if got, err := db.GetLabelsFromURL(ctx, linkURL); err != nil || got != nil {
+		t.Errorf("GetLabelsFromURL(ctx, %q) = %v, %v; want nil, nil", linkURL, got, err)
+	}
+
+	// After clearing the store, queries should be needed again.
+	if err := store.Clear(); err != nil {
+		t.Fatal("Clear failed: ", err)
+	}
+	db = NewDB(DisallowQueries, Store(store))
+	if _, err := db.GetDatabaseID(ctx, mbid); err == nil {
+		t.Errorf("GetDatabaseID(ctx, %q) unexpectedly succeeded after clearing store", mbid)
+	}
+}
+
+func TestDB_GetArtistsFromURLs(t *testing.T) {
+	const (
+		url1  = "https://tidal.com/artist/3634161"
+		mbid1 = "b10bbbfc-cf9e-42e0-be17-e2c3e1d2600d"
+		name1 = "The Beatles"
+		url2  = "https://mpsc.bandcamp.com"
+		mbid2 = "c6d215c4-c718-4bb6-a54a-4c1eee8bc068"
+		name2 = "Misha Panfilov Sound Combo"
+		url3  = "http://example.org/bogus-url"
+		// The server omits missing URLs and adds a trailing slash to url2.
+		data = `<?xml version="1.0" encoding="UTF-8"?>
+<metadata xmlns="http://musicbrainz.org/ns/mmd-2.0#"><url-list count="2">` +
+			`<url><resource>https://tidal.com/artist/3634161</resource><relation-list target-type="artist"><relation><target>` + mbid1 + `</target><artist><name>` + name1 + `</name></artist></relation></relation-list></url>` +
+			`<url><resource>https://mpsc.bandcamp.com/</resource><relation-list target-type="artist"><relation><target>` + mbid2 + `</target><artist><name>` + name2 + `</name></artist></relation></relation-list></url>` +
+			`</url-list></metadata>`
+	)
+	path := "/ws/2/url?resource=" + url.QueryEscape(url1) + "&resource=" + url.QueryEscape(url2) +
+		"&resource=" + url.QueryEscape(url3) + "&inc=artist-rels"
+
+	var reqs int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if p := r.URL.Path + "?" + r.URL.RawQuery; p != path {
+			t.Fatalf("Got request for %q; want %q", p, path)
+		}
+		reqs++
+		io.WriteString(w, data)
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	db := NewDB(ServerURL(srv.URL), MaxQPS(999))
+	want := map[string][]EntityInfo{
+		url1: {{mbid1, name1}},
+		url2: {{mbid2, name2}},
+		url3: nil,
+	}
+	urls := []string{url1, url2, url3, url1}
+	if got, err := db.GetArtistsFromURLs(ctx, urls); err != nil {
+		t.Errorf("GetArtistsFromURLs(ctx, %q) failed: %v", urls, err)
+	} else if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetArtistsFromURLs(ctx, %q) = %v; want %v", urls, got, want)
+	}
+
+	// Subsequent lookups of individual URLs (including the missing one) should use the cache.
+	for u, w := range want {
+		if got, err := db.GetArtistsFromURL(ctx, u); err != nil {
+			t.Errorf("GetArtistsFromURL(ctx, %q) failed: %v", u, err)
+		} else if !reflect.DeepEqual(got, w) {
+			t.Errorf("GetArtistsFromURL(ctx, %q) = %v; want %v", u, got, w)
+		}
+	}
+	if reqs != 1 {
+		t.Errorf("Sent %d request(s); want 1", reqs)
+	}
+}
+
+func TestDB_GetReleaseGroupsFromURL(t *testing.T) {
+	const (
+		linkURL = "https://www.discogs.com/master/33008"
+		mbid    = "f4a261de-5f1a-4d5c-a1e8-5ea0a83abcc7"
+		title   = "Abbey Road"
+		// Release group relations use underscores in their target types.
+		data = `<?xml version="1.0" encoding="UTF-8"?>
+<metadata xmlns="http://musicbrainz.org/ns/mmd-2.0#"><url><resource>https://www.discogs.com/master/33008</resource><relation-list target-type="release_group"><relation type="discogs"><target>` + mbid + `</target><release-group id="` + mbid + `"><title>` + title + `</title></release-group></relation></relation-list></url></metadata>`
+	)
+	path := "/ws/2/url?resource=" + url.QueryEscape(linkURL) + "&inc=release-group-rels"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if p := r.URL.Path + "?" + r.URL.RawQuery; p != path {
+			t.Fatalf("Got request for %q; want %q", p, path)
+		}
+		io.WriteString(w, data)
+	}))
+	defer srv.Close()
+
+	db := NewDB(ServerURL(srv.URL), MaxQPS(999))
+	want := []EntityInfo{{mbid, title}}
+	if got, err := db.GetReleaseGroupsFromURL(context.Background(), linkURL); err != nil {
+		t.Errorf("GetReleaseGroupsFromURL(ctx, %q) failed: %v", linkURL, err)
+	} else if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetReleaseGroupsFromURL(ctx, %q) = %v; want %v", linkURL, got, want)
+	}
+}
+
+func TestDB_Retry(t *testing.T) {
+	const (
+		mbid = "b10bbbfc-cf9e-42e0-be17-e2c3e1d2600d"
+		id   = 303
+	)
+	var reqs int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Simulate the server throttling us.
+		if reqs++; reqs < 3 {
+			w.Header().Set("Retry-After", "0")
+			http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		io.WriteString(w, `{"id":303}`)
+	}))
+	defer srv.Close()
+
+	db := NewDB(ServerURL(srv.URL), MaxQPS(999), RetryDelay(time.Millisecond))
+	if got, err := db.GetDatabaseID(context.Background(), mbid); err != nil {
+		t.Fatalf("GetDatabaseID(ctx, %q) failed: %v", mbid, err)
+	} else if got != id {
+		t.Fatalf("GetDatabaseID(ctx, %q) = %d; want %d", mbid, got, id)
+	}
+	if reqs != 3 {
+		t.Errorf("Sent %d request(s); want 3", reqs)
+	}
+}
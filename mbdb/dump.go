@@ -0,0 +1,497 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package mbdb
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dumpTypes lists the entity types that can be loaded from JSON dump files.
+var dumpTypes = []string{
+	"area", "artist", "event", "instrument", "label", "place",
+	"recording", "release", "release-group", "series", "work",
+}
+
+// dumpIDsFile is the name of the optional file within a dump directory that maps
+// MBIDs to database IDs, which aren't included in JSON dumps.
+const dumpIDsFile = "database-ids"
+
+// Dump contains entities loaded from MusicBrainz JSON data dumps
+// (see https://musicbrainz.org/doc/MusicBrainz_Database/Download#JSON_Data_Dumps).
+// It can be passed to UseDump to perform lookups without using the network.
+//
+// All entities are held in memory, so it's typically used with a subset of a full dump.
+type Dump struct {
+	entities map[string]map[string]*dumpEntity      // entity type to MBID to entity
+	order    map[string][]*dumpEntity               // entity type to entities in load order
+	dbIDs    map[string]int32                       // MBID to database ID
+	urlRels  map[string]map[entityType][]EntityInfo // loose URL to entity type to entities
+	isrcs    map[string][]RecordingInfo             // uppercase ISRC to recordings
+}
+
+// dumpEntity contains the parts of a dumped entity that are needed for lookups.
+type dumpEntity struct {
+	raw json.RawMessage // original JSON object
+
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`
+	Title        string   `json:"title"`
+	Barcode      string   `json:"barcode"`
+	ISRCs        []string `json:"isrcs"`
+	Length       int64    `json:"length"` // milliseconds
+	ArtistCredit []struct {
+		Name   string `json:"name"`
+		Artist struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"artist"`
+	} `json:"artist-credit"`
+	LabelInfo []struct {
+		CatalogNumber string `json:"catalog-number"`
+		Label         *struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"label"`
+	} `json:"label-info"`
+	Relations []struct {
+		TargetType string `json:"target-type"`
+		URL        *struct {
+			Resource string `json:"resource"`
+		} `json:"url"`
+	} `json:"relations"`
+}
+
+// name returns the entity's name or title.
+func (ent *dumpEntity) name() string {
+	if ent.Name != "" {
+		return ent.Name
+	}
+	return ent.Title
+}
+
+// LoadDump loads entities from JSON dump files in dir.
+//
+// Each entity type is read from a file named after the type (e.g. "artist" or
+// "release-group", as in the "mbdump" directory of the JSON dump archives),
+// optionally with a ".json" or ".jsonl" extension and a ".gz" extension for gzipped files.
+// Each line of a file contains a single JSON object as returned by the API with all
+// includes. Missing files are skipped.
+//
+// Database IDs can be supplied via an optional "database-ids" file containing lines
+// with tab-separated database IDs and MBIDs, e.g. as extracted via `cut -f1,2` from the
+// tables in the PostgreSQL dumps.
+func LoadDump(dir string) (*Dump, error) {
+	d := Dump{
+		entities: make(map[string]map[string]*dumpEntity),
+		order:    make(map[string][]*dumpEntity),
+		dbIDs:    make(map[string]int32),
+		urlRels:  make(map[string]map[entityType][]EntityInfo),
+		isrcs:    make(map[string][]RecordingInfo),
+	}
+	var found bool
+	for _, typ := range append(dumpTypes, dumpIDsFile) {
+		for _, ext := range []string{"", ".json", ".jsonl"} {
+			for _, gz := range []bool{false, true} {
+				p := filepath.Join(dir, typ+ext)
+				if gz {
+					p += ".gz"
+				}
+				if ok, err := d.loadFile(p, typ, gz); err != nil {
+					return nil, fmt.Errorf("%v: %v", p, err)
+				} else if ok {
+					found = true
+				}
+			}
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("no dump files in %v", dir)
+	}
+	return &d, nil
+}
+
+// loadFile loads entities of type typ (or database IDs) from the file at p.
+// False is returned if the file doesn't exist.
+func (d *Dump) loadFile(p, typ string, gz bool) (bool, error) {
+	f, err := os.Open(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	log.Print("Loading dump file ", p)
+	var r io.Reader = f
+	if gz {
+		zr, err := gzip.NewReader(f)
+		if err != nil {
+			return false, err
+		}
+		defer zr.Close()
+		r = zr
+	}
+	if typ == dumpIDsFile {
+		return true, d.loadIDs(r)
+	}
+	return true, d.loadEntities(r, typ)
+}
+
+// loadEntities reads line-delimited JSON entities of type typ from r.
+func (d *Dump) loadEntities(r io.Reader, typ string) error {
+	if d.entities[typ] == nil {
+		d.entities[typ] = make(map[string]*dumpEntity)
+	}
+	br := bufio.NewReader(r) // lines can be too long for bufio.Scanner
+	for ln := 1; ; ln++ {
+		b, err := br.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if line := strings.TrimSpace(string(b)); line != "" {
+			if err := d.addEntity(typ, []byte(line)); err != nil {
+				return fmt.Errorf("line %d: %v", ln, err)
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
+// addEntity adds the supplied JSON object to d.
+func (d *Dump) addEntity(typ string, b []byte) error {
+	ent := dumpEntity{raw: b}
+	if err := json.Unmarshal(b, &ent); err != nil {
+		return err
+	}
+	if !IsMBID(ent.ID) {
+		return fmt.Errorf("bad MBID %q", ent.ID)
+	}
+	if _, ok := d.entities[typ][ent.ID]; ok {
+		return fmt.Errorf("duplicate %v %v", typ, ent.ID)
+	}
+	d.entities[typ][ent.ID] = &ent
+	d.order[typ] = append(d.order[typ], &ent)
+
+	info := EntityInfo{MBID: ent.ID, Name: ent.name()}
+	for _, rel := range ent.Relations {
+		if rel.TargetType != "url" || rel.URL == nil {
+			continue
+		}
+		key := looseURL(rel.URL.Resource)
+		if d.urlRels[key] == nil {
+			d.urlRels[key] = make(map[entityType][]EntityInfo)
+		}
+		d.urlRels[key][entityType(typ)] = append(d.urlRels[key][entityType(typ)], info)
+	}
+	if typ == string(recordingType) {
+		for _, isrc := range ent.ISRCs {
+			isrc = strings.ToUpper(isrc)
+			d.isrcs[isrc] = append(d.isrcs[isrc], RecordingInfo{
+				MBID:   ent.ID,
+				Title:  ent.Title,
+				Length: time.Duration(ent.Length) * time.Millisecond,
+			})
+		}
+	}
+	return nil
+}
+
+// loadIDs reads lines containing tab-separated database IDs and MBIDs from r.
+// Additional columns are ignored.
+func (d *Dump) loadIDs(r io.Reader) error {
+	br := bufio.NewReader(r)
+	for ln := 1; ; ln++ {
+		b, err := br.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if line := strings.TrimSpace(string(b)); line != "" {
+			cols := strings.Split(line, "\t")
+			if len(cols) < 2 || !IsMBID(cols[1]) {
+				return fmt.Errorf("line %d: expected ID and MBID", ln)
+			}
+			id, perr := strconv.ParseInt(cols[0], 10, 32)
+			if perr != nil || id <= 0 {
+				return fmt.Errorf("line %d: bad ID %q", ln, cols[0])
+			}
+			d.dbIDs[strings.ToLower(cols[1])] = int32(id)
+		}
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
+// databaseID returns the database ID for mbid.
+func (d *Dump) databaseID(mbid string) (int32, error) {
+	if id, ok := d.dbIDs[strings.ToLower(mbid)]; ok {
+		return id, nil
+	}
+	return 0, fmt.Errorf("no database ID for %v in dump", mbid)
+}
+
+// urlRelsBatch returns entities of the specified type related to each of linkURLs.
+func (d *Dump) urlRelsBatch(linkURLs []string, entity entityType) map[string][]EntityInfo {
+	res := make(map[string][]EntityInfo, len(linkURLs))
+	for _, u := range linkURLs {
+		res[u] = d.urlRels[looseURL(u)][entity]
+	}
+	return res
+}
+
+// recordingsFromISRC returns recordings with the supplied ISRC.
+func (d *Dump) recordingsFromISRC(isrc string) []RecordingInfo {
+	return d.isrcs[strings.ToUpper(isrc)]
+}
+
+// entity JSON-decodes the entity of the specified type and MBID into dst.
+func (d *Dump) entity(typ, mbid string, dst interface{}) error {
+	ent, ok := d.entities[typ][strings.ToLower(mbid)]
+	if !ok {
+		return fmt.Errorf("%v %v not found in dump", typ, mbid)
+	}
+	return json.Unmarshal(ent.raw, dst)
+}
+
+// search JSON-decodes up to limit entities of the specified type matching query into dst,
+// using the same format as the /ws/2 search API (e.g. {"releases": [...]}).
+//
+// Only a small subset of the Lucene query syntax is supported: a query consists of one
+// or more clauses joined by AND, each containing an optional field name followed by a
+// word, a quoted phrase, or a parenthesized list of words or phrases joined by OR.
+// Values are compared case-insensitively against entire fields rather than being tokenized.
+func (d *Dump) search(typ, query string, limit int, dst interface{}) error {
+	clauses, err := parseDumpQuery(query)
+	if err != nil {
+		return err
+	}
+	var matches []json.RawMessage
+	for _, ent := range d.order[typ] {
+		if limit > 0 && len(matches) >= limit {
+			break
+		}
+		ok := true
+		for _, cl := range clauses {
+			vals, err := ent.fieldValues(typ, cl.field)
+			if err != nil {
+				return err
+			}
+			if !cl.matches(vals) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			matches = append(matches, ent.raw)
+		}
+	}
+
+	plural := typ + "s"
+	if typ == "series" {
+		plural = typ
+	}
+	if matches == nil {
+		matches = []json.RawMessage{}
+	}
+	b, err := json.Marshal(map[string]interface{}{"count": len(matches), "offset": 0, plural: matches})
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, dst)
+}
+
+// fieldValues returns the values of the named search field for ent, which has type typ.
+func (ent *dumpEntity) fieldValues(typ, field string) ([]string, error) {
+	var vals []string
+	switch field {
+	case "", "name", strings.ReplaceAll(typ, "-", ""):
+		return []string{ent.name()}, nil
+	case "barcode":
+		return []string{ent.Barcode}, nil
+	case "isrc":
+		return ent.ISRCs, nil
+	case "catno":
+		for _, li := range ent.LabelInfo {
+			vals = append(vals, li.CatalogNumber)
+		}
+	case "laid", "label":
+		if typ == string(labelType) {
+			if field == "laid" {
+				return []string{ent.ID}, nil
+			}
+			return []string{ent.Name}, nil
+		}
+		for _, li := range ent.LabelInfo {
+			if li.Label != nil {
+				if field == "laid" {
+					vals = append(vals, li.Label.ID)
+				} else {
+					vals = append(vals, li.Label.Name)
+				}
+			}
+		}
+	case "arid", "artist", "artistname":
+		if typ == string(artistType) {
+			if field == "arid" {
+				return []string{ent.ID}, nil
+			}
+			return []string{ent.Name}, nil
+		}
+		for _, ac := range ent.ArtistCredit {
+			if field == "arid" {
+				vals = append(vals, ac.Artist.ID)
+			} else {
+				vals = append(vals, ac.Name, ac.Artist.Name)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported search field %q", field)
+	}
+	return vals, nil
+}
+
+// dumpClause is a single clause in a query passed to Dump.search.
+type dumpClause struct {
+	field  string   // empty for default field
+	values []string // alternatives
+}
+
+// matches returns true if any of c's values matches any of vals.
+func (c *dumpClause) matches(vals []string) bool {
+	for _, want := range c.values {
+		for _, v := range vals {
+			if strings.EqualFold(strings.TrimSpace(v), want) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseDumpQuery parses a query in the Lucene subset described in Dump.search.
+func parseDumpQuery(query string) ([]dumpClause, error) {
+	toks, err := tokenizeDumpQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	var clauses []dumpClause
+	for len(toks) > 0 {
+		if len(clauses) > 0 {
+			if !toks[0].is("AND") {
+				return nil, fmt.Errorf("expected AND at %q", toks[0].s)
+			}
+			toks = toks[1:]
+		}
+		var cl dumpClause
+		if len(toks) >= 2 && !toks[0].op() && toks[1].is(":") {
+			cl.field = strings.ToLower(toks[0].s)
+			toks = toks[2:]
+		}
+		if len(toks) == 0 {
+			return nil, errors.New("missing value")
+		}
+		if toks[0].is("(") {
+			toks = toks[1:]
+			for {
+				if len(toks) < 2 || toks[0].op() {
+					return nil, errors.New("bad parenthesized list")
+				}
+				cl.values = append(cl.values, toks[0].s)
+				next := toks[1]
+				toks = toks[2:]
+				if next.is(")") {
+					break
+				} else if !next.is("OR") {
+					return nil, fmt.Errorf("expected OR at %q", next.s)
+				}
+			}
+		} else if toks[0].op() {
+			return nil, fmt.Errorf("unexpected %q", toks[0].s)
+		} else {
+			cl.values = []string{toks[0].s}
+			toks = toks[1:]
+		}
+		clauses = append(clauses, cl)
+	}
+	if len(clauses) == 0 {
+		return nil, errors.New("empty query")
+	}
+	return clauses, nil
+}
+
+// dumpToken is a token returned by tokenizeDumpQuery.
+type dumpToken struct {
+	s      string
+	quoted bool // s was a quoted phrase
+}
+
+// op returns true if t is an operator rather than a value.
+func (t dumpToken) op() bool {
+	switch t.s {
+	case "AND", "OR", "(", ")", ":":
+		return !t.quoted
+	}
+	return false
+}
+
+// is returns true if t is the supplied operator.
+func (t dumpToken) is(op string) bool { return !t.quoted && t.s == op }
+
+// tokenizeDumpQuery splits query into words, unquoted phrases, and operators.
+// Unsupported syntax (e.g. NOT or wildcards) results in an error.
+func tokenizeDumpQuery(query string) ([]dumpToken, error) {
+	var toks []dumpToken
+	for i := 0; i < len(query); {
+		switch ch := query[i]; {
+		case ch == ' ' || ch == '\t':
+			i++
+		case ch == '(' || ch == ')' || ch == ':':
+			toks = append(toks, dumpToken{s: string(ch)})
+			i++
+		case ch == '"':
+			var b strings.Builder
+			j := i + 1
+			for ; j < len(query) && query[j] != '"'; j++ {
+				if query[j] == '\\' && j+1 < len(query) {
+					j++
+				}
+				b.WriteByte(query[j])
+			}
+			if j >= len(query) {
+				return nil, errors.New("unterminated phrase")
+			}
+			toks = append(toks, dumpToken{s: b.String(), quoted: true})
+			i = j + 1
+		default:
+			j := i
+			for j < len(query) && !strings.ContainsRune(" \t():\"", rune(query[j])) {
+				j++
+			}
+			word := query[i:j]
+			if word == "NOT" || strings.ContainsAny(word[:1], "-+!") || strings.ContainsAny(word, `*?~^\{}[]`) {
+				return nil, fmt.Errorf("unsupported query syntax %q", word)
+			}
+			toks = append(toks, dumpToken{s: word})
+			i = j
+		}
+	}
+	return toks, nil
+}
+
+// looseURL returns a loosely-normalized version of u for comparing URLs.
+func looseURL(u string) string { return strings.TrimSuffix(strings.ToLower(u), "/") }
@@ -0,0 +1,167 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package mbdb
+
+import (
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDB_UseDump(t *testing.T) {
+	const (
+		artistMBID = "b10bbbfc-cf9e-42e0-be17-e2c3e1d2600d"
+		labelMBID  = "c5c4e3b6-4b1a-4a3f-8a8f-6e6a3b3f7f11"
+		relMBID1   = "0a1b2c3d-0000-4000-8000-000000000001"
+		relMBID2   = "0a1b2c3d-0000-4000-8000-000000000002"
+		recMBID    = "0a1b2c3d-0000-4000-8000-000000000003"
+	)
+
+	dir := t.TempDir()
+	write := func(name string, lines ...string) {
+		data := strings.Join(lines, "\n") + "\n"
+		if strings.HasSuffix(name, ".gz") {
+			f, err := os.Create(filepath.Join(dir, name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			w := gzip.NewWriter(f)
+			if _, err := w.Write([]byte(data)); err != nil {
+				t.Fatal(err)
+			}
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+			if err := f.Close(); err != nil {
+				t.Fatal(err)
+			}
+		} else if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("artist",
+		`{"id":"`+artistMBID+`","name":"The Beatles","relations":[`+
+			`{"target-type":"url","type":"bandcamp","url":{"resource":"https://beatles.bandcamp.com/"}}]}`)
+	write("release.jsonl.gz",
+		`{"id":"`+relMBID1+`","title":"Abbey Road","barcode":"077774644624",`+
+			`"artist-credit":[{"name":"The Beatles","joinphrase":"","artist":{"id":"`+artistMBID+`","name":"The Beatles"}}],`+
+			`"label-info":[{"catalog-number":"PCS 7088","label":{"id":"`+labelMBID+`","name":"Apple Records"}}],`+
+			`"relations":[{"target-type":"url","url":{"resource":"https://www.discogs.com/release/123"}}]}`,
+		`{"id":"`+relMBID2+`","title":"Let It Be","barcode":null,`+
+			`"label-info":[{"catalog-number":"PCS 7096","label":{"id":"`+labelMBID+`","name":"Apple Records"}}]}`)
+	write("recording.json",
+		`{"id":"`+recMBID+`","title":"Come Together","length":259946,"isrcs":["GBAYE0601690"]}`)
+	write(dumpIDsFile, "303\t"+artistMBID+"\tThe Beatles")
+
+	dump, err := LoadDump(dir)
+	if err != nil {
+		t.Fatal("LoadDump failed: ", err)
+	}
+	db := NewDB(UseDump(dump), ServerURL("http://127.0.0.1:0")) // server shouldn't be used
+	ctx := context.Background()
+
+	if id, err := db.GetDatabaseID(ctx, artistMBID); err != nil || id != 303 {
+		t.Errorf("GetDatabaseID(ctx, %q) = %v, %v; want 303, nil", artistMBID, id, err)
+	}
+	if _, err := db.GetDatabaseID(ctx, labelMBID); err == nil {
+		t.Errorf("GetDatabaseID(ctx, %q) unexpectedly succeeded", labelMBID)
+	}
+
+	// URLs should be matched loosely.
+	if got, err := db.GetArtistsFromURL(ctx, "https://beatles.bandcamp.com"); err != nil {
+		t.Error("GetArtistsFromURL failed: ", err)
+	} else if want := MakeEntityInfosForTest(artistMBID, "The Beatles"); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetArtistsFromURL returned %v; want %v", got, want)
+	}
+	urls := []string{"https://www.discogs.com/release/123", "https://example.org/"}
+	if got, err := db.GetReleasesFromURLs(ctx, urls); err != nil {
+		t.Error("GetReleasesFromURLs failed: ", err)
+	} else if want := map[string][]EntityInfo{
+		urls[0]: MakeEntityInfosForTest(relMBID1, "Abbey Road"),
+		urls[1]: nil,
+	}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetReleasesFromURLs returned %v; want %v", got, want)
+	}
+
+	if got, err := db.GetRecordingsFromISRC(ctx, "gbaye0601690"); err != nil {
+		t.Error("GetRecordingsFromISRC failed: ", err)
+	} else if want := []RecordingInfo{{recMBID, "Come Together", 259946 * time.Millisecond}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetRecordingsFromISRC returned %v; want %v", got, want)
+	}
+
+	var rec struct {
+		Title string `json:"title"`
+	}
+	if err := db.GetEntity(ctx, "recording", recMBID, []string{"isrcs"}, &rec); err != nil {
+		t.Error("GetEntity failed: ", err)
+	} else if rec.Title != "Come Together" {
+		t.Errorf("GetEntity returned title %q; want %q", rec.Title, "Come Together")
+	}
+	if err := db.GetEntity(ctx, "release", recMBID, nil, &rec); err == nil {
+		t.Error("GetEntity for missing release unexpectedly succeeded")
+	}
+
+	for _, tc := range []struct {
+		query string
+		want  []string // MBIDs; nil if error expected
+	}{
+		{`barcode:("77774644624" OR "077774644624")`, []string{relMBID1}},
+		{`barcode:"0123"`, []string{}},
+		{`catno:"pcs 7096" AND laid:` + labelMBID, []string{relMBID2}},
+		{`label:"Apple Records"`, []string{relMBID1, relMBID2}},
+		{`release:"abbey road" AND arid:` + artistMBID, []string{relMBID1}},
+		{`"Let It Be"`, []string{relMBID2}},
+		{`barcode:0*`, nil},
+		{`bogus:foo`, nil},
+		{`barcode:"1" OR barcode:"2"`, nil},
+	} {
+		var data struct {
+			Count    int `json:"count"`
+			Releases []struct {
+				ID string `json:"id"`
+			} `json:"releases"`
+		}
+		err := db.Search(ctx, "release", tc.query, 10, &data)
+		if tc.want == nil {
+			if err == nil {
+				t.Errorf("Search(ctx, %q) unexpectedly succeeded", tc.query)
+			}
+			continue
+		} else if err != nil {
+			t.Errorf("Search(ctx, %q) failed: %v", tc.query, err)
+			continue
+		}
+		got := []string{}
+		for _, rel := range data.Releases {
+			got = append(got, rel.ID)
+		}
+		if !reflect.DeepEqual(got, tc.want) || data.Count != len(tc.want) {
+			t.Errorf("Search(ctx, %q) returned %v (count %d); want %v", tc.query, got, data.Count, tc.want)
+		}
+	}
+}
+
+func TestLoadDump_Errors(t *testing.T) {
+	for _, tc := range []struct{ name, data string }{
+		{"artist", `{"id":"bogus","name":"Bad MBID"}`},
+		{"label.json", `{"id":`},
+		{dumpIDsFile, "abc\tb10bbbfc-cf9e-42e0-be17-e2c3e1d2600d"},
+	} {
+		dir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(dir, tc.name), []byte(tc.data), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadDump(dir); err == nil {
+			t.Errorf("LoadDump with %v containing %q unexpectedly succeeded", tc.name, tc.data)
+		}
+	}
+	if _, err := LoadDump(t.TempDir()); err == nil {
+		t.Error("LoadDump with empty dir unexpectedly succeeded")
+	}
+}
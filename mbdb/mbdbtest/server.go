@@ -0,0 +1,404 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+// Package mbdbtest provides an in-process fake MusicBrainz server for tests.
+package mbdbtest
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/derat/yambs/mbdb"
+)
+
+// Request describes a request received by Server.
+type Request struct {
+	Method    string
+	Path      string     // e.g. "/ws/2/url"
+	Query     url.Values // parsed query parameters
+	UserAgent string
+	Time      time.Time
+}
+
+// String returns the request's path and query, e.g. "/ws/2/isrc/GBAYE0601690?fmt=json".
+func (r Request) String() string {
+	if len(r.Query) == 0 {
+		return r.Path
+	}
+	return r.Path + "?" + r.Query.Encode()
+}
+
+// defaultUserAgentRegexp matches User-Agent headers accepted by default.
+// MusicBrainz asks for a meaningful User-Agent identifying the application.
+var defaultUserAgentRegexp = regexp.MustCompile(`^[^/\s]+/\S+ .*\S`)
+
+// Server is a fake MusicBrainz server that implements the /ws/js and /ws/2 lookups
+// performed by mbdb.DB using in-memory fixtures. It records the requests that it receives,
+// and reports test errors for requests with bad User-Agent headers or requests that are
+// sent too quickly (see SetMinInterval). It is safe for concurrent use.
+type Server struct {
+	t   testing.TB
+	srv *httptest.Server
+
+	mu          sync.Mutex
+	databaseIDs map[string]int32             // MBID to database ID
+	urlRels     map[string][]urlRel          // URL to relations
+	entities    map[string]map[string]string // entity type to MBID to JSON
+	isrcs       map[string][]isrcRecording   // uppercase ISRC to recordings
+	searches    map[string]map[string]string // entity type to query to JSON
+	reqs        []Request                    // received requests
+	uaRegexp    *regexp.Regexp               // matches valid User-Agent headers
+	minInterval time.Duration                // minimum time between requests
+	lastReq     time.Time                    // time of last accepted request
+	violations  []string                     // descriptions of requests sent too soon
+	failures    []int                        // HTTP status codes for upcoming requests
+}
+
+type urlRel struct{ typ, mbid, name string }
+
+type isrcRecording struct {
+	mbid, title string
+	length      time.Duration
+}
+
+// NewServer starts a new Server that will be shut down when t's test completes.
+func NewServer(t testing.TB) *Server {
+	s := &Server{
+		t:           t,
+		databaseIDs: make(map[string]int32),
+		urlRels:     make(map[string][]urlRel),
+		entities:    make(map[string]map[string]string),
+		isrcs:       make(map[string][]isrcRecording),
+		searches:    make(map[string]map[string]string),
+		uaRegexp:    defaultUserAgentRegexp,
+	}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.handle))
+	t.Cleanup(func() {
+		s.srv.Close()
+		for _, v := range s.violations {
+			t.Error(v)
+		}
+	})
+	return s
+}
+
+// URL returns the server's base URL without a trailing slash, e.g. "http://127.0.0.1:1234".
+func (s *Server) URL() string { return s.srv.URL }
+
+// NewDB returns a new mbdb.DB that sends queries to s.
+// Rate-limiting is disabled unless opts contains mbdb.MaxQPS.
+func (s *Server) NewDB(opts ...mbdb.Option) *mbdb.DB {
+	return mbdb.NewDB(append([]mbdb.Option{
+		mbdb.ServerURL(s.URL()),
+		mbdb.MaxQPS(1000),
+		mbdb.RetryDelay(time.Millisecond),
+		mbdb.Version("test"),
+	}, opts...)...)
+}
+
+// SetDatabaseID configures s to return id as the database ID of the entity with the supplied MBID.
+func (s *Server) SetDatabaseID(mbid string, id int32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.databaseIDs[mbid] = id
+}
+
+// AddURLRel adds a relationship between linkURL and an entity of the supplied type
+// (e.g. "artist" or "release-group") with the supplied MBID and name (or title).
+func (s *Server) AddURLRel(linkURL, typ, mbid, name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.urlRels[linkURL] = append(s.urlRels[linkURL], urlRel{typ, mbid, name})
+}
+
+// SetEntity configures s to return the supplied JSON object (as returned by the /ws/2 API)
+// for the entity of the supplied type and MBID. Includes in requests are ignored.
+func (s *Server) SetEntity(typ, mbid, data string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.entities[typ] == nil {
+		s.entities[typ] = make(map[string]string)
+	}
+	s.entities[typ][mbid] = data
+}
+
+// AddISRC associates isrc with the supplied recording.
+func (s *Server) AddISRC(isrc, mbid, title string, length time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	isrc = strings.ToUpper(isrc)
+	s.isrcs[isrc] = append(s.isrcs[isrc], isrcRecording{mbid, title, length})
+}
+
+// SetSearchResult configures s to return the supplied JSON search response
+// (e.g. `{"count":1,"releases":[...]}`) for the exact Lucene query for the entity type.
+// Other searches return empty results.
+func (s *Server) SetSearchResult(typ, query, data string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.searches[typ] == nil {
+		s.searches[typ] = make(map[string]string)
+	}
+	s.searches[typ][query] = data
+}
+
+// SetUserAgentRegexp sets a regular expression that must match the User-Agent header of
+// each request. By default, an application name and version followed by additional
+// information (e.g. contact details) are required.
+func (s *Server) SetUserAgentRegexp(re *regexp.Regexp) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.uaRegexp = re
+}
+
+// SetMinInterval configures s to require at least d between requests, as the real server does.
+// Requests that arrive too soon are rejected with 503 errors and reported as test errors
+// unless they are expected (see Violations).
+func (s *Server) SetMinInterval(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.minInterval = d
+}
+
+// Violations returns the number of requests that were rejected since they arrived too soon
+// after previous requests, and resets the count. A test error is reported at the end of the
+// test for each rejected request that wasn't included in a call to Violations.
+func (s *Server) Violations() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := len(s.violations)
+	s.violations = nil
+	return n
+}
+
+// FailNext configures s to respond to the next n requests with the supplied HTTP status
+// (e.g. http.StatusServiceUnavailable).
+func (s *Server) FailNext(n, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := 0; i < n; i++ {
+		s.failures = append(s.failures, status)
+	}
+}
+
+// Requests returns the requests that have been received so far.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.reqs...)
+}
+
+// ClearRequests clears the list of received requests.
+func (s *Server) ClearRequests() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reqs = nil
+}
+
+// handle handles an HTTP request.
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	req := Request{
+		Method:    r.Method,
+		Path:      r.URL.Path,
+		Query:     r.URL.Query(),
+		UserAgent: r.UserAgent(),
+		Time:      now,
+	}
+	s.reqs = append(s.reqs, req)
+
+	if s.uaRegexp != nil && !s.uaRegexp.MatchString(req.UserAgent) {
+		s.t.Errorf("Request for %v has bad User-Agent %q", req, req.UserAgent)
+		http.Error(w, "Bad User-Agent", http.StatusForbidden)
+		return
+	}
+	if s.minInterval > 0 && !s.lastReq.IsZero() && now.Sub(s.lastReq) < s.minInterval {
+		s.violations = append(s.violations,
+			fmt.Sprintf("Request for %v sent %v after previous request", req, now.Sub(s.lastReq)))
+		http.Error(w, "Rate limit exceeded", http.StatusServiceUnavailable)
+		return
+	}
+	s.lastReq = now
+
+	if len(s.failures) > 0 {
+		st := s.failures[0]
+		s.failures = s.failures[1:]
+		http.Error(w, http.StatusText(st), st)
+		return
+	}
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	switch {
+	case len(parts) == 4 && parts[0] == "ws" && parts[1] == "js" && parts[2] == "entity":
+		s.handleDatabaseID(w, r, parts[3])
+	case len(parts) == 3 && parts[0] == "ws" && parts[1] == "2" && parts[2] == "url":
+		s.handleURL(w, r)
+	case len(parts) == 4 && parts[0] == "ws" && parts[1] == "2" && parts[2] == "isrc":
+		s.handleISRC(w, r, parts[3])
+	case len(parts) == 4 && parts[0] == "ws" && parts[1] == "2":
+		s.handleEntity(w, r, parts[2], parts[3])
+	case len(parts) == 3 && parts[0] == "ws" && parts[1] == "2" && r.URL.Query().Get("query") != "":
+		s.handleSearch(w, r, parts[2])
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *Server) handleDatabaseID(w http.ResponseWriter, r *http.Request, mbid string) {
+	id, ok := s.databaseIDs[mbid]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	writeJSON(w, map[string]interface{}{"id": id, "gid": mbid})
+}
+
+// handleURL handles a /ws/2/url request for one or more "resource" parameters.
+func (s *Server) handleURL(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	resources := q["resource"]
+	if len(resources) == 0 {
+		http.Error(w, "Missing resource", http.StatusBadRequest)
+		return
+	}
+	var types []string
+	for _, inc := range strings.Fields(strings.ReplaceAll(q.Get("inc"), "+", " ")) {
+		if !strings.HasSuffix(inc, "-rels") {
+			http.Error(w, "Bad inc "+inc, http.StatusBadRequest)
+			return
+		}
+		types = append(types, strings.TrimSuffix(inc, "-rels"))
+	}
+
+	var urls []string
+	for _, res := range resources {
+		if _, ok := s.urlRels[res]; !ok {
+			continue
+		}
+		var b strings.Builder
+		fmt.Fprintf(&b, `<url><resource>%s</resource>`, escapeXML(res))
+		for _, typ := range types {
+			var rels []urlRel
+			for _, rel := range s.urlRels[res] {
+				if rel.typ == typ {
+					rels = append(rels, rel)
+				}
+			}
+			if len(rels) == 0 {
+				continue
+			}
+			// Target types use underscores, e.g. "release_group".
+			fmt.Fprintf(&b, `<relation-list target-type="%s">`, strings.ReplaceAll(typ, "-", "_"))
+			nameElem := "name"
+			switch typ {
+			case "recording", "release", "release-group", "work":
+				nameElem = "title"
+			}
+			for _, rel := range rels {
+				fmt.Fprintf(&b, `<relation type="link"><target>%s</target><%s id="%s"><%s>%s</%s></%s></relation>`,
+					rel.mbid, typ, rel.mbid, nameElem, escapeXML(rel.name), nameElem, typ)
+			}
+			b.WriteString(`</relation-list>`)
+		}
+		b.WriteString(`</url>`)
+		urls = append(urls, b.String())
+	}
+
+	// The real server returns a 404 error for a single unknown URL, but omits unknown URLs
+	// when multiple URLs are requested.
+	const hdr = `<?xml version="1.0" encoding="UTF-8"?><metadata xmlns="http://musicbrainz.org/ns/mmd-2.0#">`
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	if len(resources) == 1 {
+		if len(urls) == 0 {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, hdr+urls[0]+`</metadata>`)
+		return
+	}
+	fmt.Fprintf(w, hdr+`<url-list count="%d">%s</url-list></metadata>`, len(urls), strings.Join(urls, ""))
+}
+
+func (s *Server) handleISRC(w http.ResponseWriter, r *http.Request, isrc string) {
+	recs, ok := s.isrcs[strings.ToUpper(isrc)]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	type recording struct {
+		ID     string `json:"id"`
+		Title  string `json:"title"`
+		Length *int64 `json:"length"`
+	}
+	data := struct {
+		ISRC       string      `json:"isrc"`
+		Recordings []recording `json:"recordings"`
+	}{ISRC: strings.ToUpper(isrc)}
+	for _, rec := range recs {
+		out := recording{ID: rec.mbid, Title: rec.title}
+		if rec.length > 0 {
+			ms := rec.length.Milliseconds()
+			out.Length = &ms
+		}
+		data.Recordings = append(data.Recordings, out)
+	}
+	writeJSON(w, data)
+}
+
+func (s *Server) handleEntity(w http.ResponseWriter, r *http.Request, typ, mbid string) {
+	data, ok := s.entities[typ][mbid]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprint(w, data)
+}
+
+func (s *Server) handleSearch(w http.ResponseWriter, r *http.Request, typ string) {
+	q := r.URL.Query()
+	if lim := q.Get("limit"); lim != "" {
+		if n, err := strconv.Atoi(lim); err != nil || n < 1 || n > 100 {
+			http.Error(w, "Bad limit "+lim, http.StatusBadRequest)
+			return
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if data, ok := s.searches[typ][q.Get("query")]; ok {
+		fmt.Fprint(w, data)
+		return
+	}
+	plural := typ + "s"
+	if typ == "series" {
+		plural = typ
+	}
+	writeJSON(w, map[string]interface{}{"count": 0, "offset": 0, plural: []interface{}{}})
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+func escapeXML(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
@@ -0,0 +1,158 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package mbdbtest
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/derat/yambs/mbdb"
+)
+
+func TestServer(t *testing.T) {
+	const (
+		artistMBID  = "b10bbbfc-cf9e-42e0-be17-e2c3e1d2600d"
+		labelMBID   = "c5c4e3b6-4b1a-4a3f-8a8f-6e6a3b3f7f11"
+		rgMBID      = "f4a261de-5f1a-4d5c-a1e8-5ea0a83abcc7"
+		recMBID     = "0a1b2c3d-0000-4000-8000-000000000003"
+		artistURL   = "https://beatles.bandcamp.com/"
+		discogsURL  = "https://www.discogs.com/master/33008"
+		missingURL  = "https://www.example.org/"
+		isrc        = "GBAYE0601690"
+		searchQuery = `barcode:"077774644624"`
+		searchData  = `{"count":1,"releases":[{"id":"0a1b2c3d-0000-4000-8000-000000000001","title":"Abbey Road"}]}`
+	)
+
+	srv := NewServer(t)
+	srv.SetDatabaseID(artistMBID, 303)
+	srv.AddURLRel(artistURL, "artist", artistMBID, "The Beatles")
+	srv.AddURLRel(artistURL, "label", labelMBID, "Apple & Co")
+	srv.AddURLRel(discogsURL, "release-group", rgMBID, "Abbey Road")
+	srv.AddISRC(isrc, recMBID, "Come Together", 259946*time.Millisecond)
+	srv.SetEntity("artist", artistMBID, `{"id":"`+artistMBID+`","name":"The Beatles"}`)
+	srv.SetSearchResult("release", searchQuery, searchData)
+
+	db := srv.NewDB()
+	ctx := context.Background()
+
+	if id, err := db.GetDatabaseID(ctx, artistMBID); err != nil || id != 303 {
+		t.Errorf("GetDatabaseID(ctx, %q) = %v, %v; want 303, nil", artistMBID, id, err)
+	}
+	if _, err := db.GetDatabaseID(ctx, labelMBID); err == nil {
+		t.Errorf("GetDatabaseID(ctx, %q) unexpectedly succeeded", labelMBID)
+	}
+
+	if got, err := db.GetArtistsFromURLs(ctx, []string{artistURL, missingURL}); err != nil {
+		t.Error("GetArtistsFromURLs failed: ", err)
+	} else if want := map[string][]mbdb.EntityInfo{
+		artistURL:  mbdb.MakeEntityInfosForTest(artistMBID, "The Beatles"),
+		missingURL: nil,
+	}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetArtistsFromURLs returned %v; want %v", got, want)
+	}
+	if got, err := db.GetLabelsFromURL(ctx, artistURL); err != nil {
+		t.Error("GetLabelsFromURL failed: ", err)
+	} else if want := mbdb.MakeEntityInfosForTest(labelMBID, "Apple & Co"); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetLabelsFromURL returned %v; want %v", got, want)
+	}
+	if got, err := db.GetReleaseGroupsFromURL(ctx, discogsURL); err != nil {
+		t.Error("GetReleaseGroupsFromURL failed: ", err)
+	} else if want := mbdb.MakeEntityInfosForTest(rgMBID, "Abbey Road"); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetReleaseGroupsFromURL returned %v; want %v", got, want)
+	}
+
+	if got, err := db.GetRecordingsFromISRC(ctx, isrc); err != nil {
+		t.Error("GetRecordingsFromISRC failed: ", err)
+	} else if want := []mbdb.RecordingInfo{{MBID: recMBID, Title: "Come Together", Length: 259946 * time.Millisecond}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetRecordingsFromISRC returned %v; want %v", got, want)
+	}
+
+	var artist struct {
+		Name string `json:"name"`
+	}
+	if err := db.GetEntity(ctx, "artist", artistMBID, []string{"aliases"}, &artist); err != nil {
+		t.Error("GetEntity failed: ", err)
+	} else if artist.Name != "The Beatles" {
+		t.Errorf("GetEntity returned name %q; want %q", artist.Name, "The Beatles")
+	}
+
+	var search struct {
+		Count int `json:"count"`
+	}
+	if err := db.Search(ctx, "release", searchQuery, 10, &search); err != nil || search.Count != 1 {
+		t.Errorf("Search(ctx, %q) returned count %d, err %v; want 1, nil", searchQuery, search.Count, err)
+	}
+	if err := db.Search(ctx, "release", `barcode:"1"`, 10, &search); err != nil || search.Count != 0 {
+		t.Errorf("Search(ctx, %q) returned count %d, err %v; want 0, nil", `barcode:"1"`, search.Count, err)
+	}
+
+	var paths []string
+	for _, req := range srv.Requests() {
+		if req.Method != http.MethodGet || !strings.HasPrefix(req.UserAgent, "yambs/test ") {
+			t.Errorf("Got %v request for %v with User-Agent %q", req.Method, req, req.UserAgent)
+		}
+		paths = append(paths, req.Path)
+	}
+	if want := []string{
+		"/ws/js/entity/" + artistMBID,
+		"/ws/js/entity/" + labelMBID,
+		"/ws/2/url",
+		"/ws/2/url",
+		"/ws/2/url",
+		"/ws/2/isrc/" + isrc,
+		"/ws/2/artist/" + artistMBID,
+		"/ws/2/release",
+		"/ws/2/release",
+	}; !reflect.DeepEqual(paths, want) {
+		t.Errorf("Got requests for %q; want %q", paths, want)
+	}
+}
+
+func TestServer_FailNext(t *testing.T) {
+	const mbid = "b10bbbfc-cf9e-42e0-be17-e2c3e1d2600d"
+	srv := NewServer(t)
+	srv.SetDatabaseID(mbid, 303)
+	srv.FailNext(2, http.StatusServiceUnavailable)
+
+	// mbdb.DB should retry after transient errors.
+	db := srv.NewDB()
+	if id, err := db.GetDatabaseID(context.Background(), mbid); err != nil || id != 303 {
+		t.Errorf("GetDatabaseID(ctx, %q) = %v, %v; want 303, nil", mbid, id, err)
+	}
+	if n := len(srv.Requests()); n != 3 {
+		t.Errorf("Got %d request(s); want 3", n)
+	}
+}
+
+func TestServer_SetMinInterval(t *testing.T) {
+	const interval = 40 * time.Millisecond
+	srv := NewServer(t)
+	srv.SetMinInterval(interval)
+	ctx := context.Background()
+
+	// The DB should wait between requests.
+	db := srv.NewDB(mbdb.MaxQPS(int(time.Second / (interval + 10*time.Millisecond))))
+	for _, u := range []string{"https://a.example.org/", "https://b.example.org/", "https://c.example.org/"} {
+		if _, err := db.GetArtistsFromURL(ctx, u); err != nil {
+			t.Errorf("GetArtistsFromURL(ctx, %q) failed: %v", u, err)
+		}
+	}
+	if n := srv.Violations(); n != 0 {
+		t.Errorf("Got %d violation(s) with rate-limited DB; want 0", n)
+	}
+
+	// Without rate-limiting, the server should reject requests.
+	time.Sleep(interval)
+	db = srv.NewDB()
+	for _, u := range []string{"https://d.example.org/", "https://e.example.org/"} {
+		db.GetArtistsFromURL(ctx, u)
+	}
+	if n := srv.Violations(); n == 0 {
+		t.Error("Got no violations with non-rate-limited DB")
+	}
+}
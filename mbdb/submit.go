@@ -0,0 +1,374 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package mbdb
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Login returns an Option that configures DB to use HTTP digest authentication with
+// the supplied MusicBrainz username and password when submitting data.
+func Login(username, password string) Option {
+	return func(db *DB) {
+		db.username = username
+		db.password = password
+	}
+}
+
+// AccessToken returns an Option that configures DB to authenticate using the supplied
+// OAuth2 access token (with the appropriate scopes, e.g. "submit_isrc" and "tag")
+// when submitting data. It takes precedence over Login.
+func AccessToken(tok string) Option { return func(db *DB) { db.accessToken = tok } }
+
+// CanSubmit returns true if DB was configured with credentials for submitting data.
+func (db *DB) CanSubmit() bool { return db.accessToken != "" || db.username != "" }
+
+// Entity types that can be tagged or rated via the API.
+// See https://musicbrainz.org/doc/MusicBrainz_API#Submitting_data.
+var (
+	tagTypes = map[string]struct{}{
+		"area": {}, "artist": {}, "event": {}, "instrument": {}, "label": {}, "place": {},
+		"recording": {}, "release": {}, "release-group": {}, "series": {}, "work": {},
+	}
+	ratingTypes = map[string]struct{}{
+		"artist": {}, "event": {}, "label": {}, "place": {},
+		"recording": {}, "release-group": {}, "work": {},
+	}
+)
+
+// SubmitISRCs adds ISRCs to existing recordings.
+// isrcs maps from recording MBIDs to the ISRCs to add.
+func (db *DB) SubmitISRCs(ctx context.Context, isrcs map[string][]string) error {
+	if len(isrcs) == 0 {
+		return nil
+	}
+	var b bytes.Buffer
+	b.WriteString(xmlHeader + `<recording-list>`)
+	mbids := make([]string, 0, len(isrcs))
+	for mbid := range isrcs {
+		mbids = append(mbids, mbid)
+	}
+	sort.Strings(mbids)
+	for _, mbid := range mbids {
+		fmt.Fprintf(&b, `<recording id="%s"><isrc-list count="%d">`, xmlEscape(mbid), len(isrcs[mbid]))
+		for _, isrc := range isrcs[mbid] {
+			fmt.Fprintf(&b, `<isrc id="%s"/>`, xmlEscape(isrc))
+		}
+		b.WriteString(`</isrc-list></recording>`)
+	}
+	b.WriteString(`</recording-list>` + xmlFooter)
+	return db.submit(ctx, "/ws/2/recording", b.Bytes())
+}
+
+// SubmitBarcodes sets the barcodes of existing releases.
+// barcodes maps from release MBIDs to the barcodes to set.
+func (db *DB) SubmitBarcodes(ctx context.Context, barcodes map[string]string) error {
+	if len(barcodes) == 0 {
+		return nil
+	}
+	var b bytes.Buffer
+	b.WriteString(xmlHeader + `<release-list>`)
+	mbids := make([]string, 0, len(barcodes))
+	for mbid := range barcodes {
+		mbids = append(mbids, mbid)
+	}
+	sort.Strings(mbids)
+	for _, mbid := range mbids {
+		fmt.Fprintf(&b, `<release id="%s"><barcode>%s</barcode></release>`,
+			xmlEscape(mbid), xmlEscape(barcodes[mbid]))
+	}
+	b.WriteString(`</release-list>` + xmlFooter)
+	return db.submit(ctx, "/ws/2/release", b.Bytes())
+}
+
+// SubmitTags adds tags to existing entities on behalf of the authenticated user.
+// tags maps from entity types (e.g. "artist" or "release-group") to entity MBIDs to tags.
+func (db *DB) SubmitTags(ctx context.Context, tags map[string]map[string][]string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	var b bytes.Buffer
+	b.WriteString(xmlHeader)
+	types := make([]string, 0, len(tags))
+	for typ := range tags {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+	for _, typ := range types {
+		if _, ok := tagTypes[typ]; !ok {
+			return fmt.Errorf("can't tag %v entities", typ)
+		}
+		fmt.Fprintf(&b, `<%s-list>`, typ)
+		mbids := make([]string, 0, len(tags[typ]))
+		for mbid := range tags[typ] {
+			mbids = append(mbids, mbid)
+		}
+		sort.Strings(mbids)
+		for _, mbid := range mbids {
+			fmt.Fprintf(&b, `<%s id="%s"><user-tag-list>`, typ, xmlEscape(mbid))
+			for _, tag := range tags[typ][mbid] {
+				fmt.Fprintf(&b, `<user-tag><name>%s</name></user-tag>`, xmlEscape(tag))
+			}
+			fmt.Fprintf(&b, `</user-tag-list></%s>`, typ)
+		}
+		fmt.Fprintf(&b, `</%s-list>`, typ)
+	}
+	b.WriteString(xmlFooter)
+	return db.submit(ctx, "/ws/2/tag", b.Bytes())
+}
+
+// SubmitRatings rates existing entities on behalf of the authenticated user.
+// ratings maps from entity types (e.g. "artist" or "release-group") to entity MBIDs
+// to ratings between 0 and 100, where 0 removes the user's rating.
+func (db *DB) SubmitRatings(ctx context.Context, ratings map[string]map[string]int) error {
+	if len(ratings) == 0 {
+		return nil
+	}
+	var b bytes.Buffer
+	b.WriteString(xmlHeader)
+	types := make([]string, 0, len(ratings))
+	for typ := range ratings {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+	for _, typ := range types {
+		if _, ok := ratingTypes[typ]; !ok {
+			return fmt.Errorf("can't rate %v entities", typ)
+		}
+		fmt.Fprintf(&b, `<%s-list>`, typ)
+		mbids := make([]string, 0, len(ratings[typ]))
+		for mbid := range ratings[typ] {
+			mbids = append(mbids, mbid)
+		}
+		sort.Strings(mbids)
+		for _, mbid := range mbids {
+			r := ratings[typ][mbid]
+			if r < 0 || r > 100 {
+				return fmt.Errorf("invalid rating %d for %v", r, mbid)
+			}
+			fmt.Fprintf(&b, `<%s id="%s"><user-rating>%d</user-rating></%s>`, typ, xmlEscape(mbid), r, typ)
+		}
+		fmt.Fprintf(&b, `</%s-list>`, typ)
+	}
+	b.WriteString(xmlFooter)
+	return db.submit(ctx, "/ws/2/rating", b.Bytes())
+}
+
+const (
+	xmlHeader = `<?xml version="1.0" encoding="UTF-8"?>` +
+		`<metadata xmlns="http://musicbrainz.org/ns/mmd-2.0#">`
+	xmlFooter = `</metadata>`
+)
+
+// submit POSTs the supplied XML document to path.
+func (db *DB) submit(ctx context.Context, path string, body []byte) error {
+	if db.disallowQueries {
+		return errors.New("querying not allowed")
+	}
+	if !db.CanSubmit() {
+		return errors.New("no credentials supplied")
+	}
+
+	// The API requires a client ID like "example.app-0.4.7".
+	u := db.serverURL + path + "?client=" + url.QueryEscape("yambs-"+db.version)
+	newReq := func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, "POST", u, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/xml; charset=utf-8")
+		if db.accessToken != "" {
+			req.Header.Set("Authorization", "Bearer "+db.accessToken)
+		}
+		return req, nil
+	}
+
+	log.Printf("Sending %d-byte POST request to %v", len(body), u)
+	req, err := newReq()
+	if err != nil {
+		return err
+	}
+	resp, err := db.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	// Digest auth requires responding to the server's challenge.
+	if resp.StatusCode == http.StatusUnauthorized && db.accessToken == "" {
+		chal, err := parseDigestChallenge(resp.Header.Get("WWW-Authenticate"))
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("bad auth challenge: %v", err)
+		}
+		if req, err = newReq(); err != nil {
+			return err
+		}
+		cnonce, err := newCnonce()
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization",
+			chal.authorization("POST", req.URL.RequestURI(), db.username, db.password, cnonce))
+		if resp, err = db.client.Do(req); err != nil {
+			return err
+		}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("server returned %v: %v", resp.Status, readAPIError(resp.Body))
+	}
+	return nil
+}
+
+// readAPIError attempts to extract a message from an error document returned by the API, e.g.
+//
+//	<?xml version="1.0" encoding="UTF-8"?>
+//	<error><text>Invalid ISRC.</text><text>For usage, please see: ...</text></error>
+func readAPIError(r io.Reader) string {
+	var doc struct {
+		Text []string `xml:"text"`
+	}
+	if err := xml.NewDecoder(io.LimitReader(r, 64*1024)).Decode(&doc); err != nil || len(doc.Text) == 0 {
+		return "unknown error"
+	}
+	return doc.Text[0]
+}
+
+// digestChallenge contains parameters from a "WWW-Authenticate: Digest ..." header.
+// See RFC 2617.
+type digestChallenge struct {
+	realm, nonce, opaque, algorithm string
+	qopAuth                         bool // server supports qop=auth
+}
+
+// parseDigestChallenge parses the value of a WWW-Authenticate header, e.g.
+// `Digest realm="musicbrainz.org", nonce="abc", qop="auth", algorithm=MD5`.
+func parseDigestChallenge(h string) (*digestChallenge, error) {
+	const prefix = "digest "
+	if len(h) < len(prefix) || !strings.EqualFold(h[:len(prefix)], prefix) {
+		return nil, fmt.Errorf("unsupported scheme in %q", h)
+	}
+	var chal digestChallenge
+	rest := h[len(prefix):]
+	for {
+		rest = strings.TrimLeft(rest, " \t,")
+		if rest == "" {
+			break
+		}
+		eq := strings.IndexByte(rest, '=')
+		if eq < 0 {
+			return nil, fmt.Errorf("missing value in %q", rest)
+		}
+		key := strings.ToLower(strings.TrimSpace(rest[:eq]))
+		rest = rest[eq+1:]
+		var val string
+		if strings.HasPrefix(rest, `"`) {
+			var b strings.Builder
+			i := 1
+			for ; i < len(rest) && rest[i] != '"'; i++ {
+				if rest[i] == '\\' && i+1 < len(rest) {
+					i++
+				}
+				b.WriteByte(rest[i])
+			}
+			if i >= len(rest) {
+				return nil, errors.New("unterminated quoted string")
+			}
+			val, rest = b.String(), rest[i+1:]
+		} else if end := strings.IndexByte(rest, ','); end >= 0 {
+			val, rest = strings.TrimSpace(rest[:end]), rest[end:]
+		} else {
+			val, rest = strings.TrimSpace(rest), ""
+		}
+
+		switch key {
+		case "realm":
+			chal.realm = val
+		case "nonce":
+			chal.nonce = val
+		case "opaque":
+			chal.opaque = val
+		case "algorithm":
+			chal.algorithm = val
+		case "qop":
+			for _, q := range strings.Split(val, ",") {
+				if strings.TrimSpace(q) == "auth" {
+					chal.qopAuth = true
+				}
+			}
+		}
+	}
+	if chal.nonce == "" {
+		return nil, errors.New("missing nonce")
+	}
+	if chal.algorithm != "" && !strings.EqualFold(chal.algorithm, "MD5") {
+		return nil, fmt.Errorf("unsupported algorithm %q", chal.algorithm)
+	}
+	return &chal, nil
+}
+
+// authorization returns an "Authorization" header value responding to chal.
+func (chal *digestChallenge) authorization(method, uri, username, password, cnonce string) string {
+	const nc = "00000001"
+	ha1 := md5Hex(username + ":" + chal.realm + ":" + password)
+	ha2 := md5Hex(method + ":" + uri)
+	var resp string
+	if chal.qopAuth {
+		resp = md5Hex(ha1 + ":" + chal.nonce + ":" + nc + ":" + cnonce + ":auth:" + ha2)
+	} else {
+		resp = md5Hex(ha1 + ":" + chal.nonce + ":" + ha2)
+	}
+
+	q := func(s string) string { return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"` }
+	parts := []string{
+		"username=" + q(username),
+		"realm=" + q(chal.realm),
+		"nonce=" + q(chal.nonce),
+		"uri=" + q(uri),
+		"algorithm=MD5",
+		"response=" + q(resp),
+	}
+	if chal.qopAuth {
+		parts = append(parts, "qop=auth", "nc="+nc, "cnonce="+q(cnonce))
+	}
+	if chal.opaque != "" {
+		parts = append(parts, "opaque="+q(chal.opaque))
+	}
+	return "Digest " + strings.Join(parts, ", ")
+}
+
+// newCnonce returns a random client nonce for digest authentication.
+func newCnonce() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func md5Hex(s string) string {
+	sum := md5.Sum([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// xmlEscape returns s with XML special characters escaped.
+func xmlEscape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
@@ -0,0 +1,225 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package mbdb
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// fakeSubmitServer is a stand-in for the MusicBrainz API that accepts POSTed XML documents
+// from clients using digest or bearer-token authentication.
+type fakeSubmitServer struct {
+	t        *testing.T
+	user     string
+	pass     string
+	token    string
+	bodies   map[string]string // request paths to bodies
+	noAuth   int               // number of requests without credentials
+	badLogin int               // number of requests with bad credentials
+}
+
+const (
+	fakeRealm = "musicbrainz.org"
+	fakeNonce = "dcd98b7102dd2f0e8b11d0f600bfb0c093"
+)
+
+func (srv *fakeSubmitServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		srv.t.Errorf("Got %v request for %v", r.Method, r.URL)
+	}
+	if ct := r.Header.Get("Content-Type"); !strings.HasPrefix(ct, "application/xml") {
+		srv.t.Errorf("Got Content-Type %q for %v", ct, r.URL)
+	}
+	if c := r.URL.Query().Get("client"); c != "yambs-1.0" {
+		srv.t.Errorf("Got client %q for %v", c, r.URL)
+	}
+
+	auth := r.Header.Get("Authorization")
+	switch {
+	case auth == "":
+		srv.noAuth++
+		w.Header().Set("WWW-Authenticate",
+			`Digest realm="`+fakeRealm+`", nonce="`+fakeNonce+`", qop="auth", algorithm=MD5`)
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	case srv.token != "" && auth == "Bearer "+srv.token:
+	case srv.user != "" && strings.HasPrefix(auth, "Digest "):
+		if !srv.checkDigest(auth[len("Digest "):], r) {
+			srv.badLogin++
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+	default:
+		srv.badLogin++
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	b, _ := io.ReadAll(r.Body)
+	if strings.Contains(string(b), "BADISRC") {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, `<?xml version="1.0" encoding="UTF-8"?>`+
+			`<error><text>Invalid ISRC.</text><text>For usage, please see: https://musicbrainz.org/development/mmd</text></error>`)
+		return
+	}
+	srv.bodies[r.URL.Path] = string(b)
+	io.WriteString(w, `<?xml version="1.0" encoding="UTF-8"?><metadata><message><text>OK</text></message></metadata>`)
+}
+
+// checkDigest independently verifies the parameters from a digest "Authorization" header.
+func (srv *fakeSubmitServer) checkDigest(params string, r *http.Request) bool {
+	vals := make(map[string]string)
+	for _, p := range strings.Split(params, ", ") {
+		kv := strings.SplitN(p, "=", 2)
+		if len(kv) != 2 {
+			return false
+		}
+		vals[kv[0]] = strings.Trim(kv[1], `"`)
+	}
+	h := func(s string) string {
+		sum := md5.Sum([]byte(s))
+		return hex.EncodeToString(sum[:])
+	}
+	ha1 := h(srv.user + ":" + fakeRealm + ":" + srv.pass)
+	ha2 := h(r.Method + ":" + r.URL.RequestURI())
+	want := h(ha1 + ":" + fakeNonce + ":" + vals["nc"] + ":" + vals["cnonce"] + ":" + vals["qop"] + ":" + ha2)
+	return vals["username"] == srv.user && vals["realm"] == fakeRealm && vals["nonce"] == fakeNonce &&
+		vals["uri"] == r.URL.RequestURI() && vals["qop"] == "auth" && vals["response"] == want
+}
+
+func TestDB_Submit_Digest(t *testing.T) {
+	fake := &fakeSubmitServer{t: t, user: "user", pass: "secret", bodies: make(map[string]string)}
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	ctx := context.Background()
+	db := NewDB(ServerURL(srv.URL), MaxQPS(999), Version("1.0"), Login(fake.user, fake.pass))
+	if err := db.SubmitISRCs(ctx, map[string][]string{
+		"b0e6a2ea-5f55-4ab2-8c3c-4a4e04c58cf1": {"USRC17607839", "GBAYE6800011"},
+		"0e4a5a87-a1a9-4f92-a4a9-26e54b2a9d84": {"USRC17607840"},
+	}); err != nil {
+		t.Error("SubmitISRCs failed: ", err)
+	}
+	if err := db.SubmitBarcodes(ctx, map[string]string{
+		"3a2b5e6b-6a6e-4d4c-a0d5-6f7a8b9c0d1e": "0123456789012",
+	}); err != nil {
+		t.Error("SubmitBarcodes failed: ", err)
+	}
+	if err := db.SubmitTags(ctx, map[string]map[string][]string{
+		"release-group": {"f4a261de-5f1a-4d5c-a1e8-5ea0a83abcc7": {"rock", "r&b"}},
+		"artist":        {"b10bbbfc-cf9e-42e0-be17-e2c3e1d2600d": {"british"}},
+	}); err != nil {
+		t.Error("SubmitTags failed: ", err)
+	}
+	if err := db.SubmitRatings(ctx, map[string]map[string]int{
+		"recording": {"b0e6a2ea-5f55-4ab2-8c3c-4a4e04c58cf1": 80},
+	}); err != nil {
+		t.Error("SubmitRatings failed: ", err)
+	}
+
+	const (
+		hdr = `<?xml version="1.0" encoding="UTF-8"?><metadata xmlns="http://musicbrainz.org/ns/mmd-2.0#">`
+		ftr = `</metadata>`
+	)
+	for path, want := range map[string]string{
+		"/ws/2/recording": hdr + `<recording-list>` +
+			`<recording id="0e4a5a87-a1a9-4f92-a4a9-26e54b2a9d84"><isrc-list count="1"><isrc id="USRC17607840"/></isrc-list></recording>` +
+			`<recording id="b0e6a2ea-5f55-4ab2-8c3c-4a4e04c58cf1"><isrc-list count="2"><isrc id="USRC17607839"/><isrc id="GBAYE6800011"/></isrc-list></recording>` +
+			`</recording-list>` + ftr,
+		"/ws/2/release": hdr + `<release-list>` +
+			`<release id="3a2b5e6b-6a6e-4d4c-a0d5-6f7a8b9c0d1e"><barcode>0123456789012</barcode></release>` +
+			`</release-list>` + ftr,
+		"/ws/2/tag": hdr +
+			`<artist-list><artist id="b10bbbfc-cf9e-42e0-be17-e2c3e1d2600d"><user-tag-list>` +
+			`<user-tag><name>british</name></user-tag></user-tag-list></artist></artist-list>` +
+			`<release-group-list><release-group id="f4a261de-5f1a-4d5c-a1e8-5ea0a83abcc7"><user-tag-list>` +
+			`<user-tag><name>rock</name></user-tag><user-tag><name>r&amp;b</name></user-tag>` +
+			`</user-tag-list></release-group></release-group-list>` + ftr,
+		"/ws/2/rating": hdr +
+			`<recording-list><recording id="b0e6a2ea-5f55-4ab2-8c3c-4a4e04c58cf1"><user-rating>80</user-rating></recording></recording-list>` +
+			ftr,
+	} {
+		if got := fake.bodies[path]; got != want {
+			t.Errorf("%v got body:\n%s\nwant:\n%s", path, got, want)
+		}
+	}
+	if fake.noAuth != 4 || fake.badLogin != 0 {
+		t.Errorf("Got %d unauthenticated and %d bad request(s); want 4 and 0", fake.noAuth, fake.badLogin)
+	}
+
+	// Errors returned by the server should be reported.
+	err := db.SubmitISRCs(ctx, map[string][]string{"b0e6a2ea-5f55-4ab2-8c3c-4a4e04c58cf1": {"BADISRC"}})
+	if err == nil || !strings.Contains(err.Error(), "Invalid ISRC.") {
+		t.Errorf("SubmitISRCs with bad ISRC returned %v; want invalid-ISRC error", err)
+	}
+
+	// Bad passwords should be rejected.
+	db = NewDB(ServerURL(srv.URL), MaxQPS(999), Version("1.0"), Login(fake.user, "wrong"))
+	if err := db.SubmitBarcodes(ctx, map[string]string{"3a2b5e6b-6a6e-4d4c-a0d5-6f7a8b9c0d1e": "1"}); err == nil {
+		t.Error("SubmitBarcodes with wrong password unexpectedly succeeded")
+	}
+	if fake.badLogin != 1 {
+		t.Errorf("Got %d bad request(s); want 1", fake.badLogin)
+	}
+}
+
+func TestDB_Submit_Token(t *testing.T) {
+	fake := &fakeSubmitServer{t: t, token: "abc123", bodies: make(map[string]string)}
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	ctx := context.Background()
+	db := NewDB(ServerURL(srv.URL), MaxQPS(999), Version("1.0"), AccessToken(fake.token))
+	if err := db.SubmitBarcodes(ctx, map[string]string{"3a2b5e6b-6a6e-4d4c-a0d5-6f7a8b9c0d1e": "1"}); err != nil {
+		t.Error("SubmitBarcodes failed: ", err)
+	}
+	if fake.noAuth != 0 || fake.badLogin != 0 || len(fake.bodies) != 1 {
+		t.Errorf("Got %d unauthenticated, %d bad, and %d successful request(s); want 0, 0, and 1",
+			fake.noAuth, fake.badLogin, len(fake.bodies))
+	}
+
+	// Submitting without credentials should fail without sending a request.
+	db = NewDB(ServerURL(srv.URL), MaxQPS(999), Version("1.0"))
+	if err := db.SubmitBarcodes(ctx, map[string]string{"3a2b5e6b-6a6e-4d4c-a0d5-6f7a8b9c0d1e": "1"}); err == nil {
+		t.Error("SubmitBarcodes without credentials unexpectedly succeeded")
+	}
+	if err := db.SubmitRatings(ctx, map[string]map[string]int{"release": {"x": 20}}); err == nil {
+		t.Error("SubmitRatings for release unexpectedly succeeded")
+	}
+}
+
+func TestParseDigestChallenge(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want *digestChallenge // nil if error expected
+	}{
+		{`Digest realm="musicbrainz.org", nonce="abc", qop="auth", algorithm=MD5`,
+			&digestChallenge{realm: "musicbrainz.org", nonce: "abc", algorithm: "MD5", qopAuth: true}},
+		{`digest realm="a, \"b\"",nonce=xyz,opaque="o",qop="auth-int,auth"`,
+			&digestChallenge{realm: `a, "b"`, nonce: "xyz", opaque: "o", qopAuth: true}},
+		{`Digest realm="r", nonce="n"`, &digestChallenge{realm: "r", nonce: "n"}},
+		{`Basic realm="r"`, nil},
+		{`Digest realm="r"`, nil},
+		{`Digest realm="r", nonce="n", algorithm=SHA-256`, nil},
+		{`Digest realm="r, nonce="n`, nil},
+	} {
+		got, err := parseDigestChallenge(tc.in)
+		if tc.want == nil {
+			if err == nil {
+				t.Errorf("parseDigestChallenge(%q) = %+v; want error", tc.in, *got)
+			}
+		} else if err != nil {
+			t.Errorf("parseDigestChallenge(%q) failed: %v", tc.in, err)
+		} else if *got != *tc.want {
+			t.Errorf("parseDigestChallenge(%q) = %+v; want %+v", tc.in, *got, *tc.want)
+		}
+	}
+}
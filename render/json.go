@@ -0,0 +1,59 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package render
+
+import (
+	"encoding/json"
+	"io"
+	"net/url"
+
+	"github.com/derat/yambs/seed"
+)
+
+// jsonEdit is a machine-readable description of a seed.Edit written by WriteJSON.
+type jsonEdit struct {
+	Entity   seed.Entity `json:"entity"`
+	Desc     string      `json:"desc"`
+	Method   string      `json:"method"`
+	URL      string      `json:"url"`    // includes params iff GET
+	Params   url.Values  `json:"params"` // always includes all params
+	Problems []string    `json:"problems"`
+	Data     seed.Edit   `json:"data"` // typed seed struct, as written by seed.MarshalEdits
+}
+
+// WriteJSON writes a JSON array describing the supplied edits to w.
+// If ndjson is true, each edit is instead written as a separate line.
+func WriteJSON(w io.Writer, edits []seed.Edit, ndjson bool, opts ...Option) error {
+	cfg := getConfig(opts...)
+	editInfos, err := NewEditInfos(edits, cfg.serverURL)
+	if err != nil {
+		return err
+	}
+	jes := make([]jsonEdit, len(edits))
+	for i, ed := range edits {
+		info := editInfos[i]
+		jes[i] = jsonEdit{
+			Entity:   ed.Entity(),
+			Desc:     info.Desc,
+			Method:   ed.Method(),
+			URL:      info.URL,
+			Params:   ed.Params(),
+			Problems: append(info.Problems, cfg.warnings[ed]...),
+			Data:     ed,
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	if !ndjson {
+		enc.SetIndent("", "  ")
+		return enc.Encode(jes)
+	}
+	for _, je := range jes {
+		if err := enc.Encode(je); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/derat/yambs/seed"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWriteJSON(t *testing.T) {
+	rel := &seed.Release{
+		Title:   "Release Title",
+		Artists: []seed.ArtistCredit{{Name: "Release Artist"}},
+	}
+	rec := &seed.Recording{
+		Name:    "Recording Name",
+		Artists: []seed.ArtistCredit{{Name: "Recording Artist"}},
+		ISRCs:   []string{"bogus"},
+	}
+	edits := []seed.Edit{rel, rec}
+
+	const srvURL = "https://test.musicbrainz.org"
+	warnings := map[seed.Edit][]string{rel: {"Possible duplicate"}}
+
+	type edit struct {
+		Entity   seed.Entity            `json:"entity"`
+		Desc     string                 `json:"desc"`
+		Method   string                 `json:"method"`
+		URL      string                 `json:"url"`
+		Params   url.Values             `json:"params"`
+		Problems []string               `json:"problems"`
+		Data     map[string]interface{} `json:"data"`
+	}
+	var want []edit
+	for _, ed := range edits {
+		info, err := NewEditInfo(ed, srvURL)
+		if err != nil {
+			t.Fatal("NewEditInfo failed:", err)
+		}
+		b, err := json.Marshal(ed)
+		if err != nil {
+			t.Fatal("Marshal failed:", err)
+		}
+		var data map[string]interface{}
+		if err := json.Unmarshal(b, &data); err != nil {
+			t.Fatal("Unmarshal failed:", err)
+		}
+		want = append(want, edit{
+			Entity:   ed.Entity(),
+			Desc:     ed.Description(),
+			Method:   ed.Method(),
+			URL:      info.URL,
+			Params:   ed.Params(),
+			Problems: append(info.Problems, warnings[ed]...),
+			Data:     data,
+		})
+	}
+
+	for _, ndjson := range []bool{false, true} {
+		var b bytes.Buffer
+		if err := WriteJSON(&b, edits, ndjson, ServerURL(srvURL), Warnings(warnings)); err != nil {
+			t.Fatalf("WriteJSON(..., %v, ...) failed: %v", ndjson, err)
+		}
+		var got []edit
+		if ndjson {
+			lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+			for _, ln := range lines {
+				var ed edit
+				if err := json.Unmarshal([]byte(ln), &ed); err != nil {
+					t.Fatalf("Failed unmarshaling line %q: %v", ln, err)
+				}
+				got = append(got, ed)
+			}
+		} else if err := json.Unmarshal(b.Bytes(), &got); err != nil {
+			t.Fatalf("Failed unmarshaling %q: %v", b.String(), err)
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("WriteJSON(..., %v, ...) wrote unexpected edits:\n%s", ndjson, diff)
+		}
+	}
+
+	// POST edits should include their parameters, and the recording's bad ISRC should be reported.
+	if want[0].Method != "POST" || len(want[0].Params) == 0 {
+		t.Errorf("Release edit has method %q and %d param(s)", want[0].Method, len(want[0].Params))
+	}
+	if len(want[1].Problems) == 0 {
+		t.Error("Recording edit has no problems")
+	}
+}
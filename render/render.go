@@ -143,6 +143,9 @@ func Write(w io.Writer, edits []seed.Edit, opts ...Option) error {
 	if err != nil {
 		return err
 	}
+	for i, ed := range edits {
+		editInfos[i].Problems = append(editInfos[i].Problems, cfg.warnings[ed]...)
+	}
 	typeInfos := make([]typeInfo, len(seed.EntityTypes))
 	for i, t := range seed.EntityTypes {
 		typeInfos[i] = newTypeInfo(t)
@@ -170,9 +173,14 @@ func ServerURL(u string) Option { return func(cfg *config) { cfg.serverURL = u }
 // Version sets an optional yambs version to include in the page.
 func Version(v string) Option { return func(cfg *config) { cfg.version = v } }
 
+// Warnings supplies additional per-edit warnings (e.g. possible duplicates)
+// to display alongside problems reported by seed.Validate.
+func Warnings(w map[seed.Edit][]string) Option { return func(cfg *config) { cfg.warnings = w } }
+
 type config struct {
-	version   string // yambs version
-	serverURL string // base MusicBrainz server URL
+	version   string                 // yambs version
+	serverURL string                 // base MusicBrainz server URL
+	warnings  map[seed.Edit][]string // extra per-edit warnings
 }
 
 func getConfig(opts ...Option) config {
@@ -193,12 +201,14 @@ var iconSVG string
 // It's used both for passing edits to pageTmpl in CLI mode
 // and for returning edits via XHRs when running in server mode.
 type EditInfo struct {
-	Desc   string      `json:"desc"`
-	URL    string      `json:"url"`    // includes params iff GET
-	Params []paramInfo `json:"params"` // includes params iff POST
+	Desc     string      `json:"desc"`
+	Method   string      `json:"method"`   // "GET" or "POST"
+	URL      string      `json:"url"`      // includes params iff GET
+	Params   []paramInfo `json:"params"`   // all params, sorted by name
+	Problems []string    `json:"problems"` // from seed.Validate and other checks
 }
 
-// paramInfo describes a POST query parameter.
+// paramInfo describes a query parameter.
 type paramInfo struct {
 	Name  string `json:"name"`
 	Value string `json:"value"`
@@ -206,32 +216,41 @@ type paramInfo struct {
 
 // NewEditInfo converts a seed.Edit into an EditInfo struct.
 func NewEditInfo(edit seed.Edit, serverURL string) (*EditInfo, error) {
-	info := EditInfo{Desc: edit.Description()}
+	info := EditInfo{Desc: edit.Description(), Method: edit.Method()}
 
 	// Use a different approach depending on whether the edit requires a POST or not.
 	switch edit.Method() {
 	case "GET":
 		// If we can use GET, construct a URL including any parameters since <form method="GET">
 		// adds an annoying question mark even if there aren't any parameters.
-		u, err := url.Parse(edit.URL(serverURL))
-		if err != nil {
+		var err error
+		if info.URL, err = getURL(edit, serverURL); err != nil {
 			return nil, err
 		}
-		u.RawQuery = edit.Params().Encode()
-		info.URL = u.String()
 	case "POST":
 		// If we need to use POST, keep the parameters separate since <form> annoyingly
 		// clears the URL's query string.
 		info.URL = edit.URL(serverURL)
-		for name, vals := range edit.Params() {
-			for _, val := range vals {
-				info.Params = append(info.Params, paramInfo{Name: name, Value: val})
-			}
-		}
 	default:
 		return nil, fmt.Errorf("unsupported HTTP method %q", edit.Method())
 	}
 
+	// Also list all of the parameters so they can be displayed and edited in the page.
+	params := edit.Params()
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, val := range params[name] {
+			info.Params = append(info.Params, paramInfo{Name: name, Value: val})
+		}
+	}
+
+	for _, p := range seed.Validate(edit) {
+		info.Problems = append(info.Problems, p.String())
+	}
 	return &info, nil
 }
 
@@ -278,7 +297,7 @@ func newTypeInfo(typ seed.Entity) typeInfo {
 
 	return typeInfo{
 		Type:          string(typ),
-		Name:          strings.Title(string(typ)),
+		Name:          strings.Title(strings.ReplaceAll(string(typ), "-", " ")),
 		Fields:        fields,
 		SetExample:    text.SetExample(typ),
 		FieldsExample: text.FieldsExample(typ),
@@ -10,6 +10,7 @@ import (
 	"text/template"
 
 	"github.com/derat/yambs/seed"
+	"github.com/google/go-cmp/cmp"
 	"golang.org/x/net/html"
 )
 
@@ -26,6 +27,7 @@ func TestWrite_Edits(t *testing.T) {
 		&seed.Recording{
 			Name:    "Recording Name",
 			Artists: []seed.ArtistCredit{{Name: "Recording Artist"}},
+			ISRCs:   []string{"bogus"},
 		},
 		info,
 	}
@@ -46,6 +48,12 @@ func TestWrite_Edits(t *testing.T) {
 			t.Errorf("Write didn't include edit URL %q", url)
 		}
 	}
+	// The bad ISRC should be reported.
+	for _, p := range seed.Validate(edits[1]) {
+		if s := template.JSEscapeString(p.String()); !strings.Contains(b.String(), s) {
+			t.Errorf("Write didn't include problem %q", s)
+		}
+	}
 	if _, err := html.Parse(&b); err != nil {
 		t.Error("Write wrote invalid HTML:", err)
 	}
@@ -65,3 +73,50 @@ func TestWrite_Form(t *testing.T) {
 		t.Error("Write wrote invalid HTML:", err)
 	}
 }
+
+func TestNewEditInfo(t *testing.T) {
+	const srvURL = "https://test.musicbrainz.org"
+	for _, tc := range []struct {
+		edit seed.Edit
+		want EditInfo
+	}{
+		{
+			&seed.Recording{
+				Name:    "Name",
+				Artists: []seed.ArtistCredit{{Name: "Artist"}},
+			},
+			EditInfo{
+				Desc:   "Name / Artist",
+				Method: "GET",
+				URL: srvURL + "/recording/create?" +
+					"edit-recording.artist_credit.names.0.artist.name=Artist&edit-recording.name=Name",
+				Params: []paramInfo{
+					{"edit-recording.artist_credit.names.0.artist.name", "Artist"},
+					{"edit-recording.name", "Name"},
+				},
+			},
+		},
+		{
+			&seed.Release{
+				Title:   "Title",
+				Artists: []seed.ArtistCredit{{Name: "Artist"}},
+			},
+			EditInfo{
+				Desc:   "Title / Artist",
+				Method: "POST",
+				URL:    srvURL + "/release/add",
+				Params: []paramInfo{
+					{"artist_credit.names.0.artist.name", "Artist"},
+					{"name", "Title"},
+				},
+			},
+		},
+	} {
+		got, err := NewEditInfo(tc.edit, srvURL)
+		if err != nil {
+			t.Errorf("NewEditInfo(%q) failed: %v", tc.edit.Description(), err)
+		} else if diff := cmp.Diff(tc.want, *got); diff != "" {
+			t.Errorf("NewEditInfo(%q) returned unexpected info:\n%s", tc.edit.Description(), diff)
+		}
+	}
+}
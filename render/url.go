@@ -0,0 +1,75 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package render
+
+import (
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+
+	"github.com/derat/yambs/seed"
+)
+
+// EditURL returns a single URL that can be opened in a browser to seed edit.
+// For edits that use GET, this is the edit's URL with its parameters in the query string.
+// Browsers don't allow navigating to "data:" URLs, so edits that use POST (e.g. releases)
+// are instead written to new HTML files in dir (or the default temp dir if dir is empty)
+// that automatically submit forms containing the edits' parameters, and file:// URLs
+// for the files are returned.
+func EditURL(edit seed.Edit, serverURL, dir string) (string, error) {
+	switch edit.Method() {
+	case "GET":
+		return getURL(edit, serverURL)
+	case "POST":
+		f, err := ioutil.TempFile(dir, "yambs-edit-*.html")
+		if err != nil {
+			return "", err
+		}
+		if err := postTmpl.Execute(f, struct {
+			Desc   string
+			URL    string
+			Params url.Values
+		}{edit.Description(), edit.URL(serverURL), edit.Params()}); err != nil {
+			f.Close()
+			return "", err
+		}
+		if err := f.Close(); err != nil {
+			return "", err
+		}
+		p, err := filepath.Abs(f.Name())
+		if err != nil {
+			return "", err
+		}
+		return (&url.URL{Scheme: "file", Path: filepath.ToSlash(p)}).String(), nil
+	default:
+		return "", fmt.Errorf("unsupported HTTP method %q", edit.Method())
+	}
+}
+
+// getURL returns edit's URL with its parameters in the query string.
+func getURL(edit seed.Edit, serverURL string) (string, error) {
+	u, err := url.Parse(edit.URL(serverURL))
+	if err != nil {
+		return "", err
+	}
+	u.RawQuery = edit.Params().Encode()
+	return u.String(), nil
+}
+
+// postTmpl is used by EditURL to generate a self-submitting page for POST edits.
+var postTmpl = template.Must(template.New("").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>{{.Desc}}</title></head>
+<body onload="document.forms[0].submit()">
+<form method="post" action="{{.URL}}" accept-charset="utf-8">
+{{- range $name, $vals := .Params}}{{range $vals}}
+<input type="hidden" name="{{$name}}" value="{{.}}">
+{{- end}}{{end}}
+<input type="submit" value="{{.Desc}}">
+</form>
+</body>
+</html>
+`))
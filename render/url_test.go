@@ -0,0 +1,76 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package render
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/cascadia"
+	"github.com/derat/yambs/seed"
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/net/html"
+)
+
+func TestEditURL(t *testing.T) {
+	const srvURL = "https://test.musicbrainz.org"
+
+	rec := &seed.Recording{Name: "Recording Name"}
+	if got, err := EditURL(rec, srvURL, ""); err != nil {
+		t.Error("EditURL failed for recording:", err)
+	} else if want := rec.URL(srvURL) + "?" + rec.Params().Encode(); got != want {
+		t.Errorf("EditURL returned %q for recording; want %q", got, want)
+	}
+
+	rel := &seed.Release{
+		Title:   `"Quoted" & <Bracketed>`,
+		Artists: []seed.ArtistCredit{{Name: "Artist 1"}, {Name: "Artist 2"}},
+	}
+	dir := t.TempDir()
+	got, err := EditURL(rel, srvURL, dir)
+	if err != nil {
+		t.Fatal("EditURL failed for release:", err)
+	}
+	u, err := url.Parse(got)
+	if err != nil {
+		t.Fatalf("Failed parsing %q: %v", got, err)
+	} else if u.Scheme != "file" || filepath.Dir(filepath.FromSlash(u.Path)) != dir {
+		t.Fatalf("EditURL returned %q for release; want file in %v", got, dir)
+	}
+	b, err := os.ReadFile(filepath.FromSlash(u.Path))
+	if err != nil {
+		t.Fatal("Failed reading page:", err)
+	}
+	root, err := html.Parse(strings.NewReader(string(b)))
+	if err != nil {
+		t.Fatal("Failed parsing HTML:", err)
+	}
+
+	form := cascadia.Query(root, cascadia.MustCompile("form"))
+	if form == nil {
+		t.Fatalf("No form in %q", b)
+	}
+	if action := getAttr(form, "action"); action != rel.URL(srvURL) {
+		t.Errorf("Form action is %q; want %q", action, rel.URL(srvURL))
+	}
+	params := make(url.Values)
+	for _, n := range cascadia.QueryAll(form, cascadia.MustCompile(`input[type="hidden"]`)) {
+		params.Add(getAttr(n, "name"), getAttr(n, "value"))
+	}
+	if diff := cmp.Diff(rel.Params(), params); diff != "" {
+		t.Error("Bad form params:\n" + diff)
+	}
+}
+
+func getAttr(n *html.Node, name string) string {
+	for _, a := range n.Attr {
+		if a.Key == name {
+			return a.Val
+		}
+	}
+	return ""
+}
@@ -19,52 +19,58 @@ import (
 // See https://musicbrainz.org/doc/Artist for more information about artist entities.
 type Artist struct {
 	// MBID contains the artist's MBID (for editing an existing artist rather than creating a new one).
-	MBID string
+	MBID string `json:"mbid,omitempty"`
 	// Name contains the artist's official name.
-	Name string
+	Name string `json:"name,omitempty"`
 	// SortName contains a variant of the artist's name that should be used for sorting.
 	// See https://musicbrainz.org/doc/Style/Artist/Sort_Name.
-	SortName string
+	SortName string `json:"sort_name,omitempty"`
 	// Disambiguation differentiates this artist from other artists with similar names.
 	// See https://musicbrainz.org/doc/Disambiguation_Comment.
-	Disambiguation string
+	Disambiguation string `json:"disambiguation,omitempty"`
 	// Type describes whether the artist is a person, group, or something else.
-	Type ArtistType
+	Type ArtistType `json:"type,omitempty"`
 	// Gender describes how a person or character identifies. Groups do not have genders.
-	Gender Gender
+	Gender Gender `json:"gender,omitempty"`
 	// AreaName is used to fill the search field for the area with which the artist primarily
 	// identifies.
-	AreaName string
+	AreaName string `json:"area_name,omitempty"`
 	// IPICodes contains the artist's Interested Party Information code(s) assigned by the CISAC database
 	// for musical rights management. See https://musicbrainz.org/doc/IPI.
-	IPICodes []string
+	IPICodes []string `json:"ipi_codes,omitempty"`
 	// ISNICodes contains the artist's International Standard Name Identifier(s).
 	// See https://musicbrainz.org/doc/ISNI.
-	ISNICodes []string
+	ISNICodes []string `json:"isni_codes,omitempty"`
 	// BeginDate contains the date when the artist started.
 	// For a person, this is the date of birth.
 	// For a group, this is when the group was first formed.
 	// For a character, this is when the character concept was created.
-	BeginDate Date
+	BeginDate Date `json:"begin_date,omitempty"`
 	// BeginAreaName is used to fill the search field for the area where the artist started.
-	BeginAreaName string
+	BeginAreaName string `json:"begin_area_name,omitempty"`
 	// EndDate contains the date when the artist ended.
 	// For a person, this is the date of death.
 	// For a group, this is when the group was last dissolved.
 	// For a character, this should not be set.
-	EndDate Date
+	EndDate Date `json:"end_date,omitempty"`
 	// Ended describes whether the artist has ended.
-	Ended bool
+	Ended bool `json:"ended,omitempty"`
 	// EndAreaName is used to fill the search field for the area where the artist ended.
-	EndAreaName string
+	EndAreaName string `json:"end_area_name,omitempty"`
 	// Relationships contains (non-URL) relationships between this artist and other entities.
-	Relationships []Relationship
+	Relationships []Relationship `json:"relationships,omitempty"`
 	// URLs contains relationships between this artist and one or more URLs.
 	// See https://musicbrainz.org/doc/Style/Relationships/URLs.
-	URLs []URL
+	URLs []URL `json:"urls,omitempty"`
+	// Changes lists modifications to the existing artist. See Edit.
+	Changes []string `json:"changes,omitempty"`
+	// Tags contains tags to add to the existing artist. See GetSubmission.
+	Tags []string `json:"tags,omitempty"`
+	// Rating contains a 1-5 rating for the existing artist. See GetSubmission.
+	Rating int `json:"rating,omitempty"`
 	// EditNote contains the note attached to the edit.
 	// See https://musicbrainz.org/doc/Edit_Note.
-	EditNote string
+	EditNote string `json:"edit_note,omitempty"`
 }
 
 func (a *Artist) Entity() Entity { return ArtistEntity }
@@ -77,6 +83,9 @@ func (a *Artist) Description() string {
 	if a.Name != "" {
 		parts = append(parts, a.Name)
 	}
+	if len(a.Changes) > 0 {
+		parts = append(parts, strings.Join(a.Changes, "; "))
+	}
 	if len(parts) == 0 {
 		return "[unknown]"
 	}
@@ -143,4 +152,7 @@ func (a *Artist) Params() url.Values {
 
 func (a *Artist) Method() string { return http.MethodGet }
 
-func (a *Artist) Finish(ctx context.Context, db *mbdb.DB) error { return nil }
+func (a *Artist) Finish(ctx context.Context, db *mbdb.DB) error {
+	finishURLs(a.URLs, ArtistEntity)
+	return nil
+}
@@ -6,6 +6,9 @@ package seed
 import (
 	"fmt"
 	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
 )
 
 // ArtistCredit holds detailed information about a credited artist.
@@ -13,20 +16,20 @@ type ArtistCredit struct {
 	// MBID contains the artist entity's MBID, if known.
 	// This annoyingly doesn't seem to work for the /recording/create form,
 	// so ID should be set instead in that case (see the db package).
-	MBID string
+	MBID string `json:"mbid,omitempty"`
 	// ID contains the artist's database ID (i.e. the 'id' column from the 'artist' table).
 	// This is only needed for the /recording/create form, I think.
-	ID int32
+	ID int32 `json:"id,omitempty"`
 	// Name contains the artist's name for pre-filling the search field.
 	// This is unneeded if MBID or ID is set.
-	Name string
+	Name string `json:"name,omitempty"`
 	// NameAsCredited contains the name under which the artist was credited.
 	// This is only needed if it's different than MBID or Name.
 	// TODO: Actually, it seems like Name is maybe ignored in favor of NameAsCredited
 	// when seeding the standalone recording form? Investigate further.
-	NameAsCredited string
+	NameAsCredited string `json:"name_as_credited,omitempty"`
 	// JoinPhrase contains text for joining this artist's name with the next one's, e.g. " & ".
-	JoinPhrase string
+	JoinPhrase string `json:"join_phrase,omitempty"`
 }
 
 // setParams sets query parameters in vals corresponding to non-empty fields in ac.
@@ -62,3 +65,257 @@ func artistCreditsDesc(acs []ArtistCredit) string {
 	}
 	return s
 }
+
+// defaultProtectedNames contains artist names that shouldn't be split by
+// ArtistCreditParser despite containing join phrases.
+var defaultProtectedNames = []string{
+	"Above & Beyond",
+	"Belle and Sebastian",
+	"Blood, Sweat & Tears",
+	"Chase & Status",
+	"Crosby, Stills & Nash",
+	"Crosby, Stills, Nash & Young",
+	"Earth, Wind & Fire",
+	"Echo & the Bunnymen",
+	"Emerson, Lake & Palmer",
+	"Florence and the Machine",
+	"Hall & Oates",
+	"Huey Lewis and the News",
+	"Kool & the Gang",
+	"Marina and the Diamonds",
+	"Mumford & Sons",
+	"Peter, Paul and Mary",
+	"Sam & Dave",
+	"Simon & Garfunkel",
+	"Sly & the Family Stone",
+	"Tom Petty and the Heartbreakers",
+	"Wayne and Garth",
+	"Years & Years",
+}
+
+// ArtistCreditParser splits artist credit strings into individual credits.
+type ArtistCreditParser struct {
+	protected   []string       // additional protected names
+	ambiguous   bool           // also split on ambiguousJoinPhrases
+	protectedRE *regexp.Regexp // matches protected names
+	joinRE      *regexp.Regexp // matches join phrases
+}
+
+// ArtistCreditParserOption can be passed to NewArtistCreditParser to configure the parser.
+type ArtistCreditParserOption func(p *ArtistCreditParser)
+
+// ProtectedNames adds names that shouldn't be split, in addition to well-known
+// names like "Simon & Garfunkel". Names are matched case-insensitively.
+func ProtectedNames(names ...string) ArtistCreditParserOption {
+	return func(p *ArtistCreditParser) { p.protected = append(p.protected, names...) }
+}
+
+// AmbiguousJoinPhrases makes the parser also split on join phrases like " with ",
+// " x ", and non-English conjunctions that frequently appear within single artists'
+// names (e.g. "Emmylou Harris with the Nash Ramblers" or "Los Lobos y Amigos").
+func AmbiguousJoinPhrases() ArtistCreditParserOption {
+	return func(p *ArtistCreditParser) { p.ambiguous = true }
+}
+
+// NewArtistCreditParser returns a new ArtistCreditParser configured by opts.
+func NewArtistCreditParser(opts ...ArtistCreditParserOption) *ArtistCreditParser {
+	var p ArtistCreditParser
+	for _, o := range opts {
+		o(&p)
+	}
+
+	names := append(append([]string{}, defaultProtectedNames...), p.protected...)
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		// Only match full words so e.g. "Sam & Dave" isn't found in "Pam & Dave".
+		quoted[i] = regexp.QuoteMeta(name)
+		if r := []rune(name); len(r) > 0 && isWordRune(r[0]) {
+			quoted[i] = `\b` + quoted[i]
+		}
+		if r := []rune(name); len(r) > 0 && isWordRune(r[len(r)-1]) {
+			quoted[i] += `\b`
+		}
+	}
+	p.protectedRE = regexp.MustCompile(`(?i)` + strings.Join(quoted, "|"))
+
+	phrases := joinPhrases
+	if p.ambiguous {
+		phrases = append(append([]string{}, ambiguousJoinPhrases...), phrases...)
+	}
+	p.joinRE = regexp.MustCompile(`(?i)` + strings.Join(phrases, "|"))
+	return &p
+}
+
+// isWordRune returns true if r is matched by \w in regular expressions.
+func isWordRune(r rune) bool {
+	return r == '_' || (r <= unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)))
+}
+
+// defaultParser is used by ParseArtistCredits.
+var defaultParser = NewArtistCreditParser()
+
+// ParseArtistCredits calls Parse on an ArtistCreditParser without any options.
+func ParseArtistCredits(orig string) []ArtistCredit { return defaultParser.Parse(orig) }
+
+// Parse splits a credit string like "A, B & C" or "A feat. B" into individual
+// artist credits with Name and JoinPhrase set. Protected names are left intact.
+// If the string can't be split cleanly (e.g. it starts or ends with a join phrase),
+// a single credit containing the full string is returned.
+func (p *ArtistCreditParser) Parse(orig string) []ArtistCredit {
+	if orig == "" {
+		return nil
+	}
+
+	// Find join phrases that aren't part of protected names.
+	protected := p.protectedRE.FindAllStringIndex(orig, -1)
+	var ms [][]int
+	for _, m := range p.joinRE.FindAllStringIndex(orig, -1) {
+		ok := true
+		for _, p := range protected {
+			if m[0] < p[1] && m[1] > p[0] {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			ms = append(ms, m)
+		}
+	}
+	if len(ms) == 0 {
+		return []ArtistCredit{{Name: orig}}
+	}
+
+	// Get each artist's name from the part before its join phrase.
+	artists := make([]ArtistCredit, len(ms)+1)
+	for i, rng := range ms {
+		start, end := rng[0], rng[1]
+		artists[i].JoinPhrase = orig[start:end]
+
+		var prev int
+		if i > 0 {
+			prev = ms[i-1][1]
+		}
+		if prev < start {
+			artists[i].Name = orig[prev:start]
+		}
+	}
+
+	// Add the artist after the final join phrase.
+	if last := ms[len(ms)-1][1]; last < len(orig) {
+		artists[len(artists)-1].Name = orig[last:]
+	}
+
+	// If any of the artist names were blank, just give up.
+	for i := range artists {
+		if artists[i].Name == "" {
+			return []ArtistCredit{{Name: orig}}
+		}
+	}
+	return artists
+}
+
+// joinPhrases contains regular expressions matching join phrases in artist credit strings.
+// Alternatives sharing a prefix are listed longest-first.
+var joinPhrases = []string{
+	`, and `,
+	`, & `,
+	` & `,
+	` and `,
+	`, `,
+	` feat\.? `,
+	` featuring `,
+	` ft\.? `,
+	` vs\.? `,
+	` versus `,
+	` × `,
+}
+
+// ambiguousJoinPhrases is like joinPhrases but contains phrases that are also
+// common within artist names. See AmbiguousJoinPhrases.
+var ambiguousJoinPhrases = []string{
+	` with `,
+	`(?-i: x )`, // uppercase "X" appears in too many names
+	` und `,     // German
+	` et `,      // French
+	` y `,       // Spanish
+	` и `,       // Russian
+}
+
+// featRegexp matches a featured-artist credit in a title, e.g. " (feat. A & B)",
+// " [ft. A]", or " featuring A". The first non-empty submatch contains the names.
+// Unbracketed credits end at the next opening bracket (e.g. " feat. A (Live)"), and
+// unbracketed "feat" and "ft" require trailing periods to avoid false positives.
+var featRegexp = regexp.MustCompile(`(?i)\s*[(\[](?:feat\.?|ft\.?|featuring)\s+([^)\]]+)[)\]]` +
+	`|\s+(?:feat\.|ft\.|featuring)\s+([^(\[]*[^(\[\s])`)
+
+// moveFeaturedArtists removes a featured-artist credit like "(feat. A)" from title and
+// appends the featured artists to acs with a " feat. " join phrase, as required by
+// https://musicbrainz.org/doc/Style/Artist_Credits. If credited is true, the featured
+// artists' names are assigned to NameAsCredited rather than Name.
+//
+// Artists that are already present in acs aren't added again. If acs is empty,
+// the title is left unchanged, since there's no main artist to attach the featured
+// artists to.
+func moveFeaturedArtists(title string, acs []ArtistCredit, credited bool) (string, []ArtistCredit) {
+	if len(acs) == 0 {
+		return title, acs
+	}
+	m := featRegexp.FindStringSubmatchIndex(title)
+	if m == nil {
+		return title, acs
+	}
+	var names string
+	if m[2] >= 0 {
+		names = title[m[2]:m[3]]
+	} else {
+		names = title[m[4]:m[5]]
+	}
+	names = strings.TrimSpace(names)
+	if names == "" {
+		return title, acs
+	}
+	title = title[:m[0]] + title[m[1]:]
+
+	// Skip artists whose names already appear in the credits, including within
+	// unsplit credits, e.g. "A" in "B feat. A".
+	existing := make(map[string]bool)
+	for _, ac := range acs {
+		for _, name := range []string{ac.Name, ac.NameAsCredited} {
+			existing[normalizeArtistName(name)] = true
+			for _, part := range ParseArtistCredits(name) {
+				existing[normalizeArtistName(part.Name)] = true
+			}
+		}
+	}
+	var feat []ArtistCredit
+	for _, ac := range ParseArtistCredits(names) {
+		if !existing[normalizeArtistName(ac.Name)] {
+			feat = append(feat, ac)
+		}
+	}
+	if len(feat) == 0 {
+		return title, acs
+	}
+	if credited {
+		for i := range feat {
+			feat[i].NameAsCredited, feat[i].Name = feat[i].Name, ""
+		}
+	}
+	feat[len(feat)-1].JoinPhrase = ""
+
+	// Copy acs so the caller's slice (which may be shared with other tracks) isn't modified.
+	res := append(make([]ArtistCredit, 0, len(acs)+len(feat)), acs...)
+	res[len(res)-1].JoinPhrase = " feat. "
+	return title, append(res, feat...)
+}
+
+// normalizeArtistName lowercases name and drops everything other than letters and digits
+// so that e.g. "B.o.B" and "B.o.B." can be compared.
+func normalizeArtistName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, name)
+}
@@ -0,0 +1,123 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package seed
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseArtistCredits(t *testing.T) {
+	for _, tc := range []struct {
+		orig string
+		want []ArtistCredit
+	}{
+		{"Artist 1", credits("Artist 1")},
+		{"Artist 1 & Artist 2", credits("Artist 1", " & ", "Artist 2")},
+		{"Artist 1, Artist 2 & Artist 3", credits("Artist 1", ", ", "Artist 2", " & ", "Artist 3")},
+		{"Artist 1 feat. Artist 2", credits("Artist 1", " feat. ", "Artist 2")},
+		{"Artist 1 & Artist 2 feat. Artist 3", credits("Artist 1", " & ", "Artist 2", " feat. ", "Artist 3")},
+		{"A feat. B & C", credits("A", " feat. ", "B", " & ", "C")},
+		{"A Ft B", credits("A", " Ft ", "B")},
+		{"A featuring B", credits("A", " featuring ", "B")},
+		{"A x B", credits("A x B")},
+		{"A vs. B", credits("A", " vs. ", "B")},
+		{"A VS B", credits("A", " VS ", "B")},
+		{"A with the B Orchestra", credits("A with the B Orchestra")},
+		{"A, B and C", credits("A", ", ", "B", " and ", "C")},
+		{"A, B, and C", credits("A", ", ", "B", ", and ", "C")},
+		{"A y B", credits("A y B")},
+		{"Simon & Garfunkel", credits("Simon & Garfunkel")},
+		{"simon & garfunkel feat. A", credits("simon & garfunkel", " feat. ", "A")},
+		{"Earth, Wind & Fire feat. A", credits("Earth, Wind & Fire", " feat. ", "A")},
+		// Protected names should only match full words.
+		{"Sam & Dave & Busters", credits("Sam & Dave", " & ", "Busters")},
+		{"Sam & Daves", credits("Sam", " & ", "Daves")},
+		{"Pisam & Dave", credits("Pisam", " & ", "Dave")},
+		// Check that bad input is handled reasonably.
+		{"Artist 1 & ", credits("Artist 1 & ")},
+		{" & Artist 1", credits(" & Artist 1")},
+		{" & ", credits(" & ")},
+		{", & ", credits(", & ")},
+		{",  & ", credits(",  & ")},
+		{"", nil},
+	} {
+		if diff := cmp.Diff(tc.want, ParseArtistCredits(tc.orig)); diff != "" {
+			t.Errorf("ParseArtistCredits(%q) returned wrong credits:\n%s", tc.orig, diff)
+		}
+	}
+
+	// Check that callers can protect additional names.
+	if diff := cmp.Diff(credits("Me and You", " & ", "Them"),
+		NewArtistCreditParser(ProtectedNames("Me and You")).Parse("Me and You & Them")); diff != "" {
+		t.Error("Parse didn't protect added name:\n" + diff)
+	}
+	// Added names shouldn't affect other parsers.
+	if diff := cmp.Diff(credits("Me", " and ", "You", " & ", "Them"),
+		ParseArtistCredits("Me and You & Them")); diff != "" {
+		t.Error("ParseArtistCredits protected name added to other parser:\n" + diff)
+	}
+}
+
+func TestArtistCreditParser_AmbiguousJoinPhrases(t *testing.T) {
+	p := NewArtistCreditParser(AmbiguousJoinPhrases())
+	for _, tc := range []struct {
+		orig string
+		want []ArtistCredit
+	}{
+		{"A x B", credits("A", " x ", "B")},
+		{"Malcolm X", credits("Malcolm X")},
+		{"Brother X Band", credits("Brother X Band")},
+		{"A with the B Orchestra", credits("A", " with ", "the B Orchestra")},
+		{"A und B", credits("A", " und ", "B")},
+		{"A et B", credits("A", " et ", "B")},
+		{"A y B", credits("A", " y ", "B")},
+		{"Кино и Цой", credits("Кино", " и ", "Цой")},
+		{"A, B & C", credits("A", ", ", "B", " & ", "C")},
+		{"Earth, Wind & Fire with A", credits("Earth, Wind & Fire", " with ", "A")},
+	} {
+		if diff := cmp.Diff(tc.want, p.Parse(tc.orig)); diff != "" {
+			t.Errorf("Parse(%q) returned wrong credits:\n%s", tc.orig, diff)
+		}
+	}
+}
+
+func TestMoveFeaturedArtists(t *testing.T) {
+	for _, tc := range []struct {
+		title     string
+		acs       []ArtistCredit
+		wantTitle string
+		want      []ArtistCredit
+	}{
+		{"Song (feat. B)", credits("A"), "Song", credits("A", " feat. ", "B")},
+		{"Song (feat. A)", credits("A"), "Song", credits("A")},
+		{"Song (feat. B.o.B.)", credits("B.o.B"), "Song", credits("B.o.B")},
+		// Featured artists whose names are substrings of existing artists' names should be added.
+		{"Song (feat. Anna)", credits("Rihanna"), "Song", credits("Rihanna", " feat. ", "Anna")},
+		{"Song (ft. B)", credits("A ft. B"), "Song", credits("A ft. B")},
+		{"Song (feat. B)", nil, "Song (feat. B)", nil},
+	} {
+		title, acs := moveFeaturedArtists(tc.title, tc.acs, false)
+		if title != tc.wantTitle {
+			t.Errorf("moveFeaturedArtists(%q, ...) returned title %q; want %q", tc.title, title, tc.wantTitle)
+		}
+		if diff := cmp.Diff(tc.want, acs); diff != "" {
+			t.Errorf("moveFeaturedArtists(%q, ...) returned wrong credits:\n%s", tc.title, diff)
+		}
+	}
+}
+
+// credits constructs a slice of ArtistCredits from alternating names and join phrases.
+func credits(vals ...string) []ArtistCredit {
+	acs := make([]ArtistCredit, (len(vals)+1)/2)
+	for i, v := range vals {
+		if i%2 == 0 {
+			acs[i/2].Name = v
+		} else {
+			acs[i/2].JoinPhrase = v
+		}
+	}
+	return acs
+}
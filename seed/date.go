@@ -13,11 +13,11 @@ import (
 // Individual components may be left unset if unknown.
 type Date struct {
 	// Year contains a year, or 0 if unknown.
-	Year int
+	Year int `json:"year,omitempty"`
 	// Month contains a 1-indexed month, or 0 if unknown.
-	Month int
+	Month int `json:"month,omitempty"`
 	// Day contains a 1-indexed day, or 0 if unknown.
-	Day int
+	Day int `json:"day,omitempty"`
 }
 
 // MakeDate constructs a full Date object from the supplied components.
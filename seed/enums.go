@@ -2963,6 +2963,36 @@ const (
 	MediumFormat_VHS             MediumFormat = "VHS"
 )
 
+// PlaceType describes a place's main function.
+type PlaceType int
+
+const (
+	// A place designed for non-live production of music, typically a recording
+	// studio.
+	PlaceType_Studio PlaceType = 1
+	// A place that has live artistic performances as one of its primary
+	// functions, such as a concert hall.
+	PlaceType_Venue PlaceType = 2
+	// Anything which does not fit into the above categories.
+	PlaceType_Other PlaceType = 3
+	// A place whose main purpose is to host outdoor sport events, typically
+	// consisting of a pitch surrounded by a structure for spectators with no roof,
+	// or a roof which can be retracted.
+	PlaceType_Stadium PlaceType = 4
+	// A place consisting of a large enclosed area with a central event space
+	// surrounded by tiered seating for spectators, which can be used for indoor
+	// sports, concerts and other entertainment events.
+	PlaceType_IndoorArena PlaceType = 5
+	// A place mostly designed and used for religious purposes, like a church,
+	// cathedral or mosque.
+	PlaceType_ReligiousBuilding PlaceType = 6
+	// A school, university or other similar educational institution (especially,
+	// but not only, one where music is taught)
+	PlaceType_EducationalInstitution PlaceType = 7
+	// A place (generally a factory) at which physical media are manufactured.
+	PlaceType_PressingPlant PlaceType = 8
+)
+
 // ReleaseGroupType describes a release group. A release group can be assigned
 // a single primary type and multiple secondary types.
 type ReleaseGroupType string
@@ -3056,6 +3086,41 @@ const (
 	ReleaseStatus_Cancelled ReleaseStatus = "Cancelled"
 )
 
+// SeriesOrderingType describes how the items in a series are ordered.
+type SeriesOrderingType int
+
+const (
+	// Sorts the items in the series automatically by their number attributes,
+	// using a natural sort order.
+	SeriesOrderingType_Automatic SeriesOrderingType = 1
+	// Allows for manually setting the position of each item in the series.
+	SeriesOrderingType_Manual SeriesOrderingType = 2
+)
+
+// SeriesType describes the type of entities that are contained in a series.
+type SeriesType int
+
+const (
+	// A series of release groups.
+	SeriesType_ReleaseGroupSeries SeriesType = 1
+	// A series of releases.
+	SeriesType_ReleaseSeries SeriesType = 2
+	// A series of recordings.
+	SeriesType_RecordingSeries SeriesType = 3
+	// A series of works.
+	SeriesType_WorkSeries SeriesType = 4
+	// A series of works which form a catalogue of classical compositions.
+	SeriesType_Catalogue SeriesType = 5
+	// A series of events.
+	SeriesType_EventSeries SeriesType = 6
+	// A series of related concerts by an artist in different locations.
+	SeriesType_Tour SeriesType = 7
+	// A recurring festival, usually happening annually in the same location.
+	SeriesType_Festival SeriesType = 8
+	// A series of performances of the same show at the same venue.
+	SeriesType_Run SeriesType = 9
+)
+
 // WorkAttributeType describes an attribute attached to a work.
 type WorkAttributeType int
 
@@ -19,34 +19,36 @@ import (
 // See https://musicbrainz.org/doc/Event for more information about event entities.
 type Event struct {
 	// MBID contains the event's MBID (for editing an existing event rather than creating a new one).
-	MBID string
+	MBID string `json:"mbid,omitempty"`
 	// Name contains the event's name.
-	Name string
+	Name string `json:"name,omitempty"`
 	// Disambiguation differentiates this event from other events with similar names.
 	// See https://musicbrainz.org/doc/Disambiguation_Comment.
-	Disambiguation string
+	Disambiguation string `json:"disambiguation,omitempty"`
 	// Type describes the kind of that the event is.
 	// See https://musicbrainz.org/doc/Event#Type.
-	Type EventType
+	Type EventType `json:"type,omitempty"`
 	// Cancelled is true if the event was cancelled (i.e. it did not take place).
-	Cancelled bool
+	Cancelled bool `json:"cancelled,omitempty"`
 	// Setlist contains a list of the songs which were performed.
 	// See https://musicbrainz.org/doc/Event/Setlist for details.
-	Setlist string
+	Setlist string `json:"setlist,omitempty"`
 	// BeginDate contains the date when the event started.
-	BeginDate Date
+	BeginDate Date `json:"begin_date,omitempty"`
 	// EndDate contains the date when the ended ended.
-	EndDate Date
+	EndDate Date `json:"end_date,omitempty"`
 	// Time contains the event's start time in "HH:MM" format.
-	Time string
+	Time string `json:"time,omitempty"`
 	// Relationships contains (non-URL) relationships between this event and other entities.
-	Relationships []Relationship
+	Relationships []Relationship `json:"relationships,omitempty"`
 	// URLs contains relationships between this event and one or more URLs.
 	// See https://musicbrainz.org/doc/Style/Relationships/URLs.
-	URLs []URL
+	URLs []URL `json:"urls,omitempty"`
+	// Changes lists modifications to the existing event. See Edit.
+	Changes []string `json:"changes,omitempty"`
 	// EditNote contains the note attached to the edit.
 	// See https://musicbrainz.org/doc/Edit_Note.
-	EditNote string
+	EditNote string `json:"edit_note,omitempty"`
 }
 
 func (e *Event) Entity() Entity { return EventEntity }
@@ -59,6 +61,9 @@ func (e *Event) Description() string {
 	if e.Name != "" {
 		parts = append(parts, e.Name)
 	}
+	if len(e.Changes) > 0 {
+		parts = append(parts, strings.Join(e.Changes, "; "))
+	}
 	if len(parts) == 0 {
 		return "[unknown]"
 	}
@@ -108,4 +113,7 @@ func (e *Event) Params() url.Values {
 
 func (e *Event) Method() string { return http.MethodGet }
 
-func (e *Event) Finish(ctx context.Context, db *mbdb.DB) error { return nil }
+func (e *Event) Finish(ctx context.Context, db *mbdb.DB) error {
+	finishURLs(e.URLs, EventEntity)
+	return nil
+}
@@ -26,6 +26,7 @@ const (
 	dumpVar    = "MBDUMP_SAMPLE" // env var pointing at extracted dump
 	dumpURL    = "https://data.metabrainz.org/pub/musicbrainz/data/sample/"
 	dstPath    = "enums.go" // this program is run from 'seed' dir
+	namesPath  = "link_names.go"
 	mdPath     = "full_enums.md"
 	mdURL      = "https://github.com/derat/yambs/blob/main/seed/" + mdPath
 	commentLen = 80 - 4 // account for "\t// "
@@ -78,6 +79,34 @@ type enumValue struct {
 	EOL     string // end-of-line comment
 }
 
+// nameValue associates a name from the database with an integer ID.
+type nameValue struct {
+	Name  string
+	Value string
+}
+
+// sortNames sorts nvs by name and returns it.
+func sortNames(nvs []nameValue) []nameValue {
+	sort.Slice(nvs, func(i, j int) bool { return nvs[i].Name < nvs[j].Name })
+	return nvs
+}
+
+// sortIDs sorts nvs by integer value and returns it.
+func sortIDs(nvs []nameValue) []nameValue {
+	sort.Slice(nvs, func(i, j int) bool {
+		vi, _ := strconv.Atoi(nvs[i].Value)
+		vj, _ := strconv.Atoi(nvs[j].Value)
+		return vi < vj
+	})
+	return nvs
+}
+
+// linkNameGroup contains the names of link types between two entity types.
+type linkNameGroup struct {
+	Type0, Type1 string // e.g. "artist" and "release_group"
+	Names        []nameValue
+}
+
 type sortType int // sortType describes how enumType.Values should be sorted
 
 const (
@@ -169,6 +198,7 @@ func main() {
 		Name: "Language",
 		sort: sortName,
 	})
+	var langNames []nameValue // all languages, including low-frequency ones
 	readTable("language", func(row []string) {
 		id, name, freq := row[0], row[4], row[5]
 		if freq != "0" {
@@ -179,6 +209,7 @@ func main() {
 			})
 		}
 		fullLangs.add(enumValue{Name: name, Value: id})
+		langNames = append(langNames, nameValue{Name: name, Value: id})
 	})
 
 	// Count the frequency of different link attribute types in the sample.
@@ -198,6 +229,7 @@ func main() {
 		Name: "LinkAttributeType",
 		sort: sortName,
 	})
+	var linkAttrNames []nameValue // all types, including infrequent instruments
 	readTable("link_attribute_type", func(row []string) {
 		id, root, name, desc := row[0], row[2], row[5], row[6]
 		// There are a bit over a thousand types corresponding to instruments, so only include ones
@@ -220,6 +252,10 @@ func main() {
 			Value:   id,
 			Comment: desc,
 		})
+		if v, ok := linkAttrTypeMappings[id]; ok {
+			name = v
+		}
+		linkAttrNames = append(linkAttrNames, nameValue{name, id})
 	})
 
 	linkTypes := enums.add(&enumType{
@@ -229,6 +265,7 @@ func main() {
 			`Only link types relating to entity types that can be seeded by yambs are included.`,
 		sort: sortName,
 	})
+	linkNames := make(map[[2]string][]nameValue) // keyed by entity types
 	readTable("link_type", func(row []string) {
 		id, type0, type1, name, desc := row[0], row[4], row[5], row[6], row[7]
 		if seedEntityTypes[type0] || seedEntityTypes[type1] {
@@ -237,6 +274,8 @@ func main() {
 				Value:   id,
 				Comment: desc,
 			})
+			key := [2]string{type0, type1}
+			linkNames[key] = append(linkNames[key], nameValue{name, id})
 		}
 	})
 
@@ -254,14 +293,31 @@ func main() {
 		})
 	})
 
+	placeTypes := enums.add(&enumType{
+		Name:    "PlaceType",
+		Type:    "int",
+		Comment: `PlaceType describes a place's main function.`,
+		sort:    sortValue,
+	})
+	readTable("place_type", func(row []string) {
+		id, name, desc := row[0], row[1], row[4]
+		placeTypes.add(enumValue{
+			Name:    clean(name),
+			Value:   id,
+			Comment: desc,
+		})
+	})
+
 	releaseGroupTypes := enums.add(&enumType{
 		Name: "ReleaseGroupType",
 		Type: "string",
 		Comment: `ReleaseGroupType describes a release group. ` +
 			`A release group can be assigned a single primary type and multiple secondary types.`,
 	})
+	var rgPrimaryTypes, rgSecondaryTypes []nameValue // names are enum value suffixes
 	readTable("release_group_primary_type", func(row []string) {
-		name := row[1]
+		id, name := row[0], row[1]
+		rgPrimaryTypes = append(rgPrimaryTypes, nameValue{clean(name), id})
 		releaseGroupTypes.add(enumValue{
 			Name:  clean(name),
 			Value: fmt.Sprintf("%q", name),
@@ -269,7 +325,8 @@ func main() {
 		})
 	})
 	readTable("release_group_secondary_type", func(row []string) {
-		name := row[1]
+		id, name := row[0], row[1]
+		rgSecondaryTypes = append(rgSecondaryTypes, nameValue{clean(name), id})
 		releaseGroupTypes.add(enumValue{
 			Name:  clean(name),
 			Value: fmt.Sprintf("%q", name),
@@ -305,6 +362,36 @@ func main() {
 		})
 	})
 
+	seriesOrderingTypes := enums.add(&enumType{
+		Name:    "SeriesOrderingType",
+		Type:    "int",
+		Comment: `SeriesOrderingType describes how the items in a series are ordered.`,
+		sort:    sortValue,
+	})
+	readTable("series_ordering_type", func(row []string) {
+		id, name, desc := row[0], row[1], row[4]
+		seriesOrderingTypes.add(enumValue{
+			Name:    clean(name),
+			Value:   id,
+			Comment: desc,
+		})
+	})
+
+	seriesTypes := enums.add(&enumType{
+		Name:    "SeriesType",
+		Type:    "int",
+		Comment: `SeriesType describes the type of entities that are contained in a series.`,
+		sort:    sortValue,
+	})
+	readTable("series_type", func(row []string) {
+		id, name, desc := row[0], row[1], row[5]
+		seriesTypes.add(enumValue{
+			Name:    clean(name),
+			Value:   id,
+			Comment: desc,
+		})
+	})
+
 	workAttrTypes := enums.add(&enumType{
 		Name:    "WorkAttributeType",
 		Type:    "int",
@@ -349,32 +436,39 @@ func main() {
 	fullEnums.finish()
 
 	// Write the file.
-	funcMap := map[string]interface{}{
-		"wrap": func(s string) []string { return wrap(s, commentLen) },
-	}
-	tmpl := template.Must(template.New("").Funcs(funcMap).Parse(fileTemplate))
-	f, err := os.Create(dstPath)
-	if err != nil {
-		log.Fatal(err)
-	}
-	if err := tmpl.Execute(f, struct {
+	writeGoFile(dstPath, fileTemplate, struct {
 		Time  string
 		Enums []*enumType
 	}{
 		Time:  strings.TrimSpace(string(ts)),
 		Enums: enums.types,
-	}); err != nil {
-		f.Close()
-		log.Fatal(err)
-	}
-	if err := f.Close(); err != nil {
-		log.Fatal(err)
-	}
+	})
 
-	// Format the file.
-	if err := exec.Command("gofmt", "-w", dstPath).Run(); err != nil {
-		log.Fatalf("gofmt failed on %v: %v", dstPath, err)
+	// Write the file containing name-to-ID indexes for link types, link attribute types,
+	// and release group types, and the names of all languages.
+	var linkGroups []linkNameGroup
+	for key, names := range linkNames {
+		linkGroups = append(linkGroups, linkNameGroup{key[0], key[1], sortNames(names)})
 	}
+	sort.Slice(linkGroups, func(i, j int) bool {
+		gi, gj := linkGroups[i], linkGroups[j]
+		return gi.Type0 < gj.Type0 || (gi.Type0 == gj.Type0 && gi.Type1 < gj.Type1)
+	})
+	writeGoFile(namesPath, namesTemplate, struct {
+		Time      string
+		LinkTypes []linkNameGroup
+		LinkAttrs []nameValue
+		Languages []nameValue
+		RGPrimary []nameValue
+		RGSecond  []nameValue
+	}{
+		Time:      strings.TrimSpace(string(ts)),
+		LinkTypes: linkGroups,
+		LinkAttrs: sortNames(linkAttrNames),
+		Languages: sortNames(langNames),
+		RGPrimary: sortIDs(rgPrimaryTypes),
+		RGSecond:  sortIDs(rgSecondaryTypes),
+	})
 
 	// Also write the MarkDown file with full definitions.
 	mdTmpl := template.Must(template.New("").Funcs(funcMap).Parse(mdTemplate))
@@ -391,6 +485,30 @@ func main() {
 	}
 }
 
+var funcMap = map[string]interface{}{
+	"wrap": func(s string) []string { return wrap(s, commentLen) },
+}
+
+// writeGoFile executes the supplied template with data, writes the result
+// to p, and formats it using gofmt. It crashes if an error is encountered.
+func writeGoFile(p, tmplText string, data interface{}) {
+	tmpl := template.Must(template.New("").Funcs(funcMap).Parse(tmplText))
+	f, err := os.Create(p)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := tmpl.Execute(f, data); err != nil {
+		f.Close()
+		log.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		log.Fatal(err)
+	}
+	if err := exec.Command("gofmt", "-w", p).Run(); err != nil {
+		log.Fatalf("gofmt failed on %v: %v", p, err)
+	}
+}
+
 // openFile opens the named relative path under the dump directory.
 // It crashes if an error is encountered.
 func openFile(rel string) *os.File {
@@ -456,6 +574,66 @@ const (
 {{end}}
 `
 
+// namesTemplate is used to generate namesPath.
+const namesTemplate = `
+package seed
+
+// This file was generated from a dump of the MusicBrainz database
+// (https://musicbrainz.org/doc/MusicBrainz_Database/Download)
+// initiated at {{.Time}}.
+//
+// MusicBrainz database dumps are distributed under the CC0 license:
+// https://creativecommons.org/publicdomain/zero/1.0/
+//
+// This file can be regenerated by running "go generate".
+
+// linkTypeNames maps pairs of entity types (as named in the database,
+// e.g. "release_group") to the names of link types between them.
+// See FindLinkType.
+var linkTypeNames = map[[2]string]map[string]LinkType{
+{{range .LinkTypes -}}
+{ {{- printf "%q" .Type0}}, {{printf "%q" .Type1 -}} }: {
+{{range .Names -}}
+{{printf "%q" .Name}}: {{.Value}},
+{{end -}}
+},
+{{end -}}
+}
+
+// linkAttrTypeNames maps the names of link attribute types to IDs.
+// Unlike the LinkAttributeType enum, infrequently-used instruments are included.
+// See FindLinkAttributeType.
+var linkAttrTypeNames = map[string]LinkAttributeType{
+{{range .LinkAttrs -}}
+{{printf "%q" .Name}}: {{.Value}},
+{{end -}}
+}
+
+// languageNames maps the IDs of all languages to their names.
+// Unlike the Language enum, low-frequency languages are included.
+var languageNames = map[Language]string{
+{{range .Languages -}}
+{{.Value}}: {{printf "%q" .Name}},
+{{end -}}
+}
+
+// rgPrimaryTypeIDs maps from primary release group types to IDs from the
+// release_group_primary_type table.
+var rgPrimaryTypeIDs = map[ReleaseGroupType]int{
+{{range .RGPrimary -}}
+ReleaseGroupType_{{.Name}}: {{.Value}},
+{{end -}}
+}
+
+// rgSecondaryTypeIDs maps from secondary release group types to IDs from the
+// release_group_secondary_type table.
+var rgSecondaryTypeIDs = map[ReleaseGroupType]int{
+{{range .RGSecond -}}
+ReleaseGroupType_{{.Name}}: {{.Value}},
+{{end -}}
+}
+`
+
 // mdTemplate is used to generate mdPath.
 const mdTemplate = `# Full MusicBrainz enums
 
@@ -495,9 +673,11 @@ var seedEntityTypes = map[string]bool{
 	"artist":        true,
 	"event":         true,
 	"label":         true,
+	"place":         true,
 	"recording":     true,
 	"release":       true,
 	"release_group": true,
+	"series":        true,
 	"work":          true,
 }
 
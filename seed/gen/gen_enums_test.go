@@ -4,7 +4,10 @@
 package main
 
 import (
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -35,3 +38,108 @@ func TestWrap(t *testing.T) {
 		}
 	}
 }
+
+// runGen writes the supplied tables (keyed by name, with rows of tab-separated
+// columns) to a fake dump, runs the generator in a temp dir, and returns the
+// contents of the generated link names file. Tables that aren't supplied are empty.
+func runGen(t *testing.T, tables map[string][]string) string {
+	dumpDir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dumpDir, "mbdump"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dumpDir, "TIMESTAMP"), []byte("2023-01-01 00:00:00+00\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	for _, tbl := range []string{
+		"artist_type", "event_type", "gender", "label_type", "language",
+		"link_attribute", "link_attribute_type", "link_type", "medium_format",
+		"place_type", "release_group_primary_type", "release_group_secondary_type",
+		"release_packaging", "release_status", "series_ordering_type", "series_type",
+		"work_attribute_type", "work_type",
+	} {
+		var data string
+		if rows := tables[tbl]; len(rows) > 0 {
+			data = strings.Join(rows, "\n") + "\n"
+		}
+		if err := os.WriteFile(filepath.Join(dumpDir, "mbdump", tbl), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	outDir := t.TempDir()
+	if err := os.Chdir(outDir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+	t.Setenv(dumpVar, dumpDir)
+	main()
+
+	b, err := os.ReadFile(filepath.Join(outDir, namesPath))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestMain_ReleaseGroupTypes(t *testing.T) {
+	got := runGen(t, map[string][]string{
+		"release_group_primary_type": {
+			"11\tOther\t\\N\t99\t\\N\t4fc3f3f1-0000-0000-0000-000000000000",
+			"1\tAlbum\t\\N\t1\t\\N\tf529b476-0000-0000-0000-000000000000",
+		},
+		"release_group_secondary_type": {
+			"6\tLive\t\\N\t0\t\\N\t6fd474e2-0000-0000-0000-000000000000",
+			"8\tDJ-mix\t\\N\t0\t\\N\t0d47f47a-0000-0000-0000-000000000000",
+		},
+	})
+	for _, want := range []string{
+		"var rgPrimaryTypeIDs = map[ReleaseGroupType]int{\n" +
+			"\tReleaseGroupType_Album: 1,\n" +
+			"\tReleaseGroupType_Other: 11,\n" +
+			"}\n",
+		"var rgSecondaryTypeIDs = map[ReleaseGroupType]int{\n" +
+			"\tReleaseGroupType_Live:  6,\n" +
+			"\tReleaseGroupType_DJMix: 8,\n" +
+			"}\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Generated file doesn't contain %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestMain_LinkTypes(t *testing.T) {
+	// Columns are id, parent, child_order, gid, entity_type0, entity_type1, name, and description.
+	// IDs are arbitrary.
+	row := func(id, type0, type1, name string) string {
+		return strings.Join([]string{id, `\N`, "0", "00000000-0000-0000-0000-00000000" + id, type0, type1, name, "Desc"}, "\t")
+	}
+	got := runGen(t, map[string][]string{
+		"link_type": {
+			row("9001", "area", "place", "part of"),
+			row("9002", "place", "place", "part of"),
+			row("9003", "place", "url", "wikidata"),
+			row("9004", "series", "series", "part of"),
+			row("9005", "series", "url", "wikidata"),
+			row("9006", "area", "url", "wikidata"), // not seedable
+		},
+	})
+	for _, want := range []string{
+		"{\"area\", \"place\"}: {\n\t\t\"part of\": 9001,\n\t},\n",
+		"{\"place\", \"place\"}: {\n\t\t\"part of\": 9002,\n\t},\n",
+		"{\"place\", \"url\"}: {\n\t\t\"wikidata\": 9003,\n\t},\n",
+		"{\"series\", \"series\"}: {\n\t\t\"part of\": 9004,\n\t},\n",
+		"{\"series\", \"url\"}: {\n\t\t\"wikidata\": 9005,\n\t},\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Generated file doesn't contain %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, `{"area", "url"}`) {
+		t.Errorf("Generated file contains area-url link types:\n%s", got)
+	}
+}
@@ -5,6 +5,7 @@ package seed
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/url"
 
@@ -45,3 +46,30 @@ func (in *Info) URL(serverURL string) string {
 func (in *Info) Params() url.Values                            { return in.params }
 func (in *Info) Method() string                                { return http.MethodGet }
 func (in *Info) Finish(ctx context.Context, db *mbdb.DB) error { return nil }
+
+// infoJSON is used to marshal Info objects to JSON.
+type infoJSON struct {
+	Desc string `json:"desc"`
+	URL  string `json:"url"` // includes query params
+}
+
+func (in *Info) MarshalJSON() ([]byte, error) {
+	u := in.url
+	if len(in.params) > 0 {
+		u += "?" + in.params.Encode()
+	}
+	return json.Marshal(infoJSON{Desc: in.desc, URL: u})
+}
+
+func (in *Info) UnmarshalJSON(b []byte) error {
+	var ij infoJSON
+	if err := json.Unmarshal(b, &ij); err != nil {
+		return err
+	}
+	n, err := NewInfo(ij.Desc, ij.URL)
+	if err != nil {
+		return err
+	}
+	*in = *n
+	return nil
+}
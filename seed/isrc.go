@@ -0,0 +1,77 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package seed
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/derat/yambs/mbdb"
+)
+
+// isrcLengthTolerance is the maximum difference between a track's length and an
+// existing recording's length for MatchRecordings to use the recording.
+const isrcLengthTolerance = 3 * time.Second
+
+// MatchRecordings looks up the ISRCs of rel's tracks and sets the tracks' Recording
+// fields if exactly one existing recording with a compatible length is found.
+// Tracks that already have recordings are skipped. Recordings are only matched if
+// both the track's and the recording's lengths are known.
+//
+// The returned Problems describe matched, ambiguous, and unmatched tracks.
+// Lookup errors are logged and cause the remaining tracks to be skipped.
+func (rel *Release) MatchRecordings(ctx context.Context, db *mbdb.DB) []Problem {
+	var res []Problem
+Loop:
+	for i := range rel.Mediums {
+		for j := range rel.Mediums[i].Tracks {
+			tr := &rel.Mediums[i].Tracks[j]
+			if tr.ISRC == "" || tr.Recording != "" {
+				continue
+			}
+			recs, err := db.GetRecordingsFromISRC(ctx, tr.ISRC)
+			if err != nil {
+				log.Printf("Failed getting recordings for ISRC %v: %v", tr.ISRC, err)
+				break Loop
+			}
+			var cands []mbdb.RecordingInfo
+			var unknown int // recordings that can't be ruled out since a length is missing
+			for _, rec := range recs {
+				if tr.Length == 0 || rec.Length == 0 {
+					unknown++
+				} else if lengthsCompatible(tr.Length, rec.Length) {
+					cands = append(cands, rec)
+				}
+			}
+			field := fmt.Sprintf("Mediums[%d].Tracks[%d].", i, j)
+			switch {
+			case len(cands) == 1 && unknown == 0:
+				tr.Recording = cands[0].MBID
+				res = append(res, Problem{field + "Recording",
+					fmt.Sprintf("matched recording %v by ISRC %v", cands[0].MBID, tr.ISRC)})
+			case len(cands) == 0 && unknown == 0:
+				res = append(res, Problem{field + "ISRC",
+					fmt.Sprintf("no recording with ISRC %v and compatible length", tr.ISRC)})
+			default:
+				msg := fmt.Sprintf("ISRC %v is ambiguous (%d recording(s)", tr.ISRC, len(cands)+unknown)
+				if unknown > 0 {
+					msg += fmt.Sprintf(", %d with unknown length", unknown)
+				}
+				res = append(res, Problem{field + "ISRC", msg + ")"})
+			}
+		}
+	}
+	return res
+}
+
+// lengthsCompatible returns true if track and rec are within isrcLengthTolerance of each other.
+func lengthsCompatible(track, rec time.Duration) bool {
+	diff := track - rec
+	if diff < 0 {
+		diff = -diff
+	}
+	return diff <= isrcLengthTolerance
+}
@@ -0,0 +1,103 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package seed
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// jsonVersion is written to JSON files by MarshalEdits.
+// It should be incremented if incompatible changes are made to the format.
+const jsonVersion = 1
+
+// jsonEdits is the top-level object written by MarshalEdits.
+type jsonEdits struct {
+	Version int        `json:"version"`
+	Edits   []jsonEdit `json:"edits"`
+}
+
+// jsonEdit wraps a single Edit.
+type jsonEdit struct {
+	Entity Entity          `json:"entity"`
+	Data   json.RawMessage `json:"data"`
+}
+
+// MarshalEdits returns an indented JSON representation of edits that can be
+// passed to UnmarshalEdits. Each edit is tagged with its Entity type.
+// Durations are represented as integer nanoseconds.
+func MarshalEdits(edits []Edit) ([]byte, error) {
+	je := jsonEdits{Version: jsonVersion, Edits: make([]jsonEdit, 0, len(edits))}
+	for _, ed := range edits {
+		b, err := json.Marshal(ed)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", ed.Entity(), err)
+		}
+		je.Edits = append(je.Edits, jsonEdit{Entity: ed.Entity(), Data: b})
+	}
+	return json.MarshalIndent(&je, "", "  ")
+}
+
+// UnmarshalEdits unmarshals edits that were previously marshaled by MarshalEdits.
+// Finish should not be called on the returned edits.
+func UnmarshalEdits(b []byte) ([]Edit, error) {
+	var je jsonEdits
+	if err := json.Unmarshal(b, &je); err != nil {
+		return nil, err
+	}
+	if je.Version != jsonVersion {
+		return nil, fmt.Errorf("unsupported version %d", je.Version)
+	}
+	edits := make([]Edit, 0, len(je.Edits))
+	for i, e := range je.Edits {
+		ed := newEdit(e.Entity)
+		if ed == nil {
+			return nil, fmt.Errorf("edit %d has unknown entity %q", i, e.Entity)
+		}
+		if err := json.Unmarshal(e.Data, ed); err != nil {
+			return nil, fmt.Errorf("edit %d: %v", i, err)
+		}
+		edits = append(edits, ed)
+	}
+	return edits, nil
+}
+
+// IsMarshaledEdits returns true if b appears to contain edits written by MarshalEdits
+// (possibly of an unsupported version).
+func IsMarshaledEdits(b []byte) bool {
+	var je struct {
+		Version *int            `json:"version"`
+		Edits   json.RawMessage `json:"edits"`
+	}
+	return json.Unmarshal(b, &je) == nil && je.Version != nil && je.Edits != nil
+}
+
+// newEdit returns a new zero-valued Edit for the specified entity type.
+// nil is returned if the type is unsupported.
+func newEdit(typ Entity) Edit {
+	switch typ {
+	case ArtistEntity:
+		return &Artist{}
+	case EventEntity:
+		return &Event{}
+	case InfoEntity:
+		return &Info{}
+	case LabelEntity:
+		return &Label{}
+	case PlaceEntity:
+		return &Place{}
+	case RecordingEntity:
+		return &Recording{}
+	case ReleaseEntity:
+		return &Release{}
+	case ReleaseGroupEntity:
+		return &ReleaseGroup{}
+	case SeriesEntity:
+		return &Series{}
+	case WorkEntity:
+		return &Work{}
+	default:
+		return nil
+	}
+}
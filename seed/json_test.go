@@ -0,0 +1,123 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package seed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMarshalEdits(t *testing.T) {
+	info, err := NewInfo("Add cover art", "/release/123/add-cover-art?foo=bar")
+	if err != nil {
+		t.Fatal("NewInfo failed:", err)
+	}
+	rels := []Relationship{{
+		Target:     "65389277-491a-4055-8e71-0a9be1c9c99c",
+		Type:       LinkType_Arranger_Artist_Release,
+		Attributes: []RelationshipAttribute{{Type: LinkAttributeType_Number, TextValue: "4"}},
+		BeginDate:  MakeDate(2001, 4, 0),
+		Backward:   true,
+	}}
+	urls := []URL{{URL: "https://www.example.org/", LinkType: LinkType_Bandcamp_Artist_URL}}
+
+	edits := []Edit{
+		&Artist{
+			MBID:      "0096a0bf-804e-4e47-bf2a-e0878dbb3eb7",
+			Name:      "Artist",
+			Type:      ArtistType_Person,
+			IPICodes:  []string{"123456789"},
+			BeginDate: MakeDate(1956, 4, 5),
+			Ended:     true,
+			URLs:      urls,
+		},
+		&Event{Name: "Event", Type: EventType_Concert, Relationships: rels},
+		&Label{Name: "Label", LabelCode: "1234", Type: LabelType_Imprint},
+		&Recording{
+			Name:    "Recording",
+			Artists: []ArtistCredit{{ID: 123, NameAsCredited: "A", JoinPhrase: " & "}, {Name: "B"}},
+			Length:  3*time.Minute + 45*time.Second,
+			ISRCs:   []string{"UKAAA0500001"},
+		},
+		&Release{
+			Title:   "Release",
+			Types:   []ReleaseGroupType{ReleaseGroupType_Album},
+			Status:  ReleaseStatus_Official,
+			Events:  []ReleaseEvent{{Date: MakeDate(2021, 5, 3), Country: "GB"}},
+			Labels:  []ReleaseLabel{{CatalogNumber: "CAT-1", Name: "Label"}},
+			Artists: []ArtistCredit{{Name: "Artist"}},
+			Mediums: []Medium{{Format: MediumFormat_CD, Tracks: []Track{
+				{Title: "One", Length: 4 * time.Minute},
+				{Title: "Two", Artists: []ArtistCredit{{Name: "Other"}}},
+			}}},
+			EditNote:    "Line 1\nLine 2",
+			RedirectURI: AddCoverArtRedirectURI,
+		},
+		&Work{
+			Name:       "Work",
+			Languages:  []Language{Language_English},
+			Attributes: []WorkAttribute{{Type: WorkAttributeType_ACAM_ID, Value: "123"}},
+		},
+		info,
+	}
+
+	b, err := MarshalEdits(edits)
+	if err != nil {
+		t.Fatal("MarshalEdits failed:", err)
+	}
+	got, err := UnmarshalEdits(b)
+	if err != nil {
+		t.Fatal("UnmarshalEdits failed:", err)
+	}
+	if len(got) != len(edits) {
+		t.Fatalf("UnmarshalEdits returned %d edit(s); want %d", len(got), len(edits))
+	}
+	for i, want := range edits {
+		if gi, ok := got[i].(*Info); ok {
+			// Info doesn't export its fields, so compare its methods' return values instead.
+			if gi.Description() != want.Description() || gi.URL("") != want.URL("") ||
+				gi.Params().Encode() != want.Params().Encode() {
+				t.Errorf("Edit %d is %q %q %q; want %q %q %q", i,
+					gi.Description(), gi.URL(""), gi.Params().Encode(),
+					want.Description(), want.URL(""), want.Params().Encode())
+			}
+		} else if diff := cmp.Diff(want, got[i]); diff != "" {
+			t.Errorf("Edit %d changed after round trip:\n%s", i, diff)
+		}
+	}
+}
+
+func TestUnmarshalEdits_Errors(t *testing.T) {
+	for _, s := range []string{
+		`not json`,
+		`{"version":2,"edits":[]}`,
+		`{"version":1,"edits":[{"entity":"bogus","data":{}}]}`,
+		`{"version":1,"edits":[{"entity":"artist","data":{"name":5}}]}`,
+	} {
+		if _, err := UnmarshalEdits([]byte(s)); err == nil {
+			t.Errorf("UnmarshalEdits(%q) unexpectedly succeeded", s)
+		}
+	}
+}
+
+func TestIsMarshaledEdits(t *testing.T) {
+	for _, tc := range []struct {
+		s    string
+		want bool
+	}{
+		{`{"version":1,"edits":[]}`, true},
+		{"  {\n\"version\": 2, \"edits\": [{\"entity\":\"artist\",\"data\":{}}]}\n", true},
+		{`{"version":1}`, false},
+		{`{"edits":[]}`, false},
+		{`{"name":"Artist"}`, false},
+		{"{Name}\tArtist\n", false},
+		{`not json`, false},
+	} {
+		if got := IsMarshaledEdits([]byte(tc.s)); got != tc.want {
+			t.Errorf("IsMarshaledEdits(%q) = %v; want %v", tc.s, got, tc.want)
+		}
+	}
+}
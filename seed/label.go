@@ -19,44 +19,50 @@ import (
 // See https://musicbrainz.org/doc/Label for more information about label entities.
 type Label struct {
 	// MBID contains the label's MBID (for editing an existing label rather than creating a new one).
-	MBID string
+	MBID string `json:"mbid,omitempty"`
 	// Name contains the label's name.
-	Name string
+	Name string `json:"name,omitempty"`
 	// Disambiguation differentiates this label from other labels with similar names.
 	// See https://musicbrainz.org/doc/Disambiguation_Comment.
-	Disambiguation string
+	Disambiguation string `json:"disambiguation,omitempty"`
 	// Type describes the label's main activity.
 	// See https://musicbrainz.org/doc/Label/Type.
-	Type LabelType
+	Type LabelType `json:"type,omitempty"`
 	// AreaName is used to fill the search field for the label's area of origin.
 	// TODO: Find some way to seed by MBID. There are hidden "edit-label.area.gid" and
 	// "edit-label.area_id" inputs in the form, and there are a few references to the latter in test
 	// code in the musicbrainz-server, but I haven't managed to fill the field by passing MBIDs or
 	// database IDs via either parameter. The field oddly still turns green, though.
-	AreaName string
+	AreaName string `json:"area_name,omitempty"`
 	// LabelCode contains the 4- or 5-digit label code (i.e. without the "LC-" prefix, and with or
 	// without leading zeros). See https://musicbrainz.org/doc/Label/Label_Code.
-	LabelCode string
+	LabelCode string `json:"label_code,omitempty"`
 	// IPICodes contains the label's Interested Party Information code(s) assigned by the CISAC database
 	// for musical rights management. See https://musicbrainz.org/doc/IPI.
-	IPICodes []string
+	IPICodes []string `json:"ipi_codes,omitempty"`
 	// ISNICodes contains the label's International Standard Name Identifier(s).
 	// See https://musicbrainz.org/doc/ISNI.
-	ISNICodes []string
+	ISNICodes []string `json:"isni_codes,omitempty"`
 	// BeginDate contains the date when the label started.
-	BeginDate Date
+	BeginDate Date `json:"begin_date,omitempty"`
 	// EndDate contains the date when the label ended.
-	EndDate Date
+	EndDate Date `json:"end_date,omitempty"`
 	// Ended describes whether the label has ended.
-	Ended bool
+	Ended bool `json:"ended,omitempty"`
 	// Relationships contains (non-URL) relationships between this label and other entities.
-	Relationships []Relationship
+	Relationships []Relationship `json:"relationships,omitempty"`
 	// URLs contains relationships between this label and one or more URLs.
 	// See https://musicbrainz.org/doc/Style/Relationships/URLs.
-	URLs []URL
+	URLs []URL `json:"urls,omitempty"`
+	// Changes lists modifications to the existing label. See Edit.
+	Changes []string `json:"changes,omitempty"`
+	// Tags contains tags to add to the existing label. See GetSubmission.
+	Tags []string `json:"tags,omitempty"`
+	// Rating contains a 1-5 rating for the existing label. See GetSubmission.
+	Rating int `json:"rating,omitempty"`
 	// EditNote contains the note attached to the edit.
 	// See https://musicbrainz.org/doc/Edit_Note.
-	EditNote string
+	EditNote string `json:"edit_note,omitempty"`
 }
 
 func (l *Label) Entity() Entity { return LabelEntity }
@@ -69,6 +75,9 @@ func (l *Label) Description() string {
 	if l.Name != "" {
 		parts = append(parts, l.Name)
 	}
+	if len(l.Changes) > 0 {
+		parts = append(parts, strings.Join(l.Changes, "; "))
+	}
 	if len(parts) == 0 {
 		return "[unknown]"
 	}
@@ -126,4 +135,7 @@ func (l *Label) Params() url.Values {
 
 func (l *Label) Method() string { return http.MethodGet }
 
-func (l *Label) Finish(ctx context.Context, db *mbdb.DB) error { return nil }
+func (l *Label) Finish(ctx context.Context, db *mbdb.DB) error {
+	finishURLs(l.URLs, LabelEntity)
+	return nil
+}
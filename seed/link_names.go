@@ -0,0 +1,9607 @@
+package seed
+
+// This file was generated from a dump of the MusicBrainz database
+// (https://musicbrainz.org/doc/MusicBrainz_Database/Download)
+// initiated at 2023-01-01 00:00:02.791097+00.
+//
+// MusicBrainz database dumps are distributed under the CC0 license:
+// https://creativecommons.org/publicdomain/zero/1.0/
+//
+// This file can be regenerated by running "go generate".
+
+// linkTypeNames maps pairs of entity types (as named in the database,
+// e.g. "release_group") to the names of link types between them.
+// See FindLinkType.
+var linkTypeNames = map[[2]string]map[string]LinkType{
+	{"area", "event"}: {
+		"held in": 793,
+	},
+	{"area", "recording"}: {
+		"arranged in":   864,
+		"edited in":     821,
+		"engineered in": 814,
+		"mixed in":      758,
+		"produced in":   827,
+		"recorded in":   698,
+		"remixed in":    830,
+		"video shot in": 964,
+	},
+	{"area", "release"}: {
+		"arranged in":     863,
+		"edited in":       822,
+		"engineered in":   815,
+		"lacquer cut in":  967,
+		"manufactured in": 835,
+		"mastered in":     756,
+		"mixed in":        757,
+		"printed in":      849,
+		"produced in":     826,
+		"recorded in":     699,
+		"remixed in":      831,
+	},
+	{"area", "work"}: {
+		"anthem":              357,
+		"arranged in":         885,
+		"composed in":         875,
+		"dedication":          914,
+		"libretto written in": 879,
+		"lyrics written in":   877,
+		"premiere":            715,
+		"revised in":          881,
+		"translated in":       884,
+		"written in":          873,
+	},
+	{"artist", "artist"}: {
+		"artist rename":                    1079,
+		"artistic director":                965,
+		"collaboration":                    102,
+		"composer in residence":            855,
+		"conductor position":               305,
+		"founder":                          895,
+		"instrumental supporting musician": 105,
+		"involved with":                    112,
+		"is person":                        108,
+		"married":                          111,
+		"member of band":                   103,
+		"musical relationships":            106,
+		"named after":                      973,
+		"parent":                           109,
+		"personal relationship":            113,
+		"sibling":                          110,
+		"subgroup":                         722,
+		"supporting musician":              104,
+		"teacher":                          847,
+		"tribute":                          728,
+		"vocal supporting musician":        107,
+		"voice actor":                      292,
+	},
+	{"artist", "event"}: {
+		"conductor":                    806,
+		"engineer":                     1084,
+		"guest performer":              800,
+		"host":                         801,
+		"main performer":               798,
+		"non performing relationships": 935,
+		"orchestra":                    807,
+		"support act":                  799,
+		"supporting dj":                932,
+		"teacher":                      893,
+		"tribute to":                   936,
+	},
+	{"artist", "instrument"}: {
+		"invented": 896,
+	},
+	{"artist", "label"}: {
+		"artists and repertoire position": 1081,
+		"contract":                        119,
+		"creative position":               115,
+		"engineer position":               120,
+		"label founder":                   116,
+		"owner":                           991,
+		"ownership":                       990,
+		"personal label":                  723,
+		"personal publisher":              724,
+		"producer position":               117,
+		"recording contract":              121,
+	},
+	{"artist", "place"}: {
+		"associated with":                    926,
+		"composer in residence":              937,
+		"educational institution connection": 925,
+		"engineer position":                  701,
+		"founder":                            832,
+		"mastering engineer position":        704,
+		"mixing engineer position":           703,
+		"named after":                        975,
+		"organist":                           856,
+		"owner":                              988,
+		"primary concert venue":              714,
+		"recording engineer position":        702,
+		"studied at":                         923,
+		"taught at":                          924,
+	},
+	{"artist", "recording"}: {
+		"arranger":                 297,
+		"art direction":            137,
+		"artists and repertoire":   135,
+		"audio":                    140,
+		"balance":                  726,
+		"booking":                  134,
+		"chorus master":            152,
+		"compiler":                 147,
+		"concertmaster":            760,
+		"conductor":                151,
+		"creative direction":       146,
+		"design illustration":      130,
+		"editor":                   144,
+		"engineer":                 138,
+		"field recordist":          1011,
+		"graphic design":           125,
+		"instrument":               148,
+		"instrument arranger":      158,
+		"instrument technician":    986,
+		"legal representation":     142,
+		"mastering":                136,
+		"misc":                     129,
+		"mix":                      143,
+		"mix dj":                   155,
+		"orchestrator":             300,
+		"performance":              122,
+		"performer":                156,
+		"performing orchestra":     150,
+		"phonographic copyright":   869,
+		"photography":              123,
+		"producer":                 141,
+		"production":               160,
+		"programming":              132,
+		"publishing":               127,
+		"recording":                128,
+		"remixer":                  153,
+		"remixes and compilations": 157,
+		"samples from artist":      154,
+		"sound":                    133,
+		"video":                    961,
+		"video appearance":         858,
+		"video director":           962,
+		"vocal":                    149,
+		"vocal arranger":           298,
+	},
+	{"artist", "release"}: {
+		"arranger":                 295,
+		"art direction":            18,
+		"artwork":                  993,
+		"audio":                    31,
+		"balance":                  727,
+		"booking":                  23,
+		"booklet editor":           929,
+		"chorus master":            53,
+		"compiler":                 48,
+		"composer":                 55,
+		"composition":              58,
+		"concertmaster":            759,
+		"conductor":                46,
+		"copyright":                709,
+		"design":                   928,
+		"design illustration":      19,
+		"editor":                   38,
+		"engineer":                 28,
+		"field recordist":          1012,
+		"graphic design":           27,
+		"illustration":             927,
+		"instrument":               44,
+		"instrument arranger":      41,
+		"instrument technician":    987,
+		"lacquer cut":              969,
+		"legal representation":     22,
+		"librettist":               57,
+		"licensor":                 1010,
+		"liner notes":              24,
+		"lyricist":                 56,
+		"mastering":                42,
+		"misc":                     25,
+		"mix":                      26,
+		"mix dj":                   43,
+		"orchestrator":             40,
+		"performance":              34,
+		"performer":                51,
+		"performing orchestra":     45,
+		"phonographic copyright":   710,
+		"photography":              20,
+		"producer":                 30,
+		"production":               59,
+		"programming":              37,
+		"publishing":               32,
+		"recording":                36,
+		"remixer":                  47,
+		"remixes and compilations": 50,
+		"samples from artist":      49,
+		"sound":                    29,
+		"translator":               871,
+		"vocal":                    60,
+		"vocal arranger":           296,
+		"writer":                   54,
+	},
+	{"artist", "release_group"}: {
+		"artists and repertoire": 62,
+		"creative direction":     63,
+		"dedicated to":           868,
+		"named after":            974,
+		"tribute":                65,
+	},
+	{"artist", "series"}: {
+		"catalogued":       751,
+		"event artists":    1003,
+		"founder":          1004,
+		"has catalogue":    750,
+		"named after":      1000,
+		"part of":          996,
+		"residency":        994,
+		"tour":             859,
+		"work cataloguing": 1002,
+	},
+	{"artist", "url"}: {
+		"allmusic":                283,
+		"bandcamp":                718,
+		"bandsintown":             862,
+		"bbc music page":          190,
+		"biography":               182,
+		"blog":                    199,
+		"bookbrainz":              852,
+		"cd baby":                 919,
+		"cpdl":                    981,
+		"crowdfunding":            902,
+		"discography":             171,
+		"discography page":        184,
+		"discogs":                 180,
+		"download for free":       177,
+		"fanpage":                 172,
+		"free streaming":          194,
+		"get the music":           187,
+		"image":                   173,
+		"imdb":                    178,
+		"imslp":                   754,
+		"interview":               707,
+		"lastfm":                  840,
+		"lyrics":                  197,
+		"myspace":                 189,
+		"official homepage":       183,
+		"online community":        185,
+		"online data":             841,
+		"other databases":         188,
+		"patronage":               897,
+		"purchase for download":   176,
+		"purchase for mail order": 175,
+		"purevolume":              174,
+		"secondhandsongs":         307,
+		"setlistfm":               816,
+		"social network":          192,
+		"songkick":                785,
+		"soundcloud":              291,
+		"streaming":               978,
+		"vgmdb":                   191,
+		"viaf":                    310,
+		"video channel":           303,
+		"wikidata":                352,
+		"wikipedia":               179,
+		"youtube":                 193,
+		"youtube music":           1080,
+	},
+	{"artist", "work"}: {
+		"arranger":             293,
+		"commissioned":         889,
+		"composer":             168,
+		"composition":          170,
+		"dedication":           846,
+		"instrument arranger":  282,
+		"librettist":           169,
+		"lyricist":             165,
+		"misc":                 162,
+		"named after":          972,
+		"orchestrator":         164,
+		"premiere":             956,
+		"previous attribution": 834,
+		"publishing":           161,
+		"reconstructed by":     917,
+		"revised by":           844,
+		"translator":           872,
+		"vocal arranger":       294,
+		"writer":               167,
+	},
+	{"event", "event"}: {
+		"parts":          818,
+		"rescheduled as": 836,
+	},
+	{"event", "place"}: {
+		"held at": 794,
+	},
+	{"event", "recording"}: {
+		"recorded at":   809,
+		"video shot at": 966,
+	},
+	{"event", "release"}: {
+		"available at": 795,
+		"launch event": 796,
+		"recorded at":  810,
+	},
+	{"event", "release_group"}: {
+		"launch event":   797,
+		"performance of": 887,
+	},
+	{"event", "series"}: {
+		"part of": 802,
+	},
+	{"event", "url"}: {
+		"bandsintown":       860,
+		"crowdfunding":      904,
+		"lastfm":            839,
+		"official homepage": 782,
+		"other databases":   803,
+		"patronage":         898,
+		"poster":            808,
+		"review":            842,
+		"setlistfm":         811,
+		"social network":    783,
+		"songkick":          786,
+		"vgmdb":             788,
+		"video channel":     804,
+		"wikidata":          790,
+		"wikipedia":         789,
+		"youtube":           791,
+	},
+	{"event", "work"}: {
+		"premiere": 845,
+	},
+	{"instrument", "label"}: {
+		"invented": 918,
+	},
+	{"label", "label"}: {
+		"business association": 205,
+		"imprint":              725,
+		"label distribution":   203,
+		"label ownership":      200,
+		"label reissue":        201,
+		"label rename":         202,
+	},
+	{"label", "place"}: {
+		"owner": 989,
+	},
+	{"label", "recording"}: {
+		"arranged for":           949,
+		"contracted tasks":       945,
+		"misc":                   998,
+		"mixed for":              946,
+		"phonographic copyright": 867,
+		"produced for":           950,
+		"publishing":             206,
+	},
+	{"label", "release"}: {
+		"arranged for":           948,
+		"contracted tasks":       944,
+		"copyright":              708,
+		"distributed":            361,
+		"glass mastered":         955,
+		"licensee":               833,
+		"licensor":               712,
+		"manufactured":           360,
+		"manufactured for":       952,
+		"marketed":               848,
+		"misc":                   999,
+		"mixed for":              947,
+		"phonographic copyright": 711,
+		"pressed":                942,
+		"printed":                985,
+		"produced for":           951,
+		"promoted":               359,
+		"published":              362,
+		"publishing":             66,
+		"rights society":         349,
+	},
+	{"label", "release_group"}: {
+		"tribute": 970,
+	},
+	{"label", "series"}: {
+		"publishes series": 933,
+	},
+	{"label", "url"}: {
+		"bandcamp":                719,
+		"blog":                    224,
+		"bookbrainz":              851,
+		"catalog site":            212,
+		"crowdfunding":            903,
+		"discogs":                 217,
+		"download for free":       958,
+		"fanpage":                 214,
+		"free streaming":          997,
+		"get the music":           957,
+		"history site":            211,
+		"imdb":                    313,
+		"lastfm":                  838,
+		"logo":                    213,
+		"lyrics":                  982,
+		"myspace":                 215,
+		"official site":           219,
+		"online data":             221,
+		"other databases":         222,
+		"patronage":               899,
+		"purchase for download":   959,
+		"purchase for mail order": 960,
+		"secondhandsongs":         977,
+		"social network":          218,
+		"soundcloud":              290,
+		"streaming":               1005,
+		"vgmdb":                   210,
+		"viaf":                    311,
+		"video channel":           304,
+		"wikidata":                354,
+		"wikipedia":               216,
+		"youtube":                 225,
+	},
+	{"label", "work"}: {
+		"commissioned": 890,
+		"dedication":   922,
+		"publishing":   208,
+	},
+	{"place", "recording"}: {
+		"arranged at":   866,
+		"edited at":     819,
+		"engineered at": 813,
+		"mixed at":      694,
+		"produced at":   825,
+		"recorded at":   693,
+		"remixed at":    829,
+		"video shot at": 963,
+	},
+	{"place", "release"}: {
+		"arranged at":       865,
+		"edited at":         820,
+		"engineered at":     812,
+		"glass mastered at": 954,
+		"lacquer cut at":    968,
+		"manufactured at":   953,
+		"mastered at":       697,
+		"mixed at":          696,
+		"pressed at":        941,
+		"produced at":       824,
+		"recorded at":       695,
+		"remixed at":        828,
+	},
+	{"place", "work"}: {
+		"arranged at":         886,
+		"commissioned":        892,
+		"composed at":         876,
+		"dedication":          983,
+		"libretto written at": 880,
+		"lyrics written at":   878,
+		"premiere":            716,
+		"revised at":          882,
+		"translated at":       883,
+		"written at":          874,
+	},
+	{"recording", "recording"}: {
+		"compilation":              228,
+		"dj mix":                   227,
+		"edit":                     309,
+		"first track release":      238,
+		"karaoke":                  226,
+		"mashes up":                232,
+		"music video":              857,
+		"other versions":           233,
+		"remaster":                 236,
+		"remix":                    230,
+		"remixes and compilations": 234,
+		"samples material":         231,
+	},
+	{"recording", "release"}: {
+		"samples material": 69,
+	},
+	{"recording", "series"}: {
+		"part of":         740,
+		"recorded during": 1006,
+	},
+	{"recording", "url"}: {
+		"allmusic":              285,
+		"crowdfunding":          905,
+		"download for free":     255,
+		"free streaming":        268,
+		"get the music":         257,
+		"imdb samples":          258,
+		"license":               302,
+		"other databases":       306,
+		"production":            256,
+		"purchase for download": 254,
+		"secondhandsongs":       976,
+		"streaming":             979,
+	},
+	{"recording", "work"}: {
+		"performance": 278,
+	},
+	{"release", "release"}: {
+		"covers and versions": 4,
+		"part of set":         1,
+		"remaster":            6,
+		"replaced by":         1009,
+		"supporting release":  3,
+		"transl tracklisting": 2,
+	},
+	{"release", "series"}: {
+		"part of": 741,
+	},
+	{"release", "url"}: {
+		"allmusic":                755,
+		"amazon asin":             77,
+		"bookbrainz":              850,
+		"cover art link":          78,
+		"crowdfunding":            906,
+		"discography entry":       288,
+		"discogs":                 76,
+		"download for free":       75,
+		"free streaming":          85,
+		"get the music":           73,
+		"imdb samples":            83,
+		"license":                 301,
+		"other databases":         82,
+		"production":              72,
+		"purchase for download":   74,
+		"purchase for mail order": 79,
+		"secondhandsongs":         308,
+		"show notes":              729,
+		"streaming":               980,
+		"vgmdb":                   86,
+	},
+	{"release_group", "release_group"}: {
+		"cover":                    15,
+		"covers and versions":      12,
+		"dj mix":                   8,
+		"included in":              894,
+		"live performance":         17,
+		"mashes up":                10,
+		"remix":                    9,
+		"remixes and compilations": 13,
+		"single from":              11,
+		"translated version":       1082,
+	},
+	{"release_group", "series"}: {
+		"part of":            742,
+		"recorded during":    1007,
+		"tour in support of": 888,
+	},
+	{"release_group", "url"}: {
+		"allmusic":          284,
+		"bookbrainz":        853,
+		"crowdfunding":      907,
+		"discography":       88,
+		"discogs":           90,
+		"imdb":              97,
+		"lyrics":            93,
+		"official homepage": 287,
+		"other databases":   96,
+		"review":            94,
+		"wikidata":          353,
+		"wikipedia":         89,
+	},
+	{"series", "work"}: {
+		"commissioned": 891,
+		"part of":      743,
+	},
+	{"url", "work"}: {
+		"allmusic":                286,
+		"bookbrainz":              854,
+		"crowdfunding":            908,
+		"discogs":                 971,
+		"download for free":       274,
+		"get the score":           911,
+		"imdb":                    843,
+		"license":                 939,
+		"lyrics":                  271,
+		"other databases":         273,
+		"purchase for download":   912,
+		"purchase for mail order": 913,
+		"secondhandsongs":         280,
+		"songfacts":               289,
+		"vgmdb":                   992,
+		"viaf":                    312,
+		"wikidata":                351,
+		"wikipedia":               279,
+		"work list entry":         921,
+	},
+	{"work", "work"}: {
+		"arrangement":       350,
+		"based on":          314,
+		"lyrical quotation": 1047,
+		"medley":            239,
+		"musical quotation": 1046,
+		"orchestration":     316,
+		"other version":     241,
+		"parts":             281,
+		"revision of":       315,
+	},
+}
+
+// linkAttrTypeNames maps the names of link attribute types to IDs.
+// Unlike the LinkAttributeType enum, infrequently-used instruments are included.
+// See FindLinkAttributeType.
+var linkAttrTypeNames = map[string]LinkAttributeType{
+	"12 string guitar":                      529,
+	"17-string bass koto":                   628,
+	"Anglo concertina":                      989,
+	"Appalachian dulcimer":                  90,
+	"Baltic psalteries":                     506,
+	"Batá drum":                             550,
+	"Blaster Beam":                          1002,
+	"Cembalet":                              943,
+	"Chapman stick":                         238,
+	"Cretan lyra":                           591,
+	"Cristal Baschet":                       884,
+	"Denis d'or":                            160,
+	"Dubreq Stylophone":                     161,
+	"E-flat clarinet":                       686,
+	"EWI":                                   533,
+	"English concertina":                    987,
+	"English flageolet":                     994,
+	"French horn":                           44,
+	"German concertina":                     988,
+	"German harp":                           436,
+	"Gravikord":                             1059,
+	"Great Highland bagpipe":                1267,
+	"Guitaret":                              944,
+	"Hammond organ":                         177,
+	"Hawaiian guitar":                       400,
+	"Indonesian rebab":                      1190,
+	"Irish bouzouki":                        491,
+	"Irish flute":                           1035,
+	"Irish harp / clàrsach":                 435,
+	"Lyricon":                               673,
+	"Mark tree":                             782,
+	"Marxophone":                            534,
+	"Mexican vihuela":                       117,
+	"Minimoog":                              349,
+	"Moog":                                  348,
+	"Northumbrian pipes":                    438,
+	"Otamatone":                             1047,
+	"Paraguayan harp":                       810,
+	"Pianet":                                942,
+	"Pierrot ensemble":                      1208,
+	"Portuguese guitar":                     793,
+	"Reactable":                             688,
+	"Rhodes piano":                          182,
+	"Saraswati veena":                       585,
+	"Schwyzerörgeli":                        825,
+	"Scottish smallpipes":                   513,
+	"Serbo-Croatian tamburica orchestra":    1069,
+	"Stroh violin":                          543,
+	"The Great Stalacpipe Organ":            1095,
+	"Tibetan water drum":                    350,
+	"Tonette":                               996,
+	"Vietnamese guitar":                     399,
+	"Wagner tuba":                           201,
+	"Warr guitar":                           323,
+	"Wiener Horn":                           674,
+	"Wurlitzer electric piano":              562,
+	"Xaphoon":                               675,
+	"accordina":                             927,
+	"accordion":                             64,
+	"acoustic bass guitar":                  73,
+	"acoustic fretless guitar":              651,
+	"acoustic guitar":                       76,
+	"act":                                   1030,
+	"additional":                            1,
+	"aeolian harp":                          89,
+	"afoxé":                                 865,
+	"agogô":                                 597,
+	"ajaeng":                                787,
+	"akete":                                 895,
+	"akkordolia":                            1214,
+	"alfaia":                                866,
+	"algozey":                               620,
+	"alphorn":                               41,
+	"alto clarinet":                         24,
+	"alto flute":                            423,
+	"alto saxophone":                        35,
+	"alto viol":                             1084,
+	"alto violin":                           226,
+	"alto vocals":                           5,
+	"amadinda":                              443,
+	"aman khuur":                            959,
+	"amount":                                1080,
+	"analog synthesizer":                    963,
+	"angklung":                              451,
+	"ankle rattlers":                        453,
+	"anniversary":                           1079,
+	"antara":                                1262,
+	"anvil":                                 868,
+	"archlute":                              619,
+	"archtop guitar":                        800,
+	"arghul":                                779,
+	"arpeggione":                            973,
+	"arrabel":                               1121,
+	"ashiko":                                962,
+	"assistant":                             526,
+	"associate":                             527,
+	"atabaque":                              867,
+	"atarigane":                             677,
+	"autoharp":                              494,
+	"baandu":                                1037,
+	"bachelor’s degree":                     1137,
+	"background vocals":                     12,
+	"baglamas":                              306,
+	"bagpipe":                               18,
+	"bajo sexto":                            876,
+	"balafon":                               444,
+	"balalaika":                             91,
+	"bandoneón":                             263,
+	"bandora":                               598,
+	"bandura":                               476,
+	"bandurria":                             919,
+	"bangu":                                 693,
+	"banhu":                                 291,
+	"banjitar":                              479,
+	"banjo":                                 92,
+	"banjo-ukulele":                         982,
+	"banjolin":                              981,
+	"bansuri":                               251,
+	"barbat":                                969,
+	"baritone guitar":                       377,
+	"baritone horn":                         42,
+	"baritone saxophone":                    37,
+	"baritone vocals":                       6,
+	"baroque guitar":                        1076,
+	"baroque rackett":                       1055,
+	"baroque trumpet":                       633,
+	"barrel drum":                           699,
+	"barrel organ":                          600,
+	"baryton":                               749,
+	"bass":                                  70,
+	"bass clarinet":                         25,
+	"bass drum":                             518,
+	"bass flute":                            501,
+	"bass guitar":                           277,
+	"bass harmonica":                        558,
+	"bass oboe":                             880,
+	"bass pedals":                           484,
+	"bass recorder":                         365,
+	"bass saxophone":                        536,
+	"bass synthesizer":                      549,
+	"bass trombone":                         228,
+	"bass trumpet":                          875,
+	"bass viol":                             1075,
+	"bass violin":                           1058,
+	"bass vocals":                           7,
+	"bass-baritone vocals":                  231,
+	"basset clarinet":                       489,
+	"basset horn":                           490,
+	"bassoon":                               19,
+	"bawu":                                  592,
+	"bayan":                                 520,
+	"bazooka":                               889,
+	"bağlama":                               305,
+	"bağlama (saz) family":                  512,
+	"bedug":                                 1145,
+	"bell":                                  151,
+	"bell plate":                            1266,
+	"bell tree":                             588,
+	"bellow-blown bagpipes":                 514,
+	"bellowed reed":                         1162,
+	"bendir":                                420,
+	"berda":                                 978,
+	"berimbau":                              93,
+	"bhapang":                               1081,
+	"bicycle bell":                          891,
+	"bin-sasara":                            460,
+	"bin-sitar":                             1192,
+	"birbynė":                               1041,
+	"birch lur":                             950,
+	"bisernica":                             974,
+	"biwa":                                  94,
+	"boatswain's pipe":                      504,
+	"bodhrán":                               249,
+	"body percussion":                       610,
+	"bolon":                                 893,
+	"bombarde":                              519,
+	"bombo legüero":                         1265,
+	"bonang":                                1146,
+	"bonang barung":                         1147,
+	"bonang panembung":                      1149,
+	"bonang panerus":                        1148,
+	"bones":                                 437,
+	"bongos":                                128,
+	"bonus":                                 516,
+	"boobam":                                1027,
+	"boomwhacker":                           1109,
+	"bouzar / gouzouki":                     983,
+	"bouzouki":                              95,
+	"bowed lute":                            1196,
+	"bowed lyre":                            1197,
+	"bowed piano":                           614,
+	"bowed psaltery":                        299,
+	"bowed string instruments":              275,
+	"brass":                                 38,
+	"brač":                                  975,
+	"bronze lur":                            949,
+	"brushes":                               397,
+	"bugarija":                              976,
+	"bugle":                                 51,
+	"buisine":                               910,
+	"buk":                                   689,
+	"bulbul tarang":                         640,
+	"bullroarer":                            202,
+	"button accordion":                      440,
+	"buzuq":                                 801,
+	"bīn":                                   1191,
+	"cabasa":                                136,
+	"caixa":                                 1062,
+	"cajón":                                 413,
+	"calabash":                              926,
+	"calliope":                              170,
+	"calung":                                1153,
+	"cancelled":                             921,
+	"carillon":                              171,
+	"castanets":                             137,
+	"cavaquinho":                            636,
+	"caxixi":                                599,
+	"celesta":                               172,
+	"cello":                                 84,
+	"chacha":                                412,
+	"chakhe":                                772,
+	"chalumeau":                             524,
+	"chamber music":                         1223,
+	"chamber organ":                         565,
+	"chamberlin":                            330,
+	"chande":                                648,
+	"chanzy":                                861,
+	"chap":                                  764,
+	"charango":                              559,
+	"chau gong":                             785,
+	"chikuzen biwa":                         690,
+	"chime bar":                             783,
+	"chimes":                                415,
+	"ching":                                 763,
+	"chirimía":                              971,
+	"chirimía and drum":                     972,
+	"chitarra battente":                     1100,
+	"chitra veena":                          892,
+	"choir vocals":                          13,
+	"choral conducting":                     1224,
+	"chromatic button accordion":            521,
+	"chromatic harmonica":                   376,
+	"chuurqin":                              1065,
+	"cimbalom":                              326,
+	"cimbasso":                              1089,
+	"citole":                                909,
+	"cittern":                               307,
+	"cizhonghu":                             288,
+	"clapper":                               1063,
+	"clapstick":                             1116,
+	"clarinet":                              23,
+	"classical guitar":                      77,
+	"classical kemençe":                     654,
+	"classical music":                       1204,
+	"claves":                                138,
+	"clavichord":                            173,
+	"clavinet":                              227,
+	"claviola":                              929,
+	"clavioline":                            965,
+	"claviorganum":                          1071,
+	"co":                                    424,
+	"composition":                           1126,
+	"concert flute":                         499,
+	"concert harp":                          431,
+	"concertina":                            65,
+	"conch":                                 205,
+	"concussion idiophone":                  1119,
+	"conducting":                            1130,
+	"congas":                                127,
+	"contemporary music":                    1227,
+	"continuum":                             471,
+	"contrabass clarinet":                   26,
+	"contrabass flute":                      970,
+	"contrabass recorder":                   367,
+	"contrabass saxophone":                  538,
+	"contrabassoon":                         20,
+	"contralto vocals":                      230,
+	"cor anglais":                           21,
+	"cornamuse":                             622,
+	"cornet":                                39,
+	"cornett":                               273,
+	"countertenor vocals":                   8,
+	"cover":                                 567,
+	"cowbell":                               208,
+	"craviola":                              928,
+	"crotales":                              214,
+	"crumhorn":                              427,
+	"crwth":                                 98,
+	"cuatro":                                531,
+	"cuíca":                                 601,
+	"cylindrical drum":                      804,
+	"cymbal":                                342,
+	"cò ke":                                 386,
+	"cümbüş":                                495,
+	"daegeum":                               602,
+	"daf":                                   403,
+	"daire":                                 644,
+	"daluo":                                 663,
+	"danso":                                 1087,
+	"darbuka":                               419,
+	"daruan":                                658,
+	"davul":                                 496,
+	"dhol":                                  647,
+	"dholak":                                463,
+	"diatonic button accordion":             441,
+	"diddley bow":                           780,
+	"didgeridoo":                            204,
+	"dilruba":                               642,
+	"ding tac ta":                           394,
+	"disk drive":                            746,
+	"diyingehu":                             290,
+	"dizi":                                  553,
+	"djembe":                                335,
+	"djoza":                                 1000,
+	"doctoral degree":                       1139,
+	"dohol":                                 483,
+	"dolceola":                              898,
+	"dombra":                                742,
+	"domra":                                 594,
+	"donso ngɔni":                           925,
+	"doshpuluur":                            854,
+	"double bass":                           71,
+	"double reed":                           17,
+	"doyra":                                 140,
+	"dramyin":                               837,
+	"drum machine":                          162,
+	"drums (drum set)":                      126,
+	"duck call":                             795,
+	"duduk":                                 480,
+	"duggi":                                 1001,
+	"dulce melos":                           922,
+	"dulcian":                               606,
+	"dulcitone":                             991,
+	"dulzaina":                              918,
+	"dunun":                                 805,
+	"dutar":                                 774,
+	"duxianqin":                             740,
+	"early music":                           1131,
+	"ebow":                                  404,
+	"effects":                               823,
+	"electric bass guitar":                  74,
+	"electric cello":                        278,
+	"electric fretless guitar":              650,
+	"electric grand piano":                  828,
+	"electric guitar":                       78,
+	"electric harp":                         432,
+	"electric lap steel guitar":             470,
+	"electric piano":                        329,
+	"electric sitar":                        316,
+	"electric upright bass":                 72,
+	"electric viola":                        794,
+	"electric violin":                       282,
+	"electronic drum set":                   487,
+	"electronic instruments":                159,
+	"electronic music":                      1127,
+	"electronic organ":                      539,
+	"elektronium":                           998,
+	"emeritus":                              617,
+	"end-blown flute":                       28,
+	"eponymous":                             1094,
+	"erhu":                                  285,
+	"esraj":                                 641,
+	"euphonium":                             199,
+	"executive":                             425,
+	"farfisa":                               540,
+	"fiddle":                                85,
+	"fife":                                  626,
+	"finger cymbals":                        554,
+	"finger snaps":                          541,
+	"fipple flute":                          264,
+	"five-string banjo":                     635,
+	"flabiol":                               995,
+	"flageolet":                             993,
+	"flamenco guitar":                       1099,
+	"floppy disk drive":                     748,
+	"flugelhorn":                            43,
+	"flumpet":                               890,
+	"flute":                                 27,
+	"flutina":                               1268,
+	"flûte d'amour":                         500,
+	"folk harp":                             433,
+	"folk music":                            1133,
+	"foot stomps":                           587,
+	"footbass":                              1205,
+	"fortepiano":                            465,
+	"four-string banjo":                     802,
+	"frame drum":                            421,
+	"free reed":                             63,
+	"fretless bass":                         523,
+	"friction drum":                         698,
+	"friction idiophone":                    849,
+	"frottoir":                              448,
+	"fujara":                                683,
+	"fundeh":                                1038,
+	"gadulka":                               301,
+	"gambang":                               1156,
+	"game console sound chip":               1003,
+	"gamelan":                               445,
+	"gankogui":                              464,
+	"ganzá":                                 790,
+	"gaohu":                                 286,
+	"garifuna drum":                         904,
+	"garklein recorder":                     361,
+	"garmon":                                1269,
+	"gayageum":                              99,
+	"gehu":                                  289,
+	"gemshorn":                              1021,
+	"gendèr":                                1122,
+	"gendèr barung":                         1183,
+	"gendèr panerus":                        1182,
+	"gendèr wayang":                         1184,
+	"geomungo":                              100,
+	"ghatam":                                408,
+	"ghaychak":                              1091,
+	"ghijak":                                1092,
+	"gittern":                               951,
+	"gizmo":                                 945,
+	"glass harmonica":                       357,
+	"glass harp":                            913,
+	"glockenspiel":                          215,
+	"goblet drum":                           338,
+	"gong":                                  340,
+	"gong bass drum":                        784,
+	"gong-chime":                            1160,
+	"gopichant":                             789,
+	"gralla":                                694,
+	"gramorimba":                            896,
+	"grand piano":                           181,
+	"great bass recorder / c-bass recorder": 366,
+	"guan":                                  829,
+	"guban":                                 1117,
+	"gudok":                                 300,
+	"guest":                                 194,
+	"guitalele":                             791,
+	"guitar":                                229,
+	"guitar family":                         75,
+	"guitar synthesizer":                    911,
+	"guitarrón chileno":                     836,
+	"guitarrón mexicano":                    835,
+	"guitorgan":                             985,
+	"gumbri":                                402,
+	"guqin":                                 616,
+	"gusli":                                 508,
+	"guzheng":                               544,
+	"güira":                                 1098,
+	"güiro":                                 141,
+	"haegeum":                               595,
+	"half":                                  1019,
+	"hammered dulcimer":                     101,
+	"handbell":                              346,
+	"handclaps":                             398,
+	"handpan":                               624,
+	"hard disk drive":                       747,
+	"hardingfele":                           102,
+	"harmonica":                             66,
+	"harmonium":                             178,
+	"harp":                                  81,
+	"harp guitar":                           827,
+	"harpejji":                              1051,
+	"harpsichord":                           174,
+	"heckelphone":                           261,
+	"heike biwa":                            691,
+	"helicon":                               824,
+	"hi-hat":                                547,
+	"hichiriki":                             761,
+	"hmông flute":                           389,
+	"horn":                                  40,
+	"hotchiku":                              857,
+	"hourglass drum":                        697,
+	"hue puruhau":                           1008,
+	"hue puruwai":                           1007,
+	"hulusi":                                659,
+	"hummel":                                1215,
+	"huqin":                                 283,
+	"hurdy gurdy":                           103,
+	"hydraulophone":                         1046,
+	"hyoshigi":                              1064,
+	"härjedalspipa":                         968,
+	"idiophone":                             820,
+	"igil":                                  855,
+	"improvisation":                         1134,
+	"instrument":                            14,
+	"instrumental":                          580,
+	"janggu":                                605,
+	"jantar":                                1217,
+	"jazz":                                  1132,
+	"jegogan":                               1158,
+	"jeli ngɔni":                            1004,
+	"jing":                                  664,
+	"jing'erhu":                             661,
+	"jinghu":                                284,
+	"jouhikko":                              843,
+	"jublag":                                1159,
+	"jug":                                   615,
+	"junjung":                               1049,
+	"k'lông pút":                            355,
+	"kacapi":                                1178,
+	"kacapi indung":                         1180,
+	"kacapi rincik":                         1181,
+	"kacapi siter":                          1179,
+	"kachva sitar":                          1108,
+	"kagurabue":                             999,
+	"kamale ngɔni":                          967,
+	"kamancheh":                             613,
+	"kanjira":                               583,
+	"kanklės":                               507,
+	"kannel":                                1025,
+	"kantele":                               509,
+	"kantilan":                              1154,
+	"kanun":                                 360,
+	"karaoke":                               1261,
+	"kartal":                                646,
+	"kaval":                                 551,
+	"kazoo":                                 188,
+	"kecer":                                 1157,
+	"kemanak":                               1167,
+	"kemenche":                              281,
+	"kemençe of the Black Sea":              653,
+	"kempli":                                1161,
+	"kempul":                                1163,
+	"kempyang":                              1166,
+	"kendang":                               1168,
+	"kendang lanang":                        1173,
+	"kendang wadon":                         1174,
+	"kendhang batangan":                     1171,
+	"kendhang gendhing":                     1169,
+	"kendhang indung":                       1175,
+	"kendhang ketipung":                     1170,
+	"kendhang kulanter":                     1176,
+	"kendhang wayangan":                     1172,
+	"kenong":                                1164,
+	"kepyak":                                1177,
+	"kethuk":                                1165,
+	"kettle drum":                           447,
+	"keyboard":                              232,
+	"keyboard bass":                         442,
+	"keyed box zither":                      1213,
+	"keyed brass instruments":               203,
+	"keytar":                                923,
+	"khene":                                 756,
+	"khim":                                  359,
+	"khlui":                                 812,
+	"khong wong":                            766,
+	"khong wong lek":                        767,
+	"khong wong yai":                        768,
+	"khulsan khuur":                         961,
+	"khèn Mèo":                              395,
+	"ki pah":                                384,
+	"kinnor":                                317,
+	"kithara":                               318,
+	"kkwaenggwari":                          608,
+	"klong khaek":                           817,
+	"klong song na":                         818,
+	"klong that":                            819,
+	"klong yao":                             816,
+	"kokle":                                 1050,
+	"kokyu":                                 293,
+	"komuz":                                 655,
+	"kora":                                  106,
+	"kortholt":                              334,
+	"koto":                                  107,
+	"kotsuzumi":                             669,
+	"krakebs":                               798,
+	"krap":                                  1110,
+	"krap khū":                              1111,
+	"krap phuang":                           1112,
+	"krap sēphā":                            1113,
+	"krar":                                  882,
+	"kudüm":                                 637,
+	"kèn bầu":                               380,
+	"kèn lá":                                388,
+	"kös":                                   887,
+	"kōauau":                                931,
+	"kōauau ponga ihu":                      1006,
+	"lamellaphone":                          777,
+	"langeleik":                             319,
+	"laouto":                                852,
+	"lap harp":                              1043,
+	"lap steel guitar":                      466,
+	"laser harp":                            627,
+	"lasso d'amore":                         189,
+	"launeddas":                             630,
+	"lautenwerck":                           912,
+	"lavta":                                 853,
+	"laúd":                                  953,
+	"lead vocals":                           4,
+	"level of studies":                      1135,
+	"limbe":                                 685,
+	"lira da braccio":                       1195,
+	"lirone":                                845,
+	"lithophone":                            1124,
+	"liuqin":                                754,
+	"live":                                  578,
+	"low whistle":                           482,
+	"lute":                                  108,
+	"lute family":                           1194,
+	"luthéal":                               885,
+	"lyra viol":                             1086,
+	"lyre":                                  109,
+	"madal":                                 242,
+	"maddale":                               649,
+	"mandocello":                            744,
+	"mandoguitar":                           986,
+	"mandola":                               308,
+	"mandolin":                              96,
+	"mandolute":                             914,
+	"mandora / gallichon":                   1052,
+	"maracas":                               142,
+	"marimba":                               216,
+	"marimba lumina":                        915,
+	"marímbula":                             778,
+	"master’s degree":                       1136,
+	"mbira":                                 110,
+	"meane vocals":                          1060,
+	"medium":                                568,
+	"medium 1":                              570,
+	"medium 2":                              569,
+	"medium 3":                              571,
+	"medium 4":                              577,
+	"medium 5":                              576,
+	"medium 6":                              575,
+	"medium 7":                              574,
+	"medium 8":                              573,
+	"medium 9":                              572,
+	"medley":                                750,
+	"mellophone":                            56,
+	"mellotron":                             175,
+	"melodica":                              67,
+	"melophone":                             1207,
+	"membranophone":                         125,
+	"mendoza":                               143,
+	"metallophone":                          428,
+	"mezzo-soprano vocals":                  9,
+	"mijwiz":                                1029,
+	"minipiano":                             900,
+	"minor":                                 2,
+	"mirliton":                              703,
+	"morin khuur":                           294,
+	"morsing":                               645,
+	"mouth harp":                            104,
+	"mouth organ":                           755,
+	"movement":                              1031,
+	"mridangam":                             584,
+	"mukkuri":                               858,
+	"musette de cour":                       883,
+	"music education":                       1202,
+	"music production":                      1201,
+	"music theory":                          1222,
+	"music therapy":                         1203,
+	"musical bow":                           97,
+	"musical box":                           557,
+	"musical saw":                           190,
+	"musical theatre":                       1221,
+	"musicology":                            1141,
+	"nabal":                                 831,
+	"nadaswaram":                            886,
+	"nagadou-daiko":                         665,
+	"nagak":                                 833,
+	"nai":                                   369,
+	"naobo":                                 743,
+	"natural brass instruments":             49,
+	"natural horn":                          634,
+	"natural trumpet":                       1072,
+	"ney":                                   652,
+	"nguru":                                 932,
+	"ngɔni":                                 877,
+	"njarka":                                1097,
+	"nohkan":                                603,
+	"nose flute":                            270,
+	"nose whistle":                          738,
+	"number":                                788,
+	"number opera":                          1032,
+	"nyatiti":                               862,
+	"nyckelharpa":                           298,
+	"oboe":                                  22,
+	"oboe d'amore":                          581,
+	"oboe da caccia":                        684,
+	"ocarina":                               29,
+	"ocean drum":                            456,
+	"octave mandolin":                       745,
+	"octavilla":                             1101,
+	"octavina":                              1102,
+	"octoban":                               1028,
+	"octobass":                              1096,
+	"oktawka":                               907,
+	"olifant":                               1045,
+	"omnichord":                             352,
+	"ondes Martenot":                        163,
+	"ondioline":                             964,
+	"opera":                                 1220,
+	"ophicleide":                            57,
+	"optional":                              1053,
+	"organ":                                 176,
+	"original":                              525,
+	"orpharion":                             631,
+	"orphica":                               992,
+	"other instruments":                     185,
+	"other level":                           1225,
+	"other subject":                         1128,
+	"other vocals":                          461,
+	"oud":                                   304,
+	"oval spinet":                           1036,
+	"pahū":                                  1014,
+	"pahū pounamu":                          939,
+	"paiban":                                1118,
+	"pakhawaj":                              612,
+	"pan flute":                             30,
+	"pang gu ly hu hmông":                   392,
+	"pardessus de viole":                    1085,
+	"parody":                                511,
+	"part of collection":                    1033,
+	"partial":                               579,
+	"pedal accordion":                       1270,
+	"pedal piano":                           751,
+	"pedal steel guitar":                    469,
+	"pemade":                                1155,
+	"percussion":                            124,
+	"percussion idiophone":                  1120,
+	"phách":                                 379,
+	"pi":                                    813,
+	"pi nai":                                814,
+	"piano":                                 180,
+	"piano accordion":                       439,
+	"piano duo":                             1209,
+	"piano four hands":                      1210,
+	"piano quartet":                         1142,
+	"piano spinet":                          947,
+	"piano trio":                            1070,
+	"piccolo":                               31,
+	"piccolo oboe":                          881,
+	"piccolo trumpet":                       486,
+	"pipa":                                  429,
+	"pipe and tabor":                        957,
+	"pipe organ":                            179,
+	"piri":                                  609,
+	"pkhachich":                             848,
+	"plucked idiophone":                     1090,
+	"plucked string instruments":            302,
+	"pluriarc":                              1211,
+	"pocket trumpet":                        838,
+	"poi":                                   1016,
+	"poi āwhiowhio":                         941,
+	"popular / rock music":                  1200,
+	"porotiti":                              1012,
+	"portative":                             1206,
+	"post horn":                             771,
+	"postgraduate":                          1219,
+	"practice chanter":                      515,
+	"prepared piano":                        590,
+	"primero":                               906,
+	"principal":                             618,
+	"psaltery":                              111,
+	"pí thiu":                               416,
+	"pākuru":                                1017,
+	"pātē":                                  874,
+	"pōrutu":                                936,
+	"pūkaea":                                937,
+	"pūmotomoto":                            934,
+	"pūpakapaka":                            1011,
+	"pūrerehua":                             940,
+	"pūtātara":                              938,
+	"pūtōrino":                              935,
+	"qilaut":                                758,
+	"quadruple reed":                        1061,
+	"quena":                                 528,
+	"quijada":                               903,
+	"quinto":                                901,
+	"rainstick":                             455,
+	"rammana":                               773,
+	"ranat ek":                              759,
+	"ranat kaeo":                            811,
+	"ranat thum":                            765,
+	"ratchet":                               211,
+	"rauschpfeife":                          739,
+	"ravanahatha":                           902,
+	"re":                                    952,
+	"rebab":                                 309,
+	"rebec":                                 276,
+	"reco-reco":                             869,
+	"recorder":                              32,
+	"reed organ":                            274,
+	"reeds":                                 233,
+	"regal":                                 1077,
+	"rehu":                                  933,
+	"renaissance rackett":                   1054,
+	"repeater":                              1039,
+	"repinique":                             870,
+	"resonator guitar":                      467,
+	"reyong":                                1152,
+	"rhythm sticks":                         449,
+	"riq":                                   406,
+	"rondador":                              680,
+	"ronroco":                               1264,
+	"rototom":                               879,
+	"ruan":                                  657,
+	"rubab":                                 1040,
+	"rudra veena":                           611,
+	"ryuteki":                               762,
+	"rōria":                                 1009,
+	"sabar":                                 792,
+	"sackbut":                               198,
+	"saduk":                                 954,
+	"saluang":                               1143,
+	"samba whistle":                         695,
+	"samica":                                980,
+	"sampler":                               164,
+	"sanshin":                               315,
+	"santoor":                               358,
+	"santur":                                325,
+	"sanxian":                               314,
+	"sarangi":                               552,
+	"sarod":                                 250,
+	"saron barung":                          1186,
+	"saron demung":                          1185,
+	"saron family":                          741,
+	"saron panerus":                         1187,
+	"saron peking":                          1188,
+	"saron wayang":                          1189,
+	"sarrusophone":                          897,
+	"sasando":                               1088,
+	"satsuma biwa":                          692,
+	"saw duang":                             769,
+	"saw sam sai":                           860,
+	"saw u":                                 770,
+	"saxophone":                             33,
+	"saxophone quartet":                     1103,
+	"saz":                                   566,
+	"scraped idiophone":                     1114,
+	"segunda":                               905,
+	"seni rebab":                            1193,
+	"serpent":                               197,
+	"setar":                                 775,
+	"shaken idiophone":                      1115,
+	"shakers":                               417,
+	"shakuhachi":                            224,
+	"shamisen":                              112,
+	"shawm":                                 493,
+	"shehnai":                               604,
+	"shekere":                               462,
+	"sheng":                                 68,
+	"shichepshin":                           847,
+	"shime-daiko":                           666,
+	"shinobue":                              564,
+	"sho":                                   262,
+	"shofar":                                60,
+	"shruti box":                            535,
+	"shudraga":                              705,
+	"siku":                                  681,
+	"singing":                               1199,
+	"singing bowl":                          191,
+	"single reed":                           234,
+	"sistrum":                               450,
+	"sitar":                                 113,
+	"slenthem":                              1123,
+	"slentho":                               1140,
+	"slide brass instruments":               272,
+	"slide guitar":                          79,
+	"slide whistle":                         268,
+	"slit drum":                             446,
+	"snare drum":                            129,
+	"solo":                                  596,
+	"song loan":                             356,
+	"sopilka":                               908,
+	"sopranino recorder":                    362,
+	"sopranino saxophone":                   537,
+	"soprano clarinet":                      488,
+	"soprano flute":                         498,
+	"soprano recorder":                      563,
+	"soprano saxophone":                     34,
+	"soprano violin":                        280,
+	"soprano vocals":                        10,
+	"sousaphone":                            200,
+	"spike-fiddle":                          1198,
+	"spilåpipa":                             625,
+	"spinet":                                946,
+	"spinettone":                            948,
+	"spoken vocals":                         561,
+	"spoons":                                210,
+	"steel guitar":                          80,
+	"steel-string acoustic guitar":          799,
+	"steelpan":                              344,
+	"step":                                  1020,
+	"stick zither":                          1216,
+	"string quartet":                        1067,
+	"string quintet":                        1218,
+	"string synthesizer":                    1226,
+	"string trio":                           1074,
+	"strings":                               69,
+	"struck idiophone":                      821,
+	"struck string instruments":             322,
+	"subcontrabass recorder":                368,
+	"subject":                               1125,
+	"suikinkutsu":                           192,
+	"suka":                                  878,
+	"suling":                                873,
+	"suona":                                 660,
+	"surbahar":                              1107,
+	"surdo":                                 459,
+	"sursingar":                             966,
+	"swarmandal":                            864,
+	"synclavier":                            165,
+	"synthesizer":                           166,
+	"syrinx":                                370,
+	"sáo meò":                               393,
+	"sáo trúc":                              269,
+	"säckpipa":                              839,
+	"sênh tiền":                             347,
+	"t'rưng":                                381,
+	"tabla":                                 241,
+	"table steel guitar":                    468,
+	"tabor":                                 956,
+	"tack piano":                            781,
+	"taepyeongso":                           786,
+	"taiko":                                 458,
+	"taishogoto":                            667,
+	"talharpa":                              656,
+	"talkbox":                               546,
+	"talking drum":                          457,
+	"tamborim":                              871,
+	"tambourine":                            333,
+	"tambura":                               473,
+	"tanbou ka":                             410,
+	"tanbur":                                586,
+	"tangent piano":                         510,
+	"tanpura":                               979,
+	"taonga pūoro":                          930,
+	"tap dance":                             671,
+	"tape":                                  632,
+	"taphon":                                815,
+	"tar drum":                              752,
+	"tar lute":                              560,
+	"taragot":                               532,
+	"tarota":                                1023,
+	"task":                                  1150,
+	"te kū":                                 1010,
+	"tef":                                   643,
+	"telharmonium":                          167,
+	"temple blocks":                         222,
+	"temür khuur":                           960,
+	"tenor banjo":                           803,
+	"tenor guitar":                          522,
+	"tenor horn / alto horn":                45,
+	"tenor recorder":                        364,
+	"tenor saxophone":                       36,
+	"tenor trombone":                        808,
+	"tenor viol":                            1083,
+	"tenor violin":                          760,
+	"tenor vocals":                          11,
+	"tenora":                                1022,
+	"thavil":                                589,
+	"theatre organ":                         426,
+	"theorbo":                               478,
+	"theremin":                              168,
+	"thon":                                  696,
+	"three-hole pipe":                       955,
+	"ti bwa":                                409,
+	"tible":                                 1024,
+	"timbales":                              132,
+	"time":                                  830,
+	"timpani":                               217,
+	"tin whistle":                           267,
+	"tinya":                                 872,
+	"tiple":                                 331,
+	"tiêu":                                  401,
+	"tololoche":                             894,
+	"tom-tom":                               555,
+	"tonkori":                               687,
+	"topshuur":                              856,
+	"toy piano":                             328,
+	"traditional basque ensemble":           1068,
+	"translated":                            517,
+	"translator":                            1018,
+	"transliterated":                        477,
+	"transverse flute":                      422,
+	"trautonium":                            958,
+	"treble flute":                          497,
+	"treble recorder / alto recorder":       363,
+	"treble viol":                           1082,
+	"treble violin":                         279,
+	"treble vocals":                         834,
+	"tres":                                  115,
+	"triangle":                              133,
+	"trikiti":                               997,
+	"tritantri veena":                       1106,
+	"tromba marina":                         846,
+	"trombone":                              46,
+	"trumpet":                               47,
+	"trumpet family":                        1073,
+	"tràm plè":                              385,
+	"trắng jâu":                             391,
+	"trắng lu":                              390,
+	"trống bông":                            382,
+	"tuba":                                  48,
+	"tubax":                                 842,
+	"tube zither":                           1105,
+	"tubon":                                 924,
+	"tubular bells":                         218,
+	"tubulum":                               1026,
+	"tumbi":                                 623,
+	"tumutumu":                              1013,
+	"tungso":                                1044,
+	"turntable":                             236,
+	"txalaparta":                            920,
+	"txistu":                                1005,
+	"typewriter":                            638,
+	"tzoura":                                556,
+	"tōkere":                                1015,
+	"udu":                                   407,
+	"ugal":                                  1144,
+	"uilleann pipes":                        248,
+	"ukeke":                                 776,
+	"ukulele":                               114,
+	"unspecified drum":                      1093,
+	"upright piano":                         184,
+	"vacuum cleaner":                        375,
+	"valiha":                                629,
+	"valve trombone":                        237,
+	"valved brass instruments":              271,
+	"veena":                                 1104,
+	"venu":                                  503,
+	"vessel flute":                          796,
+	"vibrandoneon":                          990,
+	"vibraphone":                            219,
+	"vibraslap":                             212,
+	"vichitra veena":                        757,
+	"video":                                 582,
+	"vielle":                                116,
+	"vihuela":                               332,
+	"viol consort":                          1212,
+	"viol family":                           1057,
+	"viola":                                 87,
+	"viola caipira":                         806,
+	"viola d'amore":                         119,
+	"viola da gamba":                        118,
+	"viola organista":                       297,
+	"violin":                                86,
+	"violin family":                         82,
+	"violin octet":                          1056,
+	"violino piccolo":                       807,
+	"viololyra":                             984,
+	"violoncello piccolo":                   841,
+	"violone":                               505,
+	"violotta":                              120,
+	"virginal":                              621,
+	"vocal":                                 3,
+	"vocoder":                               354,
+	"voice synthesizer":                     542,
+	"vuvuzela":                              1034,
+	"walaycho":                              1263,
+	"washboard":                             209,
+	"washtub bass":                          121,
+	"waterphone":                            351,
+	"wavedrum":                              454,
+	"whip / slapstick":                      134,
+	"whistle":                               345,
+	"whistling":                             1151,
+	"willow flute":                          266,
+	"wind chime":                            607,
+	"wind instruments":                      15,
+	"wind synthesizer":                      672,
+	"wire-strung harp":                      434,
+	"wood block":                            213,
+	"wooden fish":                           337,
+	"woodwind":                              16,
+	"wot":                                   859,
+	"xalam":                                 122,
+	"xiao":                                  593,
+	"xiaoluo":                               662,
+	"xun":                                   797,
+	"xylophone":                             220,
+	"xylorimba":                             851,
+	"yangqin":                               324,
+	"yatga":                                 676,
+	"yaylı tanbur":                          639,
+	"yehu":                                  292,
+	"yonggo":                                832,
+	"yoochin":                               1042,
+	"yu":                                    1048,
+	"yueqin":                                310,
+	"zabumba":                               863,
+	"zarb":                                  405,
+	"zhaleika":                              840,
+	"zhonghu":                               287,
+	"zhongruan":                             311,
+	"zhuihu":                                1066,
+	"zill":                                  548,
+	"zither":                                123,
+	"zurna":                                 492,
+	"çevgen":                                888,
+	"ütőgardon":                             916,
+	"čelo":                                  977,
+	"đing buốt":                             383,
+	"đing năm":                              396,
+	"đàn bầu":                               321,
+	"đàn nguyệt":                            312,
+	"đàn nhị":                               295,
+	"đàn tam":                               414,
+	"đàn tam thập lục":                      327,
+	"đàn tranh":                             320,
+	"đàn tứ":                                753,
+	"đàn tứ dây":                            303,
+	"đàn tỳ bà":                             313,
+	"ģīga":                                  844,
+	"ōtsuzumi":                              670,
+	"šargija":                               826,
+	"żafżafa":                               701,
+	"żaqq":                                  702,
+	"żummara":                               704,
+}
+
+// languageNames maps the IDs of all languages to their names.
+// Unlike the Language enum, low-frequency languages are included.
+var languageNames = map[Language]string{
+	5267: "!O!ung",
+	4909: "!Xóõ",
+	712:  "'Are'are",
+	3576: "'Auhelawa",
+	2738: "//Ani",
+	2508: "//Gana",
+	7235: "//Xegwi",
+	2620: "/Gwi",
+	7193: "/Xam",
+	2785: "=/Hua",
+	887:  "=/Kx'au//'ein",
+	782:  "A'ou",
+	781:  "A'tong",
+	800:  "A-Pucikwar",
+	661:  "Aari",
+	503:  "Aasáx",
+	3130: "Abadi",
+	515:  "Abaga",
+	514:  "Abai Sungai",
+	520:  "Abanyom",
+	4234: "Abar",
+	505:  "Abau",
+	524:  "Abaza",
+	523:  "Abellen Ayta",
+	517:  "Abidji",
+	1397: "Abinomn",
+	936:  "Abipon",
+	842:  "Abishira",
+	2:    "Abkhazian",
+	766:  "Abom",
+	522:  "Abon",
+	525:  "Abron",
+	564:  "Abu",
+	494:  "Abu' Arapesh",
+	521:  "Abua",
+	533:  "Abui",
+	3253: "Abun",
+	528:  "Abure",
+	4185: "Abureni",
+	509:  "Abé",
+	6597: "Acatepec Me'phaa",
+	534:  "Achagua",
+	542:  "Achang",
+	7519: "Ache",
+	553:  "Acheron",
+	545:  "Achi",
+	3:    "Achinese",
+	547:  "Achterhoeks",
+	548:  "Achuar-Shiwiar",
+	549:  "Achumawi",
+	2591: "Aché",
+	4:    "Acoli",
+	546:  "Acroá",
+	554:  "Adabe",
+	7189: "Adai",
+	2320: "Adamawa Fulfulde",
+	568:  "Adamorobe Sign Language",
+	563:  "Adang",
+	566:  "Adangbe",
+	5:    "Adangme",
+	565:  "Adap",
+	3098: "Adara",
+	6458: "Adasen",
+	556:  "Adele",
+	559:  "Adhola",
+	560:  "Adi",
+	561:  "Adioukrou",
+	2104: "Adithinngithigh",
+	5231: "Adivasi Oriya",
+	2355: "Adiwasi Garasia",
+	569:  "Adnyamathanha",
+	567:  "Adonara",
+	570:  "Aduge",
+	6:    "Adyghe",
+	573:  "Adzera",
+	589:  "Aeka",
+	923:  "Aekyom",
+	7190: "Aequian",
+	583:  "Aer",
+	497:  "Afade",
+	1:    "Afar",
+	593:  "Afghan Sign Language",
+	600:  "Afitti",
+	8:    "Afrihili",
+	9:    "Afrikaans",
+	7:    "Afro-Asiatic languages",
+	599:  "Afro-Seminole Creole",
+	606:  "Agarabi",
+	611:  "Agariya",
+	605:  "Agatu",
+	913:  "Agavotaguerra",
+	618:  "Aghem",
+	631:  "Aghu",
+	2438: "Aghu Tharnggalu",
+	625:  "Aghul",
+	7191: "Aghwan",
+	647:  "Agi",
+	3299: "Agob",
+	2825: "Agoi",
+	622:  "Aguacateco",
+	603:  "Aguano",
+	619:  "Aguaruna",
+	888:  "Aguna",
+	4472: "Agusan Manobo",
+	616:  "Agutaynen",
+	7473: "Agwagwune",
+	628:  "Ahanta",
+	6429: "Aheu",
+	639:  "Ahirani",
+	637:  "Ahom",
+	641:  "Ahtena",
+	4765: "Ahwai",
+	649:  "Ai-Cham",
+	662:  "Aighon",
+	6303: "Aikanã",
+	4561: "Aiklep",
+	657:  "Aimaq",
+	653:  "Aimele",
+	654:  "Aimol",
+	644:  "Ainbai",
+	473:  "Ainu",
+	643:  "Ainu (China)",
+	680:  "Aiome",
+	658:  "Airoran",
+	655:  "Aiton",
+	665:  "Aja (Benin)",
+	664:  "Aja (Sudan)",
+	671:  "Ajawa",
+	666:  "Ajië",
+	1782: "Ajyíninka Apurucayali",
+	686:  "Ak",
+	6089: "Aka",
+	518:  "Aka-Bea",
+	683:  "Aka-Bo",
+	538:  "Aka-Cari",
+	681:  "Aka-Jeru",
+	693:  "Aka-Kede",
+	694:  "Aka-Kol",
+	539:  "Aka-Kora",
+	10:   "Akan",
+	540:  "Akar-Bale",
+	688:  "Akaselem",
+	676:  "Akawaio",
+	652:  "Ake",
+	3205: "Akebu",
+	6650: "Akei",
+	586:  "Akeu",
+	633:  "Akha",
+	691:  "Akhvakh",
+	11:   "Akkadian",
+	5960: "Akkala Sami",
+	682:  "Aklanon",
+	689:  "Akolet",
+	1414: "Akoose",
+	4244: "Akoye",
+	677:  "Akpa",
+	2822: "Akpes",
+	594:  "Akrukay",
+	6113: "Akukem",
+	950:  "Akuku",
+	690:  "Akum",
+	817:  "Akuntsu",
+	684:  "Akurio",
+	692:  "Akwa",
+	4982: "Akyaung Ari Naga",
+	6269: "Al-Sayyid Bedouin Sign Language",
+	713:  "Alaba-K’abeena",
+	695:  "Alabama",
+	2125: "Alabat Island Agta",
+	4237: "Alacatlatzala Mixtec",
+	696:  "Alago",
+	7005: "Alagwa",
+	703:  "Alak",
+	730:  "Alamblak",
+	702:  "Alangan",
+	7299: "Alanic",
+	805:  "Alapmunte",
+	700:  "Alawa",
+	12:   "Albanian",
+	6126: "Albanian Sign Language",
+	7397: "Alcozauca Mixtec",
+	699:  "Alege",
+	2344: "Alekano",
+	13:   "Aleut",
+	827:  "Algerian Arabic",
+	500:  "Algerian Saharan Arabic",
+	849:  "Algerian Sign Language",
+	14:   "Algonquian languages",
+	709:  "Algonquin",
+	663:  "Ali",
+	698:  "Alladian",
+	704:  "Allar",
+	645:  "Alngith",
+	7610: "Alo Phola",
+	776:  "Alor",
+	7688: "Aloápam Zapotec",
+	585:  "Alsea",
+	434:  "Altaic languages",
+	7418: "Alu Kurumba",
+	884:  "Alugu",
+	488:  "Alumu-Tesu",
+	708:  "Alune",
+	7581: "Aluo",
+	716:  "Alur",
+	710:  "Alutor",
+	907:  "Alviri-Vidari",
+	715:  "Alyawarr",
+	727:  "Ama (Papua New Guinea)",
+	5093: "Ama (Sudan)",
+	731:  "Amahai",
+	719:  "Amahuaca",
+	701:  "Amaimon",
+	490:  "Amal",
+	728:  "Amanab",
+	717:  "Amanayé",
+	646:  "Amara",
+	732:  "Amarakaeri",
+	508:  "Amarasi",
+	7797: "Amatlán Zapotec",
+	6876: "Amba (Solomon Islands)",
+	5804: "Amba (Uganda)",
+	725:  "Ambai",
+	587:  "Ambakich",
+	511:  "Ambala Ayta",
+	736:  "Ambelau",
+	580:  "Ambele",
+	705:  "Amblong",
+	718:  "Ambo",
+	5628: "Ambo-Pasco Quechua",
+	526:  "Ambonese Malay",
+	493:  "Ambrak",
+	798:  "Ambul",
+	527:  "Ambulas",
+	724:  "Amdang",
+	572:  "Amdo Tibetan",
+	588:  "Amele",
+	839:  "American Sign Language",
+	2844: "Amganad Ifugao",
+	15:   "Amharic",
+	739:  "Ami",
+	723:  "Amis",
+	729:  "Amo",
+	714:  "Amol",
+	4077: "Amoltepec Mixtec",
+	790:  "Ampanang",
+	811:  "Ampari Dogon",
+	672:  "Amri Karbi",
+	734:  "Amto",
+	571:  "Amundava",
+	722:  "Amurdak",
+	2105: "Ana Tinga Dogon",
+	761:  "Anaang",
+	678:  "Anakalangu",
+	752:  "Anal",
+	5327: "Anam",
+	499:  "Anambé",
+	2893: "Anamgura",
+	1336: "Anasi",
+	2669: "Ancient Hebrew",
+	7314: "Ancient Macedonian",
+	7330: "Ancient North Arabian",
+	7450: "Ancient Zapotec",
+	1961: "Andaandi",
+	591:  "Andai",
+	667:  "Andajin",
+	7186: "Andalusian Arabic",
+	2672: "Andaman Creole Hindi",
+	741:  "Andaqui",
+	768:  "Andarum",
+	558:  "Andegerebinha",
+	756:  "Andh",
+	748:  "Andi",
+	1565: "Andio",
+	742:  "Andoa",
+	754:  "Andoque",
+	762:  "Andra-Hus",
+	881:  "Aneityum",
+	764:  "Anem",
+	532:  "Aneme Wake",
+	4617: "Anfillo",
+	615:  "Angaataha",
+	607:  "Angal",
+	769:  "Angal Enen",
+	679:  "Angal Heneng",
+	4846: "Angami Naga",
+	7554: "Angguruk Yali",
+	475:  "Angika",
+	911:  "Angkamuthi",
+	7335: "Anglo-Norman",
+	5736: "Angloromani",
+	765:  "Angolar",
+	609:  "Angor",
+	771:  "Angoram",
+	6537: "Angosturas Tunebo",
+	921:  "Anguthimri",
+	7609: "Ani Phowa",
+	1241: "Anii",
+	746:  "Animere",
+	773:  "Anindilyakwa",
+	1309: "Anjam",
+	496:  "Ankave",
+	738:  "Anmatyerre",
+	5047: "Anong",
+	749:  "Anor",
+	757:  "Anserma",
+	744:  "Ansus",
+	758:  "Antakarinya",
+	7325: "Antankarana Malagasy",
+	648:  "Antigua and Barbuda Creole English",
+	751:  "Anu-Hkongso Chin",
+	759:  "Anuak",
+	1708: "Anufo",
+	890:  "Anuki",
+	898:  "Anus",
+	886:  "Anuta",
+	763:  "Anyin",
+	4486: "Anyin Morofo",
+	4848: "Ao Naga",
+	5466: "Aoheng",
+	779:  "Aore",
+	3134: "Ap Ma",
+	17:   "Apache languages",
+	7196: "Apalachee",
+	808:  "Apalaí",
+	2208: "Apali",
+	4239: "Apasco-Apoala Mixtec",
+	803:  "Apatani",
+	792:  "Apiaká",
+	797:  "Apinayé",
+	799:  "Apma",
+	638:  "Aproumu Aizi",
+	804:  "Apurinã",
+	807:  "Aputai",
+	7197: "Aquitanian",
+	819:  "Arabana",
+	825:  "Arabela",
+	18:   "Arabic",
+	20:   "Aragonese",
+	687:  "Araki",
+	873:  "Aralle-Tabulahan",
+	498:  "Aramanik",
+	6189: "Arammba",
+	492:  "Aranadan",
+	7375: "Aranama-Tamique",
+	2991: "Arandai",
+	826:  "Araona",
+	23:   "Arapaho",
+	823:  "Arapaso",
+	25:   "Arawak",
+	930:  "Araweté",
+	925:  "Arawum",
+	831:  "Arbore",
+	491:  "Arbëreshë Albanian",
+	810:  "Archi",
+	5403: "Ardhamāgadhī Prākrit",
+	4557: "Are",
+	574:  "Areba",
+	581:  "Arem",
+	5660: "Arequipa-La Unión Quechua",
+	577:  "Argentine Sign Language",
+	612:  "Argobba",
+	608:  "Arguni",
+	821:  "Arhuaco",
+	816:  "Arhâ",
+	775:  "Arhö",
+	489:  "Ari",
+	3743: "Aribwatsa",
+	7560: "Aribwaung",
+	495:  "Arifama-Miniafia",
+	812:  "Arigidi",
+	824:  "Arikapú",
+	822:  "Arikara",
+	660:  "Arikem",
+	7373: "Arin",
+	4003: "Aringa",
+	772:  "Arma",
+	7372: "Armazic",
+	21:   "Armenian",
+	582:  "Armenian Sign Language",
+	479:  "Aromanian",
+	801:  "Arop-Lokep",
+	802:  "Arop-Sissano",
+	642:  "Arosi",
+	2314: "Arpitan",
+	5780: "Arritinngithigh",
+	882:  "Arta",
+	4483: "Aruamu",
+	899:  "Aruek",
+	3992: "Aruop",
+	880:  "Arutani",
+	830:  "Aruá (Amazonas State)",
+	832:  "Aruá (Rodonia State)",
+	504:  "Arvanitika Albanian",
+	858:  "As",
+	4506: "Asaro'o",
+	838:  "Asas",
+	640:  "Ashe",
+	845:  "Ashkun",
+	1820: "Asho Chin",
+	870:  "Ashtiani",
+	1749: "Asháninka",
+	1700: "Ashéninka Pajonal",
+	5529: "Ashéninka Perené",
+	846:  "Asilulu",
+	2174: "Askopan",
+	854:  "Asoa",
+	26:   "Assamese",
+	7393: "Assan",
+	5985: "Assangori",
+	836:  "Assiniboine",
+	650:  "Assyrian Neo-Aramaic",
+	27:   "Asturian",
+	894:  "Asu (Nigeria)",
+	835:  "Asu (Tanzania)",
+	5538: "Asue Awyu",
+	883:  "Asumboa",
+	7779: "Asunción Mixtepec Zapotec",
+	851:  "Asuri",
+	869:  "Ata",
+	862:  "Ata Manobo",
+	815:  "Atakapa",
+	740:  "Atampaya",
+	4227: "Atatláhuca Mixtec",
+	6301: "Atayal",
+	863:  "Atemble",
+	28:   "Athapascan languages",
+	791:  "Athpariya",
+	867:  "Ati",
+	866:  "Atikamekw",
+	813:  "Atohwaim",
+	871:  "Atong",
+	783:  "Atorada",
+	861:  "Atsahuaca",
+	1635: "Atsam",
+	879:  "Atsugewi",
+	5411: "Attapady Kurumba",
+	865:  "Attié",
+	5130: "Atzingo Matlatzinca",
+	915:  "Au",
+	893:  "Aulua",
+	903:  "Aurá",
+	889:  "Aushi",
+	914:  "Aushiri",
+	900:  "Austral",
+	855:  "Australian Aborigines Sign Language",
+	840:  "Australian Sign Language",
+	29:   "Australian languages",
+	850:  "Austrian Sign Language",
+	263:  "Austronesian languages",
+	6046: "Auwe",
+	901:  "Auye",
+	896:  "Auyokawa",
+	30:   "Avaric",
+	912:  "Avatime",
+	906:  "Avau",
+	31:   "Avestan",
+	908:  "Avikam",
+	916:  "Avokaya",
+	917:  "Avá-Canoeiro",
+	6976: "Awa (China)",
+	918:  "Awa (Papua New Guinea)",
+	3630: "Awa-Cuaiquer",
+	924:  "Awabakal",
+	1034: "Awad Bing",
+	32:   "Awadhi",
+	927:  "Awak",
+	942:  "Awar",
+	934:  "Awara",
+	922:  "Awbono",
+	1303: "Aweer",
+	928:  "Awera",
+	920:  "Awetí",
+	969:  "Awing",
+	904:  "Awiyaana",
+	891:  "Awjilah",
+	926:  "Awngi",
+	2621: "Awngthim",
+	3394: "Awtuw",
+	7533: "Awu",
+	933:  "Awun",
+	601:  "Awutu",
+	902:  "Awyi",
+	629:  "Axamb",
+	7535: "Axi Yi",
+	945:  "Ayabadhu",
+	5626: "Ayacucho Quechua",
+	6959: "Ayautla Mazatec",
+	946:  "Ayere",
+	937:  "Ayerrerenge",
+	955:  "Ayi (Papua New Guinea)",
+	4766: "Ayiwo",
+	7671: "Ayizi",
+	943:  "Ayizo Gbe",
+	33:   "Aymara",
+	7679: "Ayoquesco Zapotec",
+	953:  "Ayoreo",
+	959:  "Ayu",
+	4246: "Ayutla Mixtec",
+	34:   "Azerbaijani",
+	962:  "Azha",
+	7537: "Azhe",
+	6580: "Azoyú Me'phaa",
+	1476: "Baan",
+	1370: "Baangi",
+	988:  "Baatonum",
+	1010: "Baba",
+	4058: "Baba Malay",
+	1013: "Babalia Creole Arabic",
+	1000: "Babango",
+	998:  "Babanki",
+	972:  "Babatana",
+	1031: "Babine",
+	1570: "Babuza",
+	1037: "Bacama",
+	1429: "Bacanese Malay",
+	7207: "Bactrian",
+	1159: "Bada (Indonesia)",
+	983:  "Bada (Nigeria)",
+	1102: "Badaga",
+	1043: "Bade",
+	1064: "Badeshi",
+	1160: "Badimaya",
+	974:  "Badui",
+	5303: "Badyara",
+	1470: "Baeggu",
+	1469: "Baelelea",
+	1436: "Baetora",
+	1095: "Bafanji",
+	1510: "Bafaw-Balong",
+	3527: "Bafia",
+	1089: "Bafut",
+	1020: "Baga Binari",
+	1352: "Baga Kaloum",
+	1124: "Baga Koga",
+	1255: "Baga Manduri",
+	1122: "Baga Mboteni",
+	1411: "Baga Sitemu",
+	1417: "Baga Sobané",
+	1109: "Bagheli",
+	1260: "Bagirmi",
+	2326: "Bagirmi Fulfulde",
+	1353: "Bago-Kusuntu",
+	1126: "Bagri",
+	1330: "Bagupi",
+	1349: "Bagusa",
+	3596: "Bagvalal",
+	7515: "Baha Buyang",
+	1061: "Baham",
+	978:  "Bahamas Creole English",
+	529:  "Baharna Arabic",
+	1155: "Bahau",
+	1187: "Bahinemo",
+	1145: "Bahing",
+	1055: "Bahnar",
+	1416: "Bahonsuai",
+	1048: "Bai",
+	993:  "Baibai",
+	1225: "Baikeno",
+	1354: "Baima",
+	1275: "Baimak",
+	1038: "Bainouk-Gunyaamolo",
+	973:  "Bainouk-Gunyuño",
+	1015: "Bainouk-Samik",
+	1418: "Baiso",
+	2267: "Baissa Fali",
+	1196: "Bajan",
+	1192: "Bajelani",
+	1205: "Baka (Cameroon)",
+	1046: "Baka (Sudan)",
+	1218: "Bakairí",
+	1372: "Bakaka",
+	1355: "Bakhtiari",
+	1210: "Baki",
+	1209: "Bakoko",
+	3385: "Bakole",
+	1006: "Bakpinka",
+	1219: "Bakumpai",
+	1200: "Bakwé",
+	1245: "Balaesang",
+	1248: "Balangao",
+	6159: "Balangingi",
+	1197: "Balanta-Ganja",
+	1231: "Balanta-Kentohe",
+	1251: "Balantak",
+	1233: "Balau",
+	1052: "Baldemu",
+	1029: "Bali (Democratic Republic of Congo)",
+	1027: "Bali (Nigeria)",
+	40:   "Balinese",
+	4216: "Balinese Malay",
+	1133: "Balkan Gagauz Turkish",
+	5744: "Balkan Romani",
+	1361: "Balo",
+	1180: "Baloi",
+	1105: "Balti",
+	5742: "Baltic Romani",
+	43:   "Baltic languages",
+	1243: "Baluan-Pam",
+	38:   "Baluchi",
+	1306: "Bamako Sign Language",
+	1004: "Bamali",
+	1266: "Bambalang",
+	5565: "Bambam",
+	39:   "Bambara",
+	4609: "Bambassi",
+	985:  "Bambili-Bambui",
+	1018: "Bamenyam",
+	36:   "Bamileke languages",
+	1019: "Bamu",
+	1366: "Bamukumbit",
+	986:  "Bamun",
+	1479: "Bamunka",
+	1258: "Bamwe",
+	1096: "Ban Khor Sign Language",
+	1036: "Bana",
+	1201: "Banao Itneg",
+	1563: "Banaro",
+	1281: "Banda (Indonesia)",
+	1338: "Banda Malay",
+	35:   "Banda languages",
+	3861: "Banda-Bambari",
+	1327: "Banda-Banda",
+	1357: "Banda-Mbrès",
+	1097: "Banda-Ndélé",
+	7460: "Banda-Yangere",
+	1564: "Bandi",
+	1356: "Bandial",
+	1063: "Bandjalang",
+	1522: "Bangala",
+	1116: "Bangandu",
+	992:  "Bangba",
+	1135: "Banggai",
+	1182: "Banggarla",
+	1285: "Bangi",
+	1904: "Bangi Me",
+	4152: "Bangka",
+	1119: "Bangolan",
+	1299: "Bangubangu",
+	1405: "Bangwinji",
+	1486: "Baniva",
+	1499: "Baniwa",
+	1193: "Banjar",
+	1537: "Bankagooma",
+	3036: "Bankal",
+	1922: "Bankan Tey Dogon",
+	510:  "Bankon",
+	1026: "Bannoni",
+	981:  "Bantawa",
+	1108: "Bantayanon",
+	1293: "Bantik",
+	1291: "Bantoanon",
+	55:   "Bantu languages",
+	1022: "Baoulé",
+	1151: "Bara Malagasy",
+	1375: "Baraamu",
+	5689: "Barababaraba",
+	989:  "Barai",
+	979:  "Barakai",
+	3691: "Baram Kayan",
+	994:  "Barama",
+	1383: "Barambu",
+	1277: "Baramu",
+	1386: "Barapasi",
+	1389: "Baras",
+	1409: "Barasana-Eduria",
+	1326: "Barbacoas",
+	1308: "Barbareño",
+	2564: "Barclayville Grebo",
+	1023: "Bardi",
+	1467: "Barein",
+	4309: "Bargam",
+	1086: "Bari",
+	1021: "Bariai",
+	1183: "Bariji",
+	1530: "Barikanchi",
+	2992: "Barikewa",
+	1190: "Barok",
+	996:  "Barombi",
+	6316: "Barro Negro Tunebo",
+	1341: "Barrow Point",
+	1203: "Baruga",
+	1556: "Baruya",
+	1497: "Barwe",
+	1185: "Barzani Jewish Neo-Aramaic",
+	975:  "Baré",
+	4379: "Barí",
+	42:   "Basa",
+	1586: "Basa (Nigeria)",
+	1407: "Basa-Gumna",
+	1452: "Basa-Gurmana",
+	1040: "Basap",
+	1555: "Basay",
+	1402: "Bashkardi",
+	37:   "Bashkir",
+	1415: "Basketo",
+	41:   "Basque",
+	1412: "Bassa",
+	1413: "Bassa-Kontagora",
+	1399: "Bassari",
+	1404: "Bassossi",
+	1421: "Bata",
+	2845: "Batad Ifugao",
+	1540: "Batak",
+	1444: "Batak Alas-Kluet",
+	673:  "Batak Angkola",
+	1423: "Batak Dairi",
+	1442: "Batak Karo",
+	1431: "Batak Mandailing",
+	1437: "Batak Simalungun",
+	990:  "Batak Toba",
+	59:   "Batak languages",
+	1289: "Batanga",
+	1435: "Batek",
+	1440: "Bateri",
+	1147: "Bathari",
+	1422: "Bati (Cameroon)",
+	1484: "Bati (Indonesia)",
+	999:  "Bats",
+	1439: "Batu",
+	7700: "Batui",
+	987:  "Batuley",
+	991:  "Bau",
+	6064: "Bau Bidayuh",
+	1401: "Bauchi",
+	1377: "Baure",
+	1115: "Bauria",
+	1516: "Bauro",
+	1501: "Bauwaki",
+	1490: "Bauzi",
+	982:  "Bavarian",
+	1127: "Bawm Chin",
+	4285: "Bay Miwok",
+	1202: "Bayali",
+	1489: "Baybayanon",
+	1546: "Baygo",
+	1551: "Bayono",
+	1039: "Bayot",
+	1525: "Bayungu",
+	1103: "Bazigar",
+	1075: "Beami",
+	1065: "Beaver",
+	1101: "Beba",
+	1585: "Bebe",
+	1066: "Bebele",
+	1074: "Bebeli",
+	1531: "Bebil",
+	6549: "Bedik",
+	1199: "Bedjond",
+	1068: "Bedoanas",
+	1207: "Beeke",
+	1532: "Beele",
+	1077: "Beembe",
+	1301: "Beezen",
+	1012: "Befang",
+	44:   "Beja",
+	1073: "Bekati'",
+	1223: "Bekwarra",
+	1224: "Bekwel",
+	1071: "Belait",
+	1517: "Belanda Bor",
+	1475: "Belanda Viri",
+	45:   "Belarusian",
+	1560: "Belhariya",
+	1084: "Beli (Papua New Guinea)",
+	1239: "Beli (Sudan)",
+	1573: "Belize Kriol English",
+	1229: "Bella Coola",
+	1393: "Bellari",
+	46:   "Bemba",
+	1276: "Bemba (Democratic Republic of Congo)",
+	1253: "Bembe",
+	1919: "Ben Tey Dogon",
+	7648: "Bena (Nigeria)",
+	1085: "Bena (Tanzania)",
+	1070: "Benabena",
+	1030: "Bench",
+	1054: "Bende",
+	1033: "Bendi",
+	4795: "Beng",
+	1284: "Benga",
+	47:   "Bengali",
+	1134: "Benggoi",
+	1371: "Bengkala Sign Language",
+	1296: "Bentong",
+	1543: "Benyadu'",
+	1448: "Beothuk",
+	1164: "Bepour",
+	1376: "Bera",
+	1536: "Berakou",
+	1471: "Berau Malay",
+	48:   "Berber languages",
+	1374: "Berbice Creole Dutch",
+	1213: "Berik",
+	1174: "Berinomo",
+	1312: "Berom",
+	7158: "Berta",
+	1558: "Berti",
+	4205: "Besisi",
+	1078: "Besme",
+	1076: "Besoa",
+	1090: "Betaf",
+	1082: "Betawi",
+	1545: "Bete",
+	1438: "Bete-Bendi",
+	2221: "Beti (Côte d'Ivoire)",
+	7419: "Betta Kurumba",
+	3107: "Bezhta",
+	1139: "Bhadrawahi",
+	1157: "Bhalay",
+	1136: "Bharia",
+	1430: "Bhatola",
+	1132: "Bhatri",
+	1153: "Bhattiyali",
+	1140: "Bhaya",
+	1158: "Bhele",
+	1144: "Bhilali",
+	1137: "Bhili",
+	49:   "Bhojpuri",
+	4757: "Bhoti Kinnauri",
+	1547: "Bhujel",
+	1154: "Bhunjia",
+	1165: "Biafada",
+	1044: "Biage",
+	1156: "Biak",
+	1072: "Biali",
+	1343: "Bian Marind",
+	1166: "Biangai",
+	1550: "Biao",
+	1271: "Biao Mon",
+	1184: "Biao-Jiao Mien",
+	1427: "Biatah Bidayuh",
+	2864: "Bidhawal",
+	1163: "Bidiyo",
+	1552: "Bidyara",
+	1186: "Bidyogo",
+	1254: "Biem",
+	1287: "Bierebo",
+	1380: "Bieria",
+	1175: "Biete",
+	4897: "Big Nambas",
+	1138: "Biga",
+	50:   "Bihari languages",
+	1178: "Bijori",
+	1162: "Bikaru",
+	51:   "Bikol",
+	1541: "Bikya",
+	1171: "Bila",
+	1358: "Bilakura",
+	3229: "Bilaspuri",
+	1347: "Bilba",
+	1396: "Bilbil",
+	1168: "Bile",
+	1270: "Bilma Kanuri",
+	1238: "Biloxi",
+	1228: "Bilua",
+	1521: "Bilur",
+	1149: "Bima",
+	1146: "Bimin",
+	1169: "Bimoba",
+	1549: "Bina (Nigeria)",
+	1265: "Bina (Papua New Guinea)",
+	1539: "Binahari",
+	1142: "Binandere",
+	1313: "Bine",
+	52:   "Bini",
+	1331: "Binji",
+	2940: "Binongan Itneg",
+	1282: "Bintauna",
+	1300: "Bintulu",
+	1206: "Binukid",
+	1195: "Binumarien",
+	1172: "Bipi",
+	1520: "Birale",
+	1388: "Birao",
+	1425: "Birgit",
+	1179: "Birhor",
+	1581: "Biri",
+	1363: "Biritai",
+	1381: "Birked",
+	1482: "Birri",
+	1382: "Birwa",
+	2866: "Biseni",
+	1346: "Bishnupriya",
+	1498: "Bishuo",
+	1298: "Bisis",
+	53:   "Bislama",
+	1173: "Bisorio",
+	1161: "Bissa",
+	1572: "Bisu",
+	1120: "Bit",
+	1390: "Bitare",
+	4080: "Bitur",
+	1503: "Biwat",
+	1553: "Biyo",
+	1334: "Biyom",
+	1242: "Blablanga",
+	1093: "Blafe",
+	1080: "Blagar",
+	1244: "Blang",
+	64:   "Blin",
+	482:  "Blissymbols",
+	1121: "Bo (Laos)",
+	1344: "Bo (Papua New Guinea)",
+	4042: "Bo-Rukul",
+	4528: "Bo-Ung",
+	1577: "Boano (Maluku)",
+	1575: "Boano (Sulawesi)",
+	1112: "Bobongko",
+	1443: "Bobot",
+	1323: "Bodo (Central African Republic)",
+	1394: "Bodo (India)",
+	2368: "Bodo Gadaba",
+	1060: "Bodo Parja",
+	1091: "Bofi",
+	1487: "Boga",
+	1316: "Bogaya",
+	1464: "Boghom",
+	1367: "Boguru",
+	1148: "Bohtan Neo-Aramaic",
+	1569: "Boikin",
+	7481: "Bokha",
+	1350: "Boko (Benin)",
+	1217: "Boko (Democratic Republic of Congo)",
+	1459: "Bokobaru",
+	1058: "Bokoto",
+	1226: "Bokyi",
+	1292: "Bola",
+	1230: "Bolango",
+	1311: "Bole",
+	7211: "Bolgarian",
+	1481: "Bolgo",
+	1235: "Bolia",
+	6049: "Bolinao",
+	1478: "Bolivian Sign Language",
+	1247: "Bolo",
+	1221: "Boloki",
+	1305: "Bolon",
+	1576: "Bolondo",
+	1236: "Bolongan",
+	5435: "Bolyu",
+	1257: "Bom",
+	1307: "Boma",
+	1263: "Bomboli",
+	1509: "Bomboma",
+	7768: "Bomitaba",
+	1268: "Bomu",
+	1274: "Bomwali",
+	2479: "Bon Gula",
+	5340: "Bonan",
+	1319: "Bondei",
+	1107: "Bondo",
+	3701: "Bondoukou Kulango",
+	1920: "Bondum Dom Dogon",
+	1278: "Bonerate",
+	1297: "Bonerif",
+	1045: "Bonggi",
+	1328: "Bonggo",
+	1451: "Bongili",
+	1318: "Bongo",
+	1342: "Bongu",
+	1310: "Bonjo",
+	1473: "Bonkeng",
+	1315: "Bonkiman",
+	1280: "Bontok",
+	1279: "Bookan",
+	1288: "Boon",
+	1472: "Boor",
+	1302: "Bora",
+	2359: "Borana-Arsi-Guji Oromo",
+	3609: "Border Kuna",
+	2345: "Borei",
+	2323: "Borgu Fulfulde",
+	1538: "Borna (Democratic Republic of Congo)",
+	1505: "Boro (Ethiopia)",
+	7442: "Boro (Ghana)",
+	3539: "Borong",
+	1384: "Boruca",
+	1317: "Borôro",
+	1496: "Boselewa",
+	1365: "Bosngun",
+	56:   "Bosnian",
+	1261: "Bote-Majhi",
+	1329: "Botlikh",
+	5838: "Botolan Sambal",
+	4875: "Bouna Kulango",
+	5312: "Bouyei",
+	1578: "Bozaba",
+	770:  "Bragat",
+	1378: "Brahui",
+	57:   "Braj",
+	1582: "Brazilian Sign Language",
+	1458: "Brem",
+	1387: "Breri",
+	58:   "Breton",
+	1567: "Bribri",
+	1583: "Brithenig",
+	1094: "British Sign Language",
+	1385: "Brokkat",
+	5930: "Brokpake",
+	1212: "Brokskat",
+	5434: "Brooke's Point Palawano",
+	1333: "Broome Pearling Lugger Pidgin",
+	3651: "Brunei",
+	1398: "Brunei Bisaya",
+	3023: "Bu",
+	1513: "Bu-Nao Bunu",
+	1445: "Bua",
+	1620: "Bualkhaw Chin",
+	1322: "Buamu",
+	1468: "Bube",
+	1463: "Bubi",
+	1011: "Bubia",
+	6198: "Budeh Stieng",
+	1434: "Budibud",
+	1062: "Budong-Budong",
+	1461: "Budu",
+	1049: "Budukh",
+	1051: "Buduma",
+	1181: "Budza",
+	995:  "Bugan",
+	1453: "Bugawac",
+	1129: "Bughotu",
+	61:   "Buginese",
+	5811: "Buglere",
+	1117: "Bugun",
+	6779: "Buhi'non Bikol",
+	1222: "Buhid",
+	1523: "Buhutu",
+	5880: "Bukar-Sadung Bidayuh",
+	1477: "Bukat",
+	1143: "Bukharic",
+	1485: "Bukit Malay",
+	1215: "Bukitan",
+	788:  "Bukiyip",
+	6473: "Buksa",
+	1526: "Bukusu",
+	1466: "Bukwen",
+	62:   "Bulgarian",
+	1360: "Bulgarian Sign Language",
+	1267: "Bulgebi",
+	1511: "Buli (Ghana)",
+	1580: "Buli (Indonesia)",
+	1465: "Bullom So",
+	6187: "Bulo Stieng",
+	1454: "Bulu (Cameroon)",
+	1191: "Bulu (Papua New Guinea)",
+	1273: "Bum",
+	1554: "Bumaji",
+	778:  "Bumbita Arapesh",
+	3329: "Bumthangkha",
+	1462: "Bun",
+	1480: "Buna",
+	1024: "Bunaba",
+	1099: "Bunak",
+	1042: "Bunama",
+	1295: "Bundeli",
+	1351: "Bung",
+	1460: "Bungain",
+	1227: "Bungku",
+	7168: "Bungu",
+	1953: "Bunoge Dogon",
+	1290: "Bunun",
+	1232: "Buol",
+	1508: "Bura-Pabir",
+	1557: "Burak",
+	1208: "Buraka",
+	1483: "Burarra",
+	1428: "Burate",
+	1529: "Burduna",
+	1474: "Bure",
+	60:   "Buriat",
+	1188: "Burji",
+	6968: "Burmbar",
+	63:   "Burmese",
+	1584: "Burmeso",
+	4219: "Buru (Indonesia)",
+	1369: "Buru (Nigeria)",
+	1395: "Burui",
+	656:  "Burumakok",
+	1047: "Burun",
+	1057: "Burunge",
+	1406: "Burushaski",
+	1364: "Burusu",
+	843:  "Buruwai",
+	1362: "Busa",
+	1534: "Busam",
+	1408: "Busami",
+	1092: "Busang Kayan",
+	1446: "Bushi",
+	1449: "Bushoong",
+	1410: "Buso",
+	1457: "Busoa",
+	2075: "Bussa",
+	1198: "Busuu",
+	3674: "Butbut Kalinga",
+	1294: "Butmas-Tur",
+	1441: "Butuanon",
+	1152: "Buwal",
+	1562: "Buya",
+	1548: "Buyu",
+	3035: "Buyuan Jinuo",
+	1512: "Bwa",
+	1494: "Bwaidoka",
+	6663: "Bwanabwana",
+	1491: "Bwatoo",
+	1495: "Bwe Karen",
+	1502: "Bwela",
+	1493: "Bwile",
+	1515: "Bwisi",
+	1069: "Byangsi",
+	4279: "Byep",
+	3263: "Bädi Kanum",
+	2084: "C'lela",
+	4476: "Caac",
+	1611: "Cabiyarí",
+	1701: "Cabécar",
+	4243: "Cacaloxtepec Mixtec",
+	1641: "Cacaopera",
+	5765: "Cacgia Roglai",
+	1627: "Cacua",
+	65:   "Caddo",
+	1632: "Cafundo Creole",
+	1616: "Cagua",
+	1596: "Cahuarano",
+	1674: "Cahuilla",
+	5629: "Cajamarca Quechua",
+	5634: "Cajatambo North Lima Quechua",
+	7677: "Cajonos Zapotec",
+	2311: "Cajun French",
+	1715: "Caka",
+	1717: "Cakchiquel-Quiché Mixed Language",
+	1716: "Cakfem-Mushere",
+	6313: "Calamian Tagbanwa",
+	5610: "Calderón Highland Quichua",
+	1607: "Callawalla",
+	1730: "Caluyanun",
+	5747: "Caló",
+	4098: "Cameroon Mambila",
+	7030: "Cameroon Pidgin",
+	5665: "Camling",
+	1737: "Campalagian",
+	6146: "Campidanese Sardinian",
+	3118: "Camsá",
+	1743: "Camtho",
+	7216: "Camunic",
+	1626: "Candoshi-Shapra",
+	5674: "Canela",
+	1610: "Canichana",
+	4297: "Cao Lan",
+	1775: "Cao Miao",
+	3108: "Capanahua",
+	1786: "Capiznon",
+	1783: "Cappadocian Greek",
+	1773: "Caquinte",
+	1604: "Car Nicobarese",
+	1662: "Cara",
+	1629: "Carabayo",
+	1796: "Caramanta",
+	1612: "Carapana",
+	7224: "Carian",
+	2743: "Caribbean Hindustani",
+	3093: "Caribbean Javanese",
+	1613: "Carijona",
+	1806: "Carolina Algonquian",
+	1599: "Carolinian",
+	5734: "Carpathian Romani",
+	1811: "Carrier",
+	1623: "Cashibo-Cacataibo",
+	1624: "Cashinahua",
+	1954: "Casiguran Dumagat Agta",
+	837:  "Casuarina Coast Asmat",
+	68:   "Catalan",
+	1815: "Catalan Sign Language",
+	1669: "Catawba",
+	1630: "Cauca",
+	69:   "Caucasian languages",
+	1606: "Cavineña",
+	1878: "Cayubaba",
+	1609: "Cayuga",
+	7229: "Cayuse",
+	5657: "Cañar Highland Quichua",
+	5893: "Cebaara Senoufo",
+	70:   "Cebuano",
+	7217: "Celtiberian",
+	71:   "Celtic languages",
+	1600: "Cemuhî",
+	1659: "Cen",
+	66:   "Central American Indian languages",
+	1753: "Central Asmat",
+	6770: "Central Atlas Tamazight",
+	931:  "Central Awyu",
+	956:  "Central Aymara",
+	1014: "Central Bai",
+	7698: "Central Berawan",
+	1025: "Central Bikol",
+	3752: "Central Bontok",
+	621:  "Central Cagayan Agta",
+	2109: "Central Dusun",
+	2561: "Central Grebo",
+	7703: "Central Hongshuihe Zhuang",
+	4703: "Central Huasteca Nahuatl",
+	2714: "Central Huishui Hmong",
+	3409: "Central Kanuri",
+	1705: "Central Kurdish",
+	4568: "Central Maewo",
+	5541: "Central Malay",
+	4604: "Central Masela",
+	2724: "Central Mashan Hmong",
+	4052: "Central Mazahua",
+	4138: "Central Melanau",
+	1740: "Central Mnong",
+	4805: "Central Nahuatl",
+	4697: "Central Nicobarese",
+	5149: "Central Ojibwa",
+	5809: "Central Okinawan",
+	5417: "Central Palawano",
+	5305: "Central Pame",
+	5553: "Central Pashto",
+	5491: "Central Pomo",
+	4716: "Central Puebla Nahuatl",
+	6050: "Central Sama",
+	2242: "Central Siberian Yupik",
+	1824: "Central Sierra Miwok",
+	6260: "Central Subanen",
+	6414: "Central Tagbanwa",
+	6295: "Central Tarahumara",
+	6689: "Central Tunebo",
+	2243: "Central Yupik",
+	2330: "Central-Eastern Niger Fulfulde",
+	1660: "Centúúm",
+	1734: "Cerma",
+	5615: "Chachapoyas Quechua",
+	1617: "Chachi",
+	5954: "Chadian Arabic",
+	1653: "Chadian Sign Language",
+	1654: "Chadong",
+	75:   "Chagatai",
+	1692: "Chaima",
+	1706: "Chak",
+	1723: "Chakali",
+	1640: "Chakma",
+	1726: "Chala",
+	1720: "Chaldean Neo-Aramaic",
+	6403: "Chalikha",
+	1657: "Chamacoco",
+	1696: "Chamalal",
+	1645: "Chamari",
+	1646: "Chambeali",
+	1601: "Chambri",
+	86:   "Chamic languages",
+	1631: "Chamicuro",
+	72:   "Chamorro",
+	4675: "Chang Naga",
+	1665: "Changriwa",
+	1744: "Changthang",
+	1678: "Chantyal",
+	1597: "Chané",
+	1792: "Chara",
+	1650: "Chaudangsi",
+	1809: "Chaura",
+	1619: "Chavacano",
+	1625: "Chayahuita",
+	4232: "Chayuco Mixtec",
+	7398: "Chazumba Mixtec",
+	5795: "Che",
+	74:   "Chechen",
+	4448: "Cheke Holo",
+	7219: "Chemakum",
+	1699: "Chenapian",
+	1643: "Chenchu",
+	1755: "Chenoua",
+	1649: "Chepang",
+	7491: "Chepya",
+	1785: "Cherepon",
+	82:   "Cherokee",
+	7488: "Chesu",
+	1836: "Chetco",
+	1875: "Chewong",
+	85:   "Cheyenne",
+	2737: "Chhattisgarhi",
+	1843: "Chhintange",
+	1863: "Chhulung",
+	1816: "Chiangmai Sign Language",
+	1689: "Chiapanec",
+	73:   "Chibcha",
+	6628: "Chicahuaxtla Triqui",
+	313:  "Chichewa",
+	7804: "Chichicapan Zapotec",
+	5341: "Chichimeca-Jonaz",
+	1682: "Chickasaw",
+	1759: "Chicomuceltec",
+	1667: "Chiga",
+	4233: "Chigmecatitlán Mixtec",
+	1719: "Chilcotin",
+	1791: "Chilean Quechua",
+	1819: "Chilean Sign Language",
+	1722: "Chilisso",
+	1814: "Chiltepec Chinantec",
+	7777: "Chimalapa Zoque",
+	1683: "Chimariko",
+	5612: "Chimborazo Highland Quichua",
+	1615: "Chimila",
+	1535: "China Buriat",
+	1685: "Chinali",
+	1745: "Chinbon Chin",
+	5650: "Chincha Quechua",
+	76:   "Chinese",
+	1784: "Chinese Pidgin English",
+	1823: "Chinese Sign Language",
+	1672: "Chinook",
+	79:   "Chinook jargon",
+	1603: "Chipaya",
+	81:   "Chipewyan",
+	1614: "Chipiajes",
+	1691: "Chippewa",
+	4046: "Chiquihuitlán Mazatec",
+	1608: "Chiquitano",
+	5649: "Chiquián Ancash Quechua",
+	4797: "Chiripá",
+	1644: "Chiru",
+	1842: "Chitimacha",
+	1686: "Chitkuli Kinnauri",
+	1839: "Chittagonian",
+	6423: "Chitwania Tharu",
+	7785: "Choapan Zapotec",
+	1668: "Chocangacakha",
+	1779: "Chochotec",
+	80:   "Choctaw",
+	1647: "Chodri",
+	4988: "Chokri Naga",
+	1697: "Chokwe",
+	1848: "Chol",
+	1676: "Cholón",
+	1764: "Chong",
+	1642: "Choni",
+	1765: "Chonyi-Dzihana-Kauma",
+	1633: "Chopi",
+	7223: "Chorasmian",
+	1590: "Chortí",
+	4714: "Chothe Naga",
+	1810: "Chrau",
+	1694: "Chru",
+	1790: "Chuanqiandian Cluster Miao",
+	1703: "Chuave",
+	1869: "Chug",
+	1592: "Chuj",
+	1854: "Chuka",
+	1712: "Chukot",
+	1867: "Chukwa",
+	1731: "Chulym",
+	4715: "Chumburung",
+	1648: "Churahi",
+	83:   "Church Slavic",
+	5853: "Chut",
+	77:   "Chuukese",
+	7227: "Chuvantsy",
+	84:   "Chuvash",
+	1677: "Chuwabu",
+	1602: "Chácobo",
+	1681: "Ci Gbe",
+	1680: "Cia-Cia",
+	1707: "Cibak",
+	919:  "Cicipu",
+	1687: "Cimbrian",
+	1652: "Cinda-Regi-Tiyal",
+	1684: "Cineni",
+	1688: "Cinta Larga",
+	7218: "Cisalpine Gaulish",
+	841:  "Cishingini",
+	6749: "Citak",
+	6401: "Ciwogai",
+	1727: "Clallam",
+	7220: "Classical Armenian",
+	4626: "Classical Mandaic",
+	1735: "Classical Mongolian",
+	4704: "Classical Nahuatl",
+	312:  "Classical Newari",
+	5644: "Classical Quechua",
+	481:  "Classical Syriac",
+	7225: "Classical Tibetan",
+	7228: "Coahuilteco",
+	1821: "Coast Miwok",
+	3707: "Coastal Kadazan",
+	3308: "Coastal Konjo",
+	7702: "Coatecas Altas Zapotec",
+	4672: "Coatepec Nahuatl",
+	4092: "Coatlán Mixe",
+	7801: "Coatlán Zapotec",
+	4247: "Coatzospan Mixtec",
+	1761: "Cocama-Cocamilla",
+	1766: "Cochimi",
+	1760: "Cocopa",
+	1758: "Cocos Islands Malay",
+	1795: "Coeur d'Alene",
+	1770: "Cofán",
+	3437: "Cogui",
+	3859: "Col",
+	1825: "Colombian Sign Language",
+	2390: "Colonia Tovar German",
+	1763: "Colorado",
+	1768: "Columbia-Wenatchi",
+	1639: "Comaltepec Chinantec",
+	1769: "Comanche",
+	7221: "Comecrudo",
+	1663: "Como Karim",
+	1771: "Comox",
+	1752: "Con",
+	6225: "Congo Swahili",
+	1834: "Coos",
+	7776: "Copainalá Zoque",
+	6613: "Copala Triqui",
+	87:   "Coptic",
+	1772: "Coquille",
+	1812: "Cori",
+	88:   "Cornish",
+	5643: "Corongo Ancash Quechua",
+	89:   "Corsican",
+	1828: "Costa Rican Sign Language",
+	4485: "Cotabato Manobo",
+	7222: "Cotoname",
+	1776: "Cowlitz",
+	3449: "Coxima",
+	1778: "Coyaima",
+	5296: "Coyotepec Popoloca",
+	6559: "Coyutla Totonac",
+	93:   "Cree",
+	286:  "Creek",
+	95:   "Creoles and pidgins",
+	90:   "Creoles and pidgins, English based",
+	91:   "Creoles and pidgins, French-based",
+	92:   "Creoles and pidgins, Portuguese-based",
+	94:   "Crimean Tatar",
+	1827: "Croatia Sign Language",
+	366:  "Croatian",
+	4164: "Cross River Mbembe",
+	1804: "Crow",
+	1813: "Cruzeño",
+	1850: "Cua",
+	1818: "Cuba Sign Language",
+	1851: "Cubeo",
+	1855: "Cuiba",
+	1858: "Culina",
+	1860: "Cumanagoto",
+	7215: "Cumbric",
+	1859: "Cumeral",
+	1862: "Cun",
+	7303: "Cuneiform Luwian",
+	1853: "Cung",
+	1861: "Cupeño",
+	7226: "Curonian",
+	3454: "Curripaco",
+	5627: "Cusco Quechua",
+	97:   "Cushitic languages",
+	1637: "Cutchi-Swahili",
+	1866: "Cuvok",
+	7414: "Cuyamecalco Mixtec",
+	1879: "Cuyonon",
+	1514: "Cwi Bwamu",
+	552:  "Cypriot Arabic",
+	98:   "Czech",
+	1817: "Czech Sign Language",
+	1746: "Côông",
+	3704: "Da'a Kaili",
+	1896: "Daai Chin",
+	3931: "Daantanai'",
+	2095: "Daasanach",
+	1917: "Daba",
+	1918: "Dabarre",
+	1907: "Dabe",
+	7230: "Dacian",
+	1926: "Dadi Dadi",
+	4403: "Dadibi",
+	1906: "Dadiya",
+	1969: "Daga",
+	1955: "Dagaari Dioula",
+	1960: "Dagba",
+	1890: "Dagbani",
+	1937: "Dagik",
+	1962: "Dagoman",
+	1894: "Dahalo",
+	1898: "Daho-Doo",
+	1990: "Dai",
+	7714: "Dai Zhuang",
+	2079: "Dair",
+	1325: "Dakaka",
+	2022: "Dakka",
+	99:   "Dakota",
+	2021: "Dakpakha",
+	2027: "Dalmatian",
+	1081: "Daloa Bété",
+	2037: "Dama",
+	1895: "Damakawa",
+	6798: "Damal",
+	1886: "Dambi",
+	2036: "Dameli",
+	2040: "Dampelas",
+	1889: "Dan",
+	2052: "Danaru",
+	2054: "Danau",
+	1897: "Dandami Maria",
+	1885: "Dangaléat",
+	6428: "Dangaura Tharu",
+	100:  "Danish",
+	2097: "Danish Sign Language",
+	848:  "Dano",
+	2055: "Danu",
+	1903: "Dao",
+	2046: "Daonda",
+	2007: "Dar Daju Daju",
+	1893: "Dar Fur Daju",
+	1899: "Dar Sila Daju",
+	2093: "Darai",
+	101:  "Dargwa",
+	5531: "Dari",
+	2028: "Darlong",
+	2081: "Darmiya",
+	2087: "Daro-Matu Melanau",
+	2072: "Dass",
+	6330: "Datooga",
+	1967: "Daungwurrung",
+	2101: "Daur",
+	1901: "Davawenyo",
+	2142: "Dawawa",
+	1936: "Dawera-Daweloor",
+	2140: "Dawro",
+	1892: "Day",
+	1902: "Dayi",
+	2153: "Daza",
+	2154: "Dazaga",
+	1924: "Deccan",
+	1938: "Dedua",
+	596:  "Defaka",
+	2366: "Defi Gbe",
+	4648: "Deg",
+	1966: "Degaru",
+	1941: "Degema",
+	1956: "Degenan",
+	2901: "Degexit'an",
+	1980: "Dehu",
+	1942: "Dehwari",
+	1944: "Dek",
+	5775: "Dela-Oenale",
+	103:  "Delaware",
+	5028: "Delo",
+	1945: "Dem",
+	2043: "Dema",
+	1943: "Demisa",
+	2044: "Demta",
+	1932: "Dendi (Benin)",
+	1947: "Dendi (Central African Republic)",
+	1951: "Dengese",
+	2050: "Dengka",
+	1905: "Deno",
+	760:  "Denya",
+	2057: "Dení",
+	1948: "Deori",
+	3132: "Dera (Indonesia)",
+	3407: "Dera (Nigeria)",
+	1949: "Desano",
+	2099: "Desiya",
+	1939: "Dewoin",
+	1940: "Dezfuli",
+	1958: "Dghwede",
+	1978: "Dhaiso",
+	1973: "Dhalandji",
+	1971: "Dhangu",
+	1975: "Dhanki",
+	1981: "Dhanwar (Nepal)",
+	4764: "Dhao",
+	1977: "Dhargari",
+	4277: "Dhatki",
+	1972: "Dhimal",
+	1976: "Dhodia",
+	557:  "Dhofari Arabic",
+	1934: "Dhudhuroa",
+	1970: "Dhundari",
+	1979: "Dhurga",
+	2123: "Dhuwal",
+	1982: "Dia",
+	4056: "Dibabawon Manobo",
+	1923: "Dibiyaso",
+	1994: "Dibo",
+	1488: "Dibole",
+	2136: "Dicamay Agta",
+	1985: "Didinga",
+	1933: "Dido",
+	1986: "Dieri",
+	4221: "Digaro-Mishmi",
+	1987: "Digo",
+	2130: "Dii",
+	1661: "Dijim-Bwilim",
+	1992: "Dilling",
+	3044: "Dima",
+	1998: "Dimasa",
+	1989: "Dimbong",
+	1993: "Dime",
+	2031: "Dimir",
+	1996: "Dimli (individual language)",
+	2004: "Ding",
+	106:  "Dinka",
+	1999: "Dirari",
+	2402: "Dirasha",
+	2139: "Diri",
+	2000: "Diriku",
+	1997: "Dirim",
+	2096: "Disa",
+	6327: "Ditammari",
+	2103: "Ditidaht",
+	2003: "Diuwe",
+	7400: "Diuxi-Tilantongo Mixtec",
+	107:  "Divehi",
+	2002: "Dixon Reef",
+	4124: "Dizin",
+	2005: "Djadjawurrung",
+	2018: "Djambarrpuyngu",
+	2008: "Djamindjung",
+	2010: "Djangun",
+	2016: "Djauan",
+	2020: "Djawi",
+	2012: "Djeebbana",
+	2147: "Djimini Senoufo",
+	2011: "Djinang",
+	2006: "Djinba",
+	3025: "Djingili",
+	2014: "Djiwarli",
+	3610: "Dobel",
+	2059: "Dobu",
+	2061: "Doe",
+	1957: "Doga",
+	1968: "Doghoro",
+	1964: "Dogoso",
+	2071: "Dogosé",
+	108:  "Dogri",
+	1963: "Dogri (individual language)",
+	105:  "Dogrib",
+	1909: "Dogul Dom Dogon",
+	1910: "Doka",
+	6887: "Doko-Uyanga",
+	2026: "Dolgan",
+	2082: "Dolpo",
+	2058: "Dom",
+	2035: "Domaaki",
+	5749: "Domari",
+	2073: "Dombe",
+	2069: "Dominican Sign Language",
+	2076: "Dompo",
+	2062: "Domu",
+	1950: "Domung",
+	2064: "Dondo",
+	2063: "Dong",
+	2067: "Dongo",
+	1927: "Dongotono",
+	7524: "Dongshanba Lalo",
+	5854: "Dongxiang",
+	1935: "Donno So Dogon",
+	1928: "Doondo",
+	2070: "Dori'o",
+	3478: "Doromu-Koki",
+	2089: "Dororo",
+	2077: "Dorze",
+	2065: "Doso",
+	2114: "Dotyali",
+	6367: "Doutai",
+	2074: "Doyayo",
+	109:  "Dravidian languages",
+	2091: "Drents",
+	2132: "Drung",
+	111:  "Duala",
+	2128: "Duano",
+	2138: "Duau",
+	2115: "Dubli",
+	2041: "Dubu",
+	4735: "Dugun",
+	1913: "Duguri",
+	2033: "Dugwor",
+	3136: "Duhwa",
+	4860: "Duke",
+	1915: "Dulbu",
+	2137: "Duli",
+	2029: "Duma",
+	2119: "Dumbea",
+	2131: "Dumi",
+	2042: "Dumpas",
+	2122: "Dumun",
+	2116: "Duna",
+	2048: "Dungan",
+	5664: "Dungmali",
+	2121: "Dungra Bhil",
+	1921: "Dungu",
+	2127: "Dupaninan Agta",
+	2088: "Dura",
+	4544: "Duri",
+	1914: "Duriankere",
+	2120: "Duruma",
+	5318: "Duruwa",
+	2098: "Dusner",
+	2126: "Dusun Deyah",
+	2129: "Dusun Malang",
+	2134: "Dusun Witu",
+	113:  "Dutch",
+	2094: "Dutch Sign Language",
+	112:  "Dutch, Middle (ca.1050-1350)",
+	2141: "Dutton World Speedwords",
+	2135: "Duungooma",
+	1888: "Duupa",
+	2133: "Duvle",
+	1916: "Duwai",
+	2602: "Duwet",
+	3776: "Dũya",
+	4941: "Dwang",
+	2151: "Dyaabugay",
+	2144: "Dyaberdyaber",
+	2143: "Dyan",
+	2149: "Dyangadi",
+	1912: "Dyirbal",
+	2145: "Dyugun",
+	114:  "Dyula",
+	3010: "Dza",
+	2155: "Dzalakha",
+	2156: "Dzando",
+	1335: "Dzao Min",
+	555:  "Dzodinka",
+	115:  "Dzongkha",
+	2051: "Dzùùngoo",
+	3622: "Dâw",
+	2165: "E",
+	7672: "E'ma Buyang",
+	5298: "E'ñapa Woromaipu",
+	7411: "Early Tripuri",
+	5180: "East Ambae",
+	7699: "East Berawan",
+	2039: "East Damar",
+	2322: "East Futuna",
+	3324: "East Kewa",
+	3902: "East Limba",
+	4293: "East Makian",
+	6942: "East Masela",
+	4882: "East Nyala",
+	6615: "East Tarangan",
+	7656: "East Yugur",
+	502:  "Eastern Abnaki",
+	543:  "Eastern Acipa",
+	5630: "Eastern Apurímac Quechua",
+	584:  "Eastern Arrernte",
+	1125: "Eastern Balochi",
+	2584: "Eastern Bolivian Guaraní",
+	2158: "Eastern Bontok",
+	1391: "Eastern Bru",
+	2870: "Eastern Canadian Inuktitut",
+	1698: "Eastern Cham",
+	964:  "Eastern Durango Nahuatl",
+	910:  "Eastern Egyptian Bedawi Arabic",
+	6402: "Eastern Gorkha Tamang",
+	2436: "Eastern Gurung",
+	1732: "Eastern Highland Chatino",
+	5251: "Eastern Highland Otomi",
+	7707: "Eastern Hongshuihe Zhuang",
+	4798: "Eastern Huasteca Nahuatl",
+	2716: "Eastern Huishui Hmong",
+	7369: "Eastern Karaboro",
+	3569: "Eastern Katu",
+	2185: "Eastern Kayah",
+	3189: "Eastern Keres",
+	1658: "Eastern Khumi Chin",
+	3490: "Eastern Krahn",
+	7532: "Eastern Lalu",
+	4028: "Eastern Lawa",
+	4191: "Eastern Magar",
+	2198: "Eastern Maninkakan",
+	4218: "Eastern Mari",
+	2013: "Eastern Maroon Creole",
+	2196: "Eastern Meohang",
+	4348: "Eastern Mnong",
+	2204: "Eastern Muria",
+	4741: "Eastern Ngad'a",
+	4959: "Eastern Nisu",
+	5150: "Eastern Ojibwa",
+	2648: "Eastern Oromo",
+	3288: "Eastern Parbate Kham",
+	5352: "Eastern Penan",
+	5335: "Eastern Pomo",
+	2726: "Eastern Qiandong Miao",
+	5912: "Eastern Subanen",
+	6288: "Eastern Tamang",
+	1286: "Eastern Tawbuid",
+	4523: "Eastern Xiangxi Miao",
+	2380: "Eastern Xwla Gbe",
+	7492: "Eastern Yiddish",
+	2852: "Ebira",
+	7233: "Eblan",
+	2160: "Ebrié",
+	2157: "Ebughu",
+	2163: "Ecuadorian Sign Language",
+	1618: "Ede Cabe",
+	2830: "Ede Ica",
+	2837: "Ede Idaca",
+	2867: "Ede Ije",
+	935:  "Edera Awyu",
+	2249: "Edolo",
+	7231: "Edomite",
+	1908: "Edopi",
+	2166: "Efai",
+	2167: "Efe",
+	116:  "Efik",
+	5137: "Efutop",
+	2168: "Ega",
+	2170: "Eggon",
+	2237: "Egypt Sign Language",
+	117:  "Egyptian (Ancient)",
+	834:  "Egyptian Arabic",
+	2171: "Ehueun",
+	2172: "Eipomek",
+	2173: "Eitiep",
+	2252: "Ejagham",
+	2175: "Ejamat",
+	118:  "Ekajuk",
+	2177: "Ekari",
+	2178: "Eki",
+	2176: "Ekit",
+	2183: "Ekpeye",
+	7798: "El Alto Zapotec",
+	2187: "El Hugeirat",
+	2191: "El Molo",
+	1803: "El Nayar Cora",
+	119:  "Elamite",
+	2190: "Eleme",
+	2186: "Elepi",
+	2181: "Elip",
+	2189: "Elkei",
+	7822: "Elotepec Zapotec",
+	597:  "Eloyi",
+	4441: "Elseng",
+	2192: "Elu",
+	7304: "Elymian",
+	4339: "Emae",
+	2193: "Emai-Iuleha-Ora",
+	2200: "Eman",
+	2194: "Embaloh",
+	1041: "Emberá-Baudó",
+	1844: "Emberá-Catío",
+	1736: "Emberá-Chamí",
+	6353: "Emberá-Tadó",
+	2161: "Embu",
+	2195: "Emerillon",
+	2169: "Emilian",
+	2201: "Emok",
+	2205: "Emplawas",
+	2217: "Emumu",
+	2210: "En",
+	6838: "Enawené-Nawé",
+	2211: "Ende",
+	2216: "Enga",
+	4785: "Engdewu",
+	2214: "Engenni",
+	2215: "Enggano",
+	120:  "English",
+	121:  "English, Middle (1100-1500)",
+	16:   "English, Old (ca.450-1100)",
+	5564: "Enrekang",
+	2218: "Enu",
+	2220: "Enwan (Akwa Ibom State)",
+	2219: "Enwan (Edu State)",
+	2425: "Enya",
+	5981: "Epena",
+	7238: "Epi-Olmec",
+	2222: "Epie",
+	2207: "Epigraphic Mayan",
+	2223: "Eravallan",
+	3328: "Erave",
+	6728: "Ere",
+	2231: "Eritai",
+	2232: "Erokwanas",
+	2229: "Erre",
+	2206: "Erromintxela",
+	2230: "Ersu",
+	2225: "Eruwa",
+	290:  "Erzya",
+	2931: "Esan",
+	4094: "Ese",
+	2233: "Ese Ejja",
+	2234: "Eshtehardi",
+	620:  "Esimbi",
+	122:  "Esperanto",
+	2241: "Esselen",
+	5255: "Estado de México Otomi",
+	123:  "Estonian",
+	2240: "Estonian Sign Language",
+	2238: "Esuma",
+	2245: "Etchemin",
+	2244: "Etebi",
+	2253: "Eten",
+	2162: "Eteocretan",
+	2164: "Eteocypriot",
+	2246: "Ethiopian Sign Language",
+	2831: "Etkywan",
+	2248: "Eton (Cameroon)",
+	2247: "Eton (Vanuatu)",
+	2251: "Etruscan",
+	6877: "Etulo",
+	1589: "Evant",
+	2255: "Even",
+	2257: "Evenki",
+	4961: "Ewage-Notu",
+	124:  "Ewe",
+	125:  "Ewondo",
+	2258: "Extremaduran",
+	2259: "Eyak",
+	2263: "Fa D'ambu",
+	2265: "Fagani",
+	970:  "Faire Atta",
+	2269: "Faita",
+	2268: "Faiwol",
+	2276: "Fala",
+	1664: "Falam Chin",
+	2295: "Fali",
+	7243: "Faliscan",
+	2272: "Fam",
+	2302: "Fanagalo",
+	126:  "Fang",
+	2270: "Fang (Cameroon)",
+	2303: "Fania",
+	128:  "Fanti",
+	2301: "Far Western Muria",
+	2592: "Farefare",
+	127:  "Faroese",
+	2310: "Fas",
+	2262: "Fasu",
+	2274: "Fataleka",
+	1929: "Fataluku",
+	2275: "Fayu",
+	2300: "Fe'fe'",
+	614:  "Fembe",
+	2309: "Fernando Po Creole English",
+	2281: "Feroge",
+	2691: "Fiji Hindi",
+	129:  "Fijian",
+	130:  "Filipino",
+	6501: "Filomena Mata-Coahuitlán Totonac",
+	2319: "Finland-Swedish Sign Language",
+	131:  "Finnish",
+	2317: "Finnish Sign Language",
+	132:  "Finno-Ugrian languages",
+	2266: "Finongan",
+	2287: "Fipa",
+	2288: "Firan",
+	2290: "Fiwaga",
+	2297: "Flinders Island",
+	2294: "Foau",
+	2305: "Foi",
+	2282: "Foia Foia",
+	5507: "Folopa",
+	2306: "Foma",
+	133:  "Fon",
+	2284: "Fongoro",
+	2304: "Foodo",
+	2315: "Forak",
+	2312: "Fordata",
+	2307: "Fore",
+	2212: "Forest Enets",
+	4619: "Forest Maninka",
+	2316: "Fortsenal",
+	7782: "Francisco León Zoque",
+	2313: "Frankish",
+	134:  "French",
+	2318: "French Sign Language",
+	135:  "French, Middle (ca.1400-1600)",
+	136:  "French, Old (842-ca.1400)",
+	485:  "Frisian, Eastern",
+	484:  "Frisian, Northern",
+	137:  "Frisian, Western",
+	139:  "Friulian",
+	138:  "Fulah",
+	2298: "Fuliiru",
+	2329: "Fulniô",
+	2328: "Fum",
+	6812: "Fungwa",
+	2335: "Fur",
+	2332: "Furu",
+	2331: "Futuna-Aniwa",
+	2334: "Fuyug",
+	2337: "Fwe",
+	2336: "Fwâi",
+	5600: "Fyam",
+	2286: "Fyer",
+	140:  "Ga",
+	2543: "Ga'anda",
+	2397: "Ga'dang",
+	6660: "Gaa",
+	6311: "Gaam",
+	2338: "Gabri",
+	7246: "Gabrielino-Fernandeño",
+	2401: "Gadang",
+	2340: "Gaddang",
+	2369: "Gaddi",
+	2414: "Gade",
+	2391: "Gade Lohar",
+	2398: "Gadjerawang",
+	2346: "Gadsup",
+	2427: "Gafat",
+	2378: "Gagadu",
+	2343: "Gagauz",
+	1426: "Gagnoa Bété",
+	2440: "Gagu",
+	1106: "Gahri",
+	2364: "Gaikundi",
+	2455: "Gail",
+	2388: "Gaina",
+	2352: "Gal",
+	2485: "Galambu",
+	7244: "Galatian",
+	2367: "Galela",
+	2354: "Galeya",
+	67:   "Galibi Carib",
+	2385: "Galice",
+	150:  "Galician",
+	7247: "Galindan",
+	5879: "Gallurese Sardinian",
+	562:  "Galo",
+	2348: "Galoli",
+	3245: "Gamale Kham",
+	2490: "Gambera",
+	7118: "Gambian Wolof",
+	3359: "Gamilaraay",
+	2370: "Gamit",
+	2347: "Gamkonora",
+	2497: "Gamo",
+	1424: "Gamo-Ningi",
+	2350: "Gan Chinese",
+	2513: "Gana",
+	2504: "Ganang",
+	249:  "Ganda",
+	2643: "Gane",
+	2384: "Ganggalida",
+	2435: "Ganglau",
+	2501: "Gangte",
+	2509: "Gangulu",
+	2351: "Gants",
+	2641: "Ganza",
+	2518: "Ganzi",
+	2429: "Gao",
+	5592: "Gapapaiwa",
+	2371: "Garhwali",
+	1591: "Garifuna",
+	2883: "Garig-Ilgar",
+	2559: "Garo",
+	2424: "Garre",
+	7136: "Garrwa",
+	2630: "Garus",
+	7248: "Garza",
+	2353: "Gata'",
+	2535: "Gavar",
+	2608: "Gavião Do Jiparaná",
+	2624: "Gawar-Bati",
+	2616: "Gawwada",
+	2636: "Gayil",
+	141:  "Gayo",
+	2642: "Gazi",
+	2376: "Gbagyi",
+	2379: "Gbanu",
+	2365: "Gbanziri",
+	2381: "Gbari",
+	2575: "Gbati-ri",
+	142:  "Gbaya",
+	3515: "Gbaya (Sudan)",
+	2374: "Gbaya-Bossangoa",
+	2375: "Gbaya-Bozoum",
+	2494: "Gbaya-Mbodomo",
+	2634: "Gbayi",
+	2377: "Gbesi Gbe",
+	2430: "Gbii",
+	7245: "Gbin",
+	2551: "Gbiri-Niragu",
+	2413: "Gboloo Grebo",
+	2721: "Ge",
+	3612: "Geba Karen",
+	2417: "Gebe",
+	2394: "Gedaged",
+	2090: "Gedeo",
+	146:  "Geez",
+	2470: "Geji",
+	2446: "Geko Karen",
+	4883: "Gela",
+	2421: "Geme",
+	2418: "Gen",
+	2342: "Gende",
+	2415: "Gengle",
+	144:  "Georgian",
+	7512: "Gepo",
+	2423: "Gera",
+	145:  "German",
+	2567: "German Sign Language",
+	299:  "German, Low",
+	152:  "German, Middle High (ca.1050-1500)",
+	153:  "German, Old High (ca.750-1050)",
+	476:  "German, Swiss",
+	143:  "Germanic languages",
+	2411: "Geruma",
+	2422: "Geser-Gorom",
+	2596: "Gey",
+	2442: "Ghadamès",
+	2541: "Ghanaian Pidgin English",
+	2566: "Ghanaian Sign Language",
+	2408: "Ghandruk Sign Language",
+	2448: "Ghanongga",
+	2552: "Ghari",
+	1262: "Ghayavi",
+	706:  "Gheg Albanian",
+	2450: "Ghera",
+	2405: "Ghodoberi",
+	2449: "Ghomara",
+	997:  "Ghomálá'",
+	487:  "Ghotuo",
+	2447: "Ghulfan",
+	1118: "Giangan",
+	2454: "Gibanawa",
+	2456: "Gidar",
+	3348: "Giiwo",
+	536:  "Gikyode",
+	2484: "Gilaki",
+	147:  "Gilbertese",
+	2467: "Gilima",
+	4834: "Gilyak",
+	2458: "Gimi (Eastern Highlands)",
+	2460: "Gimi (West New Britain)",
+	3396: "Gimme",
+	2495: "Gimnime",
+	2510: "Ginuman",
+	947:  "Ginyanga",
+	1005: "Girawa",
+	5090: "Giryama",
+	6563: "Gitonga",
+	2439: "Gitua",
+	2464: "Gitxsan",
+	2468: "Giyug",
+	6562: "Gizrra",
+	2486: "Glaro-Twabo",
+	2488: "Glavda",
+	5263: "Glio-Oubi",
+	2516: "Gnau",
+	2530: "Goan Konkani",
+	2457: "Goaria",
+	2526: "Gobasi",
+	2537: "Gobu",
+	2522: "Godié",
+	2410: "Godwari",
+	750:  "Goemai",
+	2524: "Gofa",
+	2525: "Gogo",
+	2441: "Gogodala",
+	2476: "Gokana",
+	2529: "Gola",
+	2603: "Golin",
+	154:  "Gondi",
+	2531: "Gone Dau",
+	2523: "Gongduk",
+	2472: "Gonja",
+	2507: "Gooniyandi",
+	2546: "Gor",
+	2521: "Gorakor",
+	2533: "Gorap",
+	155:  "Gorontalo",
+	2556: "Gorovu",
+	2536: "Gorowa",
+	156:  "Gothic",
+	2538: "Goundo",
+	2598: "Gourmanchéma",
+	2527: "Gowlan",
+	2528: "Gowli",
+	2618: "Gowro",
+	2539: "Gozarkhani",
+	4884: "Grangali",
+	3121: "Grass Koiari",
+	157:  "Grebo",
+	159:  "Greek",
+	2573: "Greek Sign Language",
+	158:  "Greek, Ancient",
+	2461: "Green Gelao",
+	204:  "Greenlandic",
+	2387: "Grenadian Creole English",
+	2558: "Gresi",
+	2555: "Groma",
+	2534: "Gronings",
+	875:  "Gros Ventre",
+	2627: "Gua",
+	2386: "Guadeloupean Creole French",
+	2583: "Guahibo",
+	2577: "Guajajára",
+	2604: "Guajá",
+	2587: "Guambiano",
+	2545: "Guana (Brazil)",
+	2600: "Guana (Paraguay)",
+	2601: "Guanano",
+	2502: "Guanche",
+	3031: "Guanyinqiao",
+	160:  "Guarani",
+	2639: "Guarayu",
+	2341: "Guarequena",
+	2569: "Guatemalan Sign Language",
+	2574: "Guató",
+	2589: "Guayabero",
+	4843: "Gudanji",
+	2395: "Gude",
+	2409: "Gudu",
+	2396: "Guduf-Gava",
+	735:  "Guerrero Amuzgo",
+	4788: "Guerrero Nahuatl",
+	7789: "Guevea De Humboldt Zapotec",
+	2431: "Gugadj",
+	2393: "Gugu Badhun",
+	7146: "Gugu Warra",
+	3345: "Gugubera",
+	3354: "Guguyimidjir",
+	2451: "Guhu-Samane",
+	2389: "Guianese Creole French",
+	7709: "Guibei Zhuang",
+	1079: "Guiberoua Béte",
+	7711: "Guibian Zhuang",
+	2478: "Guinea Kpelle",
+	2593: "Guinean Sign Language",
+	2544: "Guiqiong",
+	161:  "Gujarati",
+	2473: "Gujari",
+	3149: "Gula (Central African Republic)",
+	2487: "Gula (Chad)",
+	2483: "Gula Iro",
+	2491: "Gula'alaa",
+	2605: "Gulay",
+	2489: "Gule",
+	590:  "Gulf Arabic",
+	2482: "Guliguli",
+	2496: "Gumalu",
+	2511: "Gumatj",
+	2611: "Gumawana",
+	2585: "Gumuz",
+	2597: "Gun",
+	2399: "Gundi",
+	2633: "Gungabula",
+	5788: "Gungu",
+	2515: "Guntai",
+	2590: "Gunwinggu",
+	2640: "Gunya",
+	2540: "Gupa-Abawa",
+	2581: "Gupapuyngu",
+	2432: "Guragone",
+	2565: "Guramalum",
+	2646: "Gurani",
+	2400: "Gurdjar",
+	2514: "Gureng Gureng",
+	2433: "Gurgula",
+	2563: "Guriaso",
+	2580: "Gurinji",
+	2606: "Gurmana",
+	2519: "Guro",
+	2549: "Guruntum-Mbaaru",
+	2570: "Gusan",
+	2599: "Gusii",
+	2568: "Gusilay",
+	2625: "Guwamu",
+	2474: "Guya",
+	2638: "Guyanese Creole English",
+	2612: "Guyani",
+	4786: "Gvoko",
+	2614: "Gwa",
+	1891: "Gwahatike",
+	3016: "Gwak",
+	1111: "Gwamhi-Wuri",
+	2622: "Gwandara",
+	2562: "Gweda",
+	2617: "Gweno",
+	2623: "Gwere",
+	162:  "Gwich'in",
+	2635: "Gyele",
+	2632: "Gyem",
+	7831: "Güilá Zapotec",
+	2659: "Ha",
+	2671: "Habu",
+	2676: "Hadiyya",
+	2752: "Hadothi",
+	7252: "Hadrami",
+	948:  "Hadrami Arabic",
+	2781: "Hadza",
+	579:  "Haeke",
+	2651: "Hahon",
+	2683: "Hai//om",
+	163:  "Haida",
+	2684: "Haigwai",
+	2649: "Haiphong Sign Language",
+	2661: "Haisla",
+	164:  "Haitian Creole",
+	2807: "Haitian Vodoun Culture Language",
+	2701: "Haji",
+	2652: "Hajong",
+	1748: "Haka Chin",
+	2653: "Hakka Chinese",
+	2657: "Hakö",
+	2654: "Halang",
+	2708: "Halang Doan",
+	2707: "Halbi",
+	3269: "Halh Mongolian",
+	2706: "Halia",
+	2798: "Halkomelem",
+	2730: "Hamap",
+	2666: "Hamba",
+	721:  "Hamer-Banna",
+	2729: "Hamtai",
+	2644: "Han",
+	2650: "Hanga",
+	7126: "Hanga Hundi",
+	2656: "Hangaza",
+	2739: "Hani",
+	3913: "Hano",
+	2645: "Hanoi Sign Language",
+	2741: "Hanunoo",
+	7250: "Harami",
+	2660: "Harari",
+	3320: "Harijan Kinnauri",
+	2770: "Haroi",
+	2778: "Harsusi",
+	6513: "Haruai",
+	2768: "Haruku",
+	1113: "Haryanvi",
+	2774: "Harzani",
+	7480: "Hasha",
+	4149: "Hassaniyya",
+	2647: "Hatam",
+	7255: "Hattic",
+	165:  "Hausa",
+	2776: "Hausa Sign Language",
+	7641: "Havasupai-Walapai-Yavapai",
+	2809: "Haveke",
+	2662: "Havu",
+	2813: "Hawai'i Creole English",
+	2765: "Hawai'i Pidgin Sign Language",
+	166:  "Hawaiian",
+	2664: "Haya",
+	2665: "Hazaragi",
+	7234: "Hdi",
+	167:  "Hebrew",
+	2680: "Hehe",
+	2668: "Heiban",
+	2681: "Heiltsuk",
+	5861: "Helambu Sherpa",
+	2679: "Helong",
+	4836: "Hema",
+	2682: "Hemba",
+	2678: "Herdé",
+	168:  "Herero",
+	3887: "Hermit",
+	7254: "Hernican",
+	2655: "Hewa",
+	892:  "Heyo",
+	2443: "Hiberno-Scottish Gaelic",
+	2689: "Hibito",
+	2690: "Hidatsa",
+	2711: "Hieroglyphic Luwian",
+	4053: "Higaonon",
+	3316: "Highland Konjo",
+	1670: "Highland Oaxaca Chontal",
+	5488: "Highland Popoluca",
+	971:  "Highland Puebla Nahuatl",
+	6572: "Highland Totonac",
+	550:  "Hijazi Arabic",
+	2695: "Hijuk",
+	169:  "Hiligaynon",
+	170:  "Himachali languages",
+	2698: "Himarimã",
+	171:  "Hindi",
+	2694: "Hinduri",
+	2459: "Hinukh",
+	174:  "Hiri Motu",
+	172:  "Hittite",
+	2782: "Hitu",
+	2699: "Hiw",
+	2700: "Hixkaryána",
+	3842: "Hlai",
+	7517: "Hlepho Phowa",
+	2709: "Hlersu",
+	2727: "Hmar",
+	173:  "Hmong",
+	4575: "Hmong Daw",
+	2717: "Hmong Don",
+	2731: "Hmong Dô",
+	2740: "Hmong Njua",
+	2734: "Hmong Shua",
+	4445: "Hmwaveke",
+	2747: "Ho",
+	2758: "Ho Chi Minh City Sign Language",
+	7057: "Ho-Chunk",
+	2745: "Hoava",
+	2750: "Hobyót",
+	2685: "Hoia Hoia",
+	2751: "Holikachuk",
+	2762: "Holiya",
+	2748: "Holma",
+	2755: "Holoholo",
+	2753: "Holu",
+	2754: "Homa",
+	2675: "Honduras Sign Language",
+	2705: "Hong Kong Sign Language",
+	2761: "Honi",
+	2756: "Hopi",
+	2769: "Horned Miao",
+	2757: "Horo",
+	2749: "Horom",
+	2228: "Horpa",
+	2759: "Hote",
+	2779: "Hoti",
+	2760: "Hovongan",
+	2687: "Hoyahoya",
+	2763: "Hozo",
+	2764: "Hpon",
+	2766: "Hrangkhol",
+	2767: "Hre",
+	2772: "Hruso",
+	2796: "Hu",
+	2789: "Huachipaeri",
+	5608: "Huallaga Huánuco Quechua",
+	5631: "Huamalíes-Dos de Mayo Huánuco Quechua",
+	2784: "Huambisa",
+	6901: "Huarijio",
+	2799: "Huastec",
+	2786: "Huaulu",
+	4048: "Huautla Mazatec",
+	4808: "Huaxcaleca Nahuatl",
+	5645: "Huaylas Ancash Quechua",
+	5640: "Huaylla Wanca Quechua",
+	2667: "Huba",
+	6377: "Huehuetla Tepehua",
+	2673: "Huichol",
+	2790: "Huilliche",
+	4597: "Huitepec Mixtec",
+	1880: "Huizhou Chinese",
+	2803: "Hukumina",
+	2794: "Hula",
+	2805: "Hulaulá",
+	2791: "Huli",
+	2793: "Hulung",
+	2713: "Humburi Senni Songhay",
+	2788: "Humene",
+	2800: "Humla",
+	2117: "Hun-Saare",
+	2703: "Hunde",
+	2744: "Hung",
+	2795: "Hungana",
+	176:  "Hungarian",
+	2775: "Hungarian Sign Language",
+	4668: "Hungworo",
+	2704: "Hunjara-Kaina Ke",
+	7251: "Hunnic",
+	2773: "Hunsrik",
+	2806: "Hunzib",
+	177:  "Hupa",
+	3085: "Hupdë",
+	2658: "Hupla",
+	7256: "Hurrian",
+	2416: "Hutterite German",
+	2814: "Hwana",
+	2815: "Hya",
+	2971: "Hyam",
+	2771: "Hértevin",
+	3078: "Hõne",
+	2958: "I-Wak",
+	2816: "Iaai",
+	7570: "Iamalele",
+	2818: "Iapama",
+	2817: "Iatmul",
+	6529: "Iau",
+	6382: "Ibali Teke",
+	2824: "Ibaloi",
+	178:  "Iban",
+	2823: "Ibanag",
+	2829: "Ibani",
+	2956: "Ibatan",
+	7259: "Iberian",
+	2820: "Ibibio",
+	2826: "Ibino",
+	2828: "Ibu",
+	2827: "Ibuoro",
+	180:  "Icelandic",
+	2832: "Icelandic Sign Language",
+	1067: "Iceve-Maci",
+	1911: "Ida'an",
+	2834: "Idakho-Isukha-Tiriki",
+	2842: "Idaté",
+	2838: "Idere",
+	2841: "Idesa",
+	2839: "Idi",
+	181:  "Ido",
+	2843: "Idoma",
+	2836: "Idon",
+	1725: "Idu-Mishmi",
+	6925: "Iduna",
+	2847: "Ifo",
+	2846: "Ifè",
+	2855: "Igala",
+	2854: "Igana",
+	179:  "Igbo",
+	2853: "Igede",
+	2857: "Ignaciano",
+	634:  "Igo",
+	4666: "Iguta",
+	2860: "Igwe",
+	2863: "Iha",
+	2861: "Iha Based Pidgin",
+	2862: "Ihievbe",
+	6927: "Ija-Zuba",
+	183:  "Ijo languages",
+	2879: "Ik",
+	2872: "Ika",
+	2880: "Ikizu",
+	2871: "Iko",
+	4128: "Ikobi",
+	5024: "Ikoma-Nata-Isenye",
+	6742: "Ikpeng",
+	2875: "Ikpeshi",
+	3465: "Ikposo",
+	2877: "Iku-Gora-Ankwa",
+	2873: "Ikulu",
+	2878: "Ikwere",
+	2882: "Ila",
+	2881: "Ile Ape",
+	2884: "Ili Turki",
+	2888: "Ili'uun",
+	4060: "Ilianen Manobo",
+	7261: "Illyrian",
+	186:  "Iloko",
+	2885: "Ilongot",
+	2889: "Ilue",
+	4304: "Ilwana",
+	5632: "Imbabura Highland Quichua",
+	2896: "Imbongu",
+	2892: "Imeraguen",
+	2895: "Imonda",
+	2897: "Imroing",
+	531:  "Inabaknon",
+	4646: "Inapang",
+	2908: "Indian Sign Language",
+	188:  "Indic languages",
+	190:  "Indo-European languages",
+	2835: "Indo-Portuguese",
+	189:  "Indonesian",
+	1050: "Indonesian Bajau",
+	2903: "Indonesian Sign Language",
+	2840: "Indri",
+	4553: "Indus Kohistani",
+	7265: "Indus Valley Language",
+	5147: "Inebu One",
+	2910: "Ineseño",
+	2900: "Inga",
+	2967: "Ingrian",
+	191:  "Ingush",
+	2942: "Inlaod Itneg",
+	4369: "Innu",
+	2906: "Inoke-Yate",
+	3942: "Inonhan",
+	2911: "Inor",
+	4861: "Inpui Naga",
+	2859: "Interglossa",
+	187:  "Interlingua",
+	185:  "Interlingue",
+	2887: "International Sign",
+	2909: "Intha",
+	2876: "Inuinnaqtun",
+	184:  "Inuktitut",
+	192:  "Inupiaq",
+	2913: "Iowa-Oto",
+	967:  "Ipalapa Amuzgo",
+	2915: "Ipiko",
+	2914: "Ipili",
+	852:  "Ipulo",
+	2916: "Iquito",
+	2922: "Ir",
+	5348: "Iranian Persian",
+	193:  "Iranian languages",
+	2886: "Iranun",
+	2920: "Iraqw",
+	2918: "Irarutu",
+	2925: "Iraya",
+	2917: "Iresim",
+	2919: "Irigwe",
+	149:  "Irish",
+	2930: "Irish Sign Language",
+	270:  "Irish, Middle (900-1200)",
+	369:  "Irish, Old (to 900)",
+	194:  "Iroquoian languages",
+	2923: "Irula",
+	2921: "Irántxe",
+	2926: "Isabi",
+	2935: "Isanzu",
+	613:  "Isarog Agta",
+	2927: "Isconahua",
+	2858: "Isebe",
+	2948: "Isekiri",
+	2933: "Ishkashimi",
+	2905: "Isinai",
+	6144: "Isirawa",
+	1793: "Island Carib",
+	2833: "Islander Creole English",
+	2928: "Isnag",
+	2936: "Isoko",
+	2937: "Israeli Sign Language",
+	4241: "Isthmus Mixe",
+	7682: "Isthmus Zapotec",
+	4803: "Isthmus-Cosoleacaque Nahuatl",
+	4814: "Isthmus-Mecayapan Nahuatl",
+	4807: "Isthmus-Pajapan Nahuatl",
+	2938: "Istriot",
+	5796: "Istro Romanian",
+	6279: "Isu (Fako Division)",
+	2939: "Isu (Menchum Division)",
+	195:  "Italian",
+	2929: "Italian Sign Language",
+	2950: "Itawit",
+	2944: "Itelmen",
+	2941: "Itene",
+	2947: "Iteri",
+	2952: "Itik",
+	2951: "Ito",
+	2946: "Itonama",
+	2945: "Itu Mbon Uzo",
+	4082: "Itundujia Mixtec",
+	2954: "Itzá",
+	2955: "Iu Mien",
+	2957: "Ivatan",
+	864:  "Ivbie North-Okpela-Arhe",
+	2821: "Iwaidja",
+	3123: "Iwal",
+	2959: "Iwam",
+	2960: "Iwur",
+	2962: "Ixcatec",
+	4634: "Ixcatlán Mazatec",
+	2963: "Ixil",
+	6947: "Ixtayutla Mixtec",
+	5261: "Ixtenco Otomi",
+	2964: "Iyayu",
+	6800: "Iyive",
+	4696: "Iyo",
+	1805: "Iyo'wujwa Chorote",
+	1808: "Iyojwa'ja Chorote",
+	2969: "Izere",
+	2968: "Izi-Ezaa-Ikwo-Mgbo",
+	2865: "Izon",
+	1622: "Izora",
+	2907: "Iñapari",
+	2995: "Jabutí",
+	3000: "Jad",
+	3001: "Jadgali",
+	2976: "Jah Hut",
+	2973: "Jahanka",
+	932:  "Jair Awyu",
+	2985: "Jakati",
+	2978: "Jakun",
+	4044: "Jalapa De Díaz Mazatec",
+	1527: "Jalkunan",
+	2998: "Jamaican Country Sign Language",
+	2980: "Jamaican Creole English",
+	3043: "Jamaican Sign Language",
+	2970: "Jamamadí",
+	2987: "Jambi Malay",
+	4598: "Jamiltepec Mixtec",
+	2015: "Jamsay Dogon",
+	2981: "Jandai",
+	3056: "Jandavra",
+	2017: "Jangkang",
+	3055: "Jangshung",
+	3058: "Janji",
+	198:  "Japanese",
+	3073: "Japanese Sign Language",
+	3072: "Japrería",
+	3068: "Jaqaru",
+	2975: "Jara",
+	3069: "Jarai",
+	755:  "Jarawa (India)",
+	1931: "Jaru",
+	5661: "Jauja Wanca Quechua",
+	3061: "Jaunsari",
+	196:  "Javanese",
+	3092: "Javindo",
+	2989: "Jawe",
+	3097: "Jaya",
+	3003: "Jebero",
+	3006: "Jeh",
+	3018: "Jehai",
+	6575: "Jemez",
+	1568: "Jenaama Bozo",
+	3005: "Jeng",
+	7422: "Jennu Kurumba",
+	3011: "Jere",
+	3008: "Jeri Kuo",
+	3004: "Jerung",
+	6526: "Jewish Babylonian Aramaic (ca. 200-1200 CE)",
+	3067: "Jewish Palestinian Aramaic",
+	3019: "Jhankot Sign Language",
+	3030: "Jiamao",
+	3095: "Jiarong",
+	3084: "Jiba",
+	3021: "Jibu",
+	793:  "Jicarilla Apache",
+	3027: "Jiiddu",
+	3024: "Jilbe",
+	3028: "Jilim",
+	3029: "Jimi (Cameroon)",
+	3048: "Jimi (Nigeria)",
+	3020: "Jina",
+	1704: "Jinyu Chinese",
+	5476: "Jiongnai Bunu",
+	3081: "Jirel",
+	3070: "Jiru",
+	3032: "Jita",
+	3104: "Jju",
+	3062: "Joba",
+	2994: "Jofotek-Bromnya",
+	2150: "Jola-Fonyi",
+	1822: "Jola-Kasa",
+	3013: "Jonkor Bourmataguil",
+	3065: "Jordanian Sign Language",
+	3071: "Jorto",
+	3064: "Jorá",
+	3066: "Jowulu",
+	3089: "Ju",
+	3573: "Ju/'hoan",
+	3083: "Juang",
+	200:  "Judeo-Arabic",
+	2990: "Judeo-Berber",
+	3015: "Judeo-Georgian",
+	7516: "Judeo-Iraqi Arabic",
+	2943: "Judeo-Italian",
+	670:  "Judeo-Moroccan Arabic",
+	199:  "Judeo-Persian",
+	3002: "Judeo-Tat",
+	7639: "Judeo-Tripolitanian Arabic",
+	669:  "Judeo-Tunisian Arabic",
+	3096: "Judeo-Yemeni Arabic",
+	2996: "Jukun Takum",
+	3082: "Jumjum",
+	3087: "Jumla Sign Language",
+	3049: "Jumli",
+	2902: "Jungle Inga",
+	4595: "Juquila Mixe",
+	1083: "Jur Modo",
+	3091: "Juray",
+	3076: "Jurchen",
+	3086: "Jurúna",
+	3088: "Jutish",
+	4556: "Juwal",
+	6940: "Juxtlahuaca Mixtec",
+	3094: "Jwira-Pepesa",
+	3074: "Júma",
+	5609: "K'iche'",
+	7286: "Kaamba",
+	3783: "Kaan",
+	2500: "Kaansa",
+	3537: "Kaba",
+	3601: "Kabalai",
+	212:  "Kabardian",
+	7281: "Kabatei",
+	7214: "Kabixí",
+	3126: "Kabiyè",
+	3381: "Kabola",
+	5201: "Kabore One",
+	3868: "Kabras",
+	6802: "Kaburi",
+	3131: "Kabutra",
+	3185: "Kabuverdianu",
+	1871: "Kabwa",
+	3159: "Kabwari",
+	202:  "Kabyle",
+	3160: "Kachama-Ganjule",
+	7188: "Kachari",
+	3228: "Kachchi",
+	2471: "Kachi Koli",
+	203:  "Kachin",
+	3435: "Kacipo-Balesi",
+	7277: "Kaco'",
+	3702: "Kadai",
+	3194: "Kadar",
+	3180: "Kadaru",
+	3114: "Kadiwéu",
+	7729: "Kadu",
+	3563: "Kaduo",
+	3128: "Kafa",
+	3470: "Kafoa",
+	3367: "Kagan Kalagan",
+	6268: "Kagate",
+	1666: "Kagayanen",
+	3174: "Kagoma",
+	7273: "Kagoro",
+	3338: "Kagulu",
+	2702: "Kahe",
+	624:  "Kahua",
+	3156: "Kaian",
+	3700: "Kaibobo",
+	3713: "Kaidipang",
+	3133: "Kaiep",
+	3200: "Kaikadi",
+	3714: "Kaike",
+	3346: "Kaiku",
+	7727: "Kaimbulawa",
+	7192: "Kaimbé",
+	3251: "Kaingang",
+	1710: "Kairak",
+	3648: "Kairiru",
+	3504: "Kairui-Midiki",
+	3710: "Kais",
+	3141: "Kaivi",
+	3246: "Kaiwá",
+	6343: "Kaiy",
+	1709: "Kajakse",
+	7276: "Kajali",
+	3101: "Kajaman",
+	3481: "Kakabai",
+	3334: "Kakabe",
+	3330: "Kakanda",
+	3116: "Kakauhua",
+	6306: "Kaki Ae",
+	3652: "Kakihum",
+	3339: "Kako",
+	3199: "Kakwa",
+	4569: "Kala Lagaw Ya",
+	3600: "Kalabakan",
+	2868: "Kalabari",
+	3723: "Kalabra",
+	3480: "Kalagan",
+	3335: "Kalaktang Monpa",
+	3388: "Kalam",
+	2615: "Kalami",
+	3431: "Kalamsé",
+	7068: "Kalanadi",
+	3147: "Kalanga",
+	3380: "Kalao",
+	3684: "Kalapuya",
+	3112: "Kalarko",
+	3374: "Kalasha",
+	3369: "Kalenjin",
+	2293: "Kalispel-Pend d'Oreille",
+	7267: "Kalkoti",
+	3554: "Kalkutung",
+	459:  "Kalmyk",
+	5737: "Kalo Finnish Romani",
+	7660: "Kalou",
+	1028: "Kaluli",
+	3364: "Kalumpang",
+	3182: "Kam",
+	6931: "Kamakan",
+	7120: "Kamang",
+	3127: "Kamano",
+	3145: "Kamantan",
+	3201: "Kamar",
+	3051: "Kamara",
+	3721: "Kamarian",
+	3259: "Kamaru",
+	7199: "Kamas",
+	3371: "Kamasa",
+	3399: "Kamasau",
+	3683: "Kamayo",
+	3111: "Kamayurá",
+	205:  "Kamba",
+	7205: "Kamba (Brazil)",
+	3549: "Kambaata",
+	3697: "Kambaira",
+	7212: "Kambera",
+	2924: "Kamberau",
+	7213: "Kambiwá",
+	3389: "Kami (Nigeria)",
+	3157: "Kami (Tanzania)",
+	3153: "Kamo",
+	3252: "Kamoro",
+	7324: "Kamu",
+	7292: "Kamula",
+	7431: "Kamviri",
+	2692: "Kamwe",
+	7331: "Kanakanabu",
+	3418: "Kanamarí",
+	7733: "Kanan",
+	7337: "Kanashi",
+	6096: "Kanasi",
+	1189: "Kanauji",
+	3498: "Kandas",
+	2349: "Kandawo",
+	3129: "Kande",
+	3122: "Kanembu",
+	3688: "Kang",
+	3152: "Kanga",
+	3351: "Kangean",
+	2856: "Kanggape",
+	3665: "Kangjia",
+	3572: "Kango (Bas-Uélé District)",
+	3722: "Kango (Tshopo District)",
+	7336: "Kangri",
+	3558: "Kaniet",
+	3206: "Kanikkaran",
+	3176: "Kaningdon-Nindem",
+	3712: "Kaningi",
+	3423: "Kaningra",
+	6992: "Kaninuwa",
+	3401: "Kanite",
+	3230: "Kanjari",
+	3115: "Kanju",
+	3411: "Kankanaey",
+	206:  "Kannada",
+	3219: "Kannada Kurumba",
+	3660: "Kanowit-Tanjong Melanau",
+	3661: "Kanoé",
+	3532: "Kansa",
+	7285: "Kantosi",
+	3280: "Kanu",
+	3414: "Kanufi",
+	209:  "Kanuri",
+	3430: "Kanyok",
+	3110: "Kao",
+	3489: "Kaonde",
+	7543: "Kap",
+	6325: "Kapin",
+	7358: "Kapinawá",
+	3457: "Kapingamarangi",
+	3272: "Kapori",
+	2019: "Kapriman",
+	3119: "Kaptiau",
+	3370: "Kapya",
+	1598: "Kaqchikel",
+	3102: "Kara (Central African Republic)",
+	7810: "Kara (Korea)",
+	3809: "Kara (Papua New Guinea)",
+	5695: "Kara (Tanzania)",
+	201:  "Kara-Kalpak",
+	227:  "Karachay-Balkar",
+	2362: "Karadjeri",
+	3294: "Karagas",
+	7274: "Karahawyana",
+	3178: "Karaim",
+	3460: "Karajá",
+	7366: "Karakhanid",
+	7198: "Karami",
+	3171: "Karamojong",
+	3715: "Karang",
+	3555: "Karanga",
+	7732: "Karankawa",
+	3682: "Karao",
+	3257: "Karas",
+	3469: "Karata",
+	7377: "Karawa",
+	4267: "Karbi",
+	3124: "Kare (Central African Republic)",
+	3386: "Kare (Papua New Guinea)",
+	3103: "Karekare",
+	477:  "Karelian",
+	207:  "Karen languages",
+	3676: "Karey",
+	3120: "Kari",
+	3249: "Karingani",
+	3588: "Karipuna",
+	3248: "Karipúna",
+	3402: "Karipúna Creole French",
+	3720: "Karirí-Xocó",
+	3561: "Karitiâna",
+	3293: "Kariya",
+	6926: "Kariyarra",
+	7644: "Karkar-Yuri",
+	3503: "Karkin",
+	3344: "Karko",
+	1009: "Karnai",
+	828:  "Karo (Brazil)",
+	3654: "Karo (Ethiopia)",
+	3680: "Karok",
+	3519: "Karon",
+	3258: "Karon Dori",
+	7289: "Karore",
+	1636: "Kasanga",
+	7387: "Kasem",
+	3326: "Kashaya",
+	208:  "Kashmiri",
+	96:   "Kashubian",
+	3535: "Kasiguranin",
+	3355: "Kaska",
+	7817: "Kaskean",
+	3239: "Kasseng",
+	3275: "Kasua",
+	3240: "Kataang",
+	3564: "Katabaga",
+	7200: "Katawixi",
+	6511: "Katbol",
+	7399: "Katcha-Kadugli-Miri",
+	6484: "Kathoriya Tharu",
+	7547: "Kathu",
+	1403: "Kati",
+	3231: "Katkari",
+	3154: "Katla",
+	3570: "Kato",
+	3100: "Katso",
+	3548: "Katua",
+	3109: "Katukína",
+	5552: "Kaulong",
+	6929: "Kaur",
+	1337: "Kaure",
+	7738: "Kaurna",
+	7201: "Kauwera",
+	1714: "Kavalan",
+	3517: "Kavet",
+	3138: "Kawacha",
+	7203: "Kawaiisu",
+	3238: "Kawe",
+	210:  "Kawi",
+	3571: "Kaxararí",
+	3113: "Kaxuiâna",
+	3698: "Kayabí",
+	3692: "Kayagar",
+	5333: "Kayan",
+	7204: "Kayan Mahakam",
+	7279: "Kayan River Kayan",
+	3105: "Kayapa Kallahan",
+	6750: "Kayapó",
+	2631: "Kayardild",
+	3607: "Kayaw",
+	3709: "Kayeli",
+	3671: "Kayong",
+	3694: "Kayort",
+	2361: "Kaytetye",
+	3718: "Kayupulau",
+	211:  "Kazakh",
+	3708: "Kazukuru",
+	7443: "Ke'o",
+	3192: "Keak",
+	3282: "Keapara",
+	4140: "Kedah Malay",
+	3545: "Kedang",
+	3183: "Keder",
+	3267: "Kehu",
+	3193: "Kei",
+	3187: "Keiga",
+	1259: "Kein",
+	2260: "Keiyo",
+	3195: "Kekchí",
+	3196: "Kela (Democratic Republic of Congo)",
+	3148: "Kela (Papua New Guinea)",
+	3706: "Kelabit",
+	3281: "Kele (Democratic Republic of Congo)",
+	5829: "Kele (Papua New Guinea)",
+	2851: "Keley-I Kallahan",
+	3125: "Keliko",
+	7236: "Kelo",
+	3687: "Kelon",
+	3197: "Kemak",
+	7237: "Kembayan",
+	1579: "Kemberano",
+	7288: "Kembra",
+	2038: "Kemedzung",
+	5986: "Kemi Sami",
+	3220: "Kemiehua",
+	3400: "Kemtuik",
+	7210: "Kenaboi",
+	2356: "Kenati",
+	3429: "Kendayan",
+	3361: "Kendeje",
+	3608: "Kendem",
+	3689: "Kenga",
+	3655: "Keningau Murut",
+	3417: "Keninjal",
+	3424: "Kensiu",
+	4719: "Kenswei Nsei",
+	7275: "Kenyan Sign Language",
+	3198: "Kenyang",
+	3871: "Kenyi",
+	7339: "Kenzi",
+	7242: "Keoru-Ahia",
+	3464: "Kepkiriwát",
+	3583: "Kepo'",
+	3202: "Kera",
+	2686: "Kerak",
+	7271: "Kereho",
+	3510: "Kerek",
+	3188: "Kerewe",
+	3672: "Kerewo",
+	3613: "Kerinci",
+	7240: "Kesawai",
+	3204: "Ket",
+	3099: "Ketangalan",
+	3158: "Kete",
+	7401: "Ketengban",
+	3567: "Ketum",
+	3679: "Keyagana",
+	7287: "Kgalagadi",
+	3313: "Khakas",
+	3311: "Khalaj",
+	3373: "Khaling",
+	3117: "Khamba",
+	3266: "Khams Tibetan",
+	3276: "Khamti",
+	3542: "Khamyang",
+	5142: "Khana",
+	3271: "Khandesi",
+	3137: "Khanty",
+	7195: "Khao",
+	3233: "Kharam Naga",
+	3274: "Kharia",
+	3546: "Kharia Thar",
+	213:  "Khasi",
+	3877: "Khayo",
+	7740: "Khazar",
+	3482: "Khe",
+	6508: "Khehek",
+	7272: "Khengkha",
+	7253: "Khetrani",
+	4863: "Khezha Naga",
+	3303: "Khiamniungan Naga",
+	3315: "Khinalugh",
+	3645: "Khirwar",
+	3488: "Khisa",
+	3896: "Khlor",
+	7542: "Khlula",
+	215:  "Khmer, Central",
+	3312: "Khmu",
+	7269: "Kho'ini",
+	4857: "Khoibu Naga",
+	214:  "Khoisan languages",
+	3550: "Kholok",
+	3406: "Khorasani Turkish",
+	7731: "Khorezmian",
+	216:  "Khotanese",
+	3279: "Khowar",
+	7257: "Khua",
+	3265: "Khuen",
+	1750: "Khumi Chin",
+	3223: "Khunsari",
+	3278: "Khvarshi",
+	3318: "Kháng",
+	3337: "Khün",
+	3287: "Kibet",
+	5526: "Kibiri",
+	3285: "Kickapoo",
+	3705: "Kikai",
+	217:  "Kikuyu",
+	5983: "Kildin Sami",
+	3292: "Kilivila",
+	3357: "Kiliwa",
+	3290: "Kilmeri",
+	3283: "Kim",
+	4253: "Kim Mun",
+	3289: "Kimaama",
+	3493: "Kimaragang",
+	3301: "Kimbu",
+	220:  "Kimbundu",
+	5846: "Kimki",
+	3491: "Kimré",
+	1628: "Kinabalian",
+	3151: "Kinalakna",
+	4292: "Kinamiging Manobo",
+	3509: "Kinaray-A",
+	7708: "Kinga",
+	3221: "Kinnauri",
+	3422: "Kintaq",
+	3333: "Kinuku",
+	218:  "Kinyarwanda",
+	6790: "Kioko",
+	3342: "Kiong",
+	7280: "Kiorr",
+	3295: "Kiowa",
+	794:  "Kiowa Apache",
+	5917: "Kipsigis",
+	3681: "Kiput",
+	3347: "Kir-Balar",
+	2412: "Kire",
+	219:  "Kirghiz",
+	5168: "Kirike",
+	3304: "Kirikiri",
+	3300: "Kirmanjki (individual language)",
+	2291: "Kirya-Konzəl",
+	3298: "Kis",
+	3879: "Kisa",
+	3483: "Kisankasa",
+	3310: "Kisar",
+	3305: "Kisi",
+	2560: "Kistane",
+	4565: "Kita Maninkakan",
+	7737: "Kitan",
+	2453: "Kitja",
+	3291: "Kitsai",
+	4291: "Kituba (Congo)",
+	3568: "Kituba (Democratic Republic of Congo)",
+	7025: "Kiunum",
+	3356: "Klamath-Modoc",
+	3376: "Klao",
+	3495: "Klias River Kadazan",
+	421:  "Klingon",
+	1881: "Knaanic",
+	2327: "Ko",
+	3284: "Koalib",
+	1713: "Koasati",
+	3455: "Koba",
+	3146: "Kobiana",
+	3256: "Kobol",
+	3472: "Kobon",
+	3177: "Koch",
+	6432: "Kochila Tharu",
+	1655: "Koda",
+	3547: "Kodaku",
+	3211: "Kodava",
+	6932: "Kodeoha",
+	3434: "Kodi",
+	3637: "Kodia",
+	3155: "Koenoem",
+	3536: "Kofa",
+	3459: "Kofei",
+	3633: "Kofyar",
+	7730: "Koguryo",
+	3353: "Kohin",
+	5423: "Kohistani Shina",
+	3463: "Koho",
+	1032: "Kohumono",
+	3349: "Koi",
+	7728: "Koibal",
+	4859: "Koireng",
+	3484: "Koitabu",
+	3666: "Koiwat",
+	6625: "Kok Borok",
+	2477: "Kok-Nar",
+	3551: "Kokata",
+	3446: "Koke",
+	5078: "Koki Naga",
+	5159: "Koko Babangk",
+	7341: "Kokoda",
+	3711: "Kokola",
+	3340: "Kokota",
+	2180: "Kol",
+	1177: "Kol (Cameroon)",
+	3441: "Kol (Papua New Guinea)",
+	3617: "Kola",
+	3358: "Kolbila",
+	6009: "Kolibugan Subanon",
+	3379: "Koluwawa",
+	1214: "Kom (Cameroon)",
+	3393: "Kom (India)",
+	3405: "Koma",
+	3456: "Komba",
+	6759: "Kombai",
+	7208: "Kombio",
+	3241: "Komering",
+	222:  "Komi",
+	3439: "Komi-Permyak",
+	3471: "Komi-Zyrian",
+	7343: "Kominimung",
+	3403: "Komo (Democratic Republic of Congo)",
+	7345: "Komo (Sudan)",
+	3603: "Komodo",
+	3611: "Kompane",
+	3719: "Komyandaret",
+	3343: "Kon Keu",
+	3669: "Konai",
+	3410: "Konda",
+	3213: "Konda-Dora",
+	3181: "Koneraw",
+	223:  "Kongo",
+	221:  "Konkani",
+	3419: "Konkani (individual language)",
+	7346: "Konkomba",
+	3382: "Konni",
+	3426: "Kono (Guinea)",
+	3366: "Kono (Nigeria)",
+	3420: "Kono (Sierra Leone)",
+	3432: "Konomala",
+	3162: "Konongo",
+	3650: "Konso",
+	4677: "Konyak Naga",
+	4289: "Konyanka Maninka",
+	3442: "Konzo",
+	3413: "Koongo",
+	5274: "Koonzime",
+	3500: "Koorete",
+	7348: "Kopar",
+	5217: "Kopkaka",
+	3467: "Korafe-Yegha",
+	3451: "Korak",
+	3501: "Korana",
+	3161: "Korandje",
+	224:  "Korean",
+	3606: "Korean Sign Language",
+	1762: "Koreguaje",
+	5160: "Koresh-e Rostam",
+	3227: "Korku",
+	6933: "Korlai Creole Portuguese",
+	3225: "Koro (Côte d'Ivoire)",
+	3040: "Koro (India)",
+	3664: "Koro (Papua New Guinea)",
+	3506: "Koro (Vanuatu)",
+	1368: "Koro Wachi",
+	3236: "Koromfé",
+	3485: "Koromira",
+	1339: "Koronadal Blaan",
+	7282: "Koroni",
+	3513: "Korop",
+	7444: "Koropó",
+	3559: "Koroshi",
+	3264: "Korowai",
+	3214: "Korra Koraga",
+	7349: "Korubo",
+	3466: "Korupun-Sela",
+	3226: "Korwa",
+	3474: "Koryak",
+	3297: "Kosadle",
+	3341: "Kosarek Yale",
+	3703: "Kosena",
+	3286: "Koshin",
+	225:  "Kosraean",
+	3444: "Kota (Gabon)",
+	3215: "Kota (India)",
+	4416: "Kota Bangun Kutai Malay",
+	2554: "Kota Marudu Talantang",
+	3565: "Kota Marudu Tinagas",
+	3486: "Kotafon Gbe",
+	909:  "Kotava",
+	2182: "Koti",
+	7734: "Kott",
+	3678: "Kouya",
+	3477: "Kovai",
+	3598: "Kove",
+	7350: "Kowaki",
+	3629: "Kowiai",
+	3479: "Koy Sanjaq Surat",
+	3216: "Koya",
+	3237: "Koyaga",
+	3438: "Koyo",
+	3273: "Koyra Chiini Songhay",
+	5904: "Koyraboro Senni Songhai",
+	3450: "Koyukon",
+	3592: "Kpagua",
+	3462: "Kpala",
+	3461: "Kpan",
+	5301: "Kpasam",
+	3433: "Kpati",
+	3685: "Kpatili",
+	226:  "Kpelle",
+	3190: "Kpessi",
+	3458: "Kplang",
+	3677: "Krache",
+	7368: "Krahô",
+	5726: "Kraol",
+	3492: "Krenak",
+	7739: "Krevinian",
+	7370: "Kreye",
+	7371: "Krikati-Timbira",
+	3511: "Krim",
+	3508: "Krio",
+	5772: "Kriol",
+	3530: "Krisa",
+	3649: "Krobu",
+	3250: "Krongo",
+	228:  "Kru languages",
+	3514: "Kru'ng 2",
+	2999: "Krymchak",
+	3520: "Kryts",
+	6764: "Kua",
+	7544: "Kua-nsi",
+	7548: "Kuamasi",
+	6775: "Kuan",
+	7333: "Kuanhua",
+	3525: "Kuanua",
+	230:  "Kuanyama",
+	3242: "Kube",
+	3436: "Kubi",
+	3038: "Kubo",
+	3597: "Kubu",
+	3869: "Kucong",
+	3217: "Kudiya",
+	3695: "Kudmali",
+	3447: "Kudu-Camo",
+	3448: "Kugama",
+	3203: "Kugbo",
+	3667: "Kui (India)",
+	3599: "Kui (Indonesia)",
+	2023: "Kuijau",
+	3581: "Kuikúro-Kalapálo",
+	6928: "Kujarge",
+	3224: "Kuk",
+	3593: "Kukatja",
+	2452: "Kuke",
+	3210: "Kukele",
+	3208: "Kukna",
+	7320: "Kuku-Mangk",
+	7319: "Kuku-Mu'inh",
+	7312: "Kuku-Muminh",
+	6792: "Kuku-Ugbanh",
+	6886: "Kuku-Uwanh",
+	2607: "Kuku-Yalanji",
+	6583: "Kula",
+	3584: "Kulere",
+	3656: "Kulfa",
+	7356: "Kulina Pano",
+	6930: "Kulisusu",
+	3234: "Kullu Pahari",
+	6880: "Kulon-Pazeh",
+	3360: "Kulung (Nepal)",
+	1008: "Kulung (Nigeria)",
+	3533: "Kumalu",
+	3170: "Kumam",
+	3577: "Kuman",
+	5646: "Kuman (Russia)",
+	3235: "Kumaoni",
+	3390: "Kumarbhag Paharia",
+	3534: "Kumba",
+	3254: "Kumbainggar",
+	7066: "Kumbaran",
+	7284: "Kumbewaha",
+	3502: "Kumhali",
+	1988: "Kumiai",
+	3586: "Kumukio",
+	231:  "Kumyk",
+	7836: "Kumzari",
+	3585: "Kunama",
+	7074: "Kunbarlang",
+	3175: "Kunda",
+	5939: "Kundal Shahi",
+	7069: "Kunduvadi",
+	3222: "Kung",
+	3428: "Kung-Ekoka",
+	2434: "Kungarakany",
+	3614: "Kunggara",
+	3247: "Kunggari",
+	3526: "Kuni",
+	3602: "Kuni-Boazi",
+	7421: "Kunigami",
+	3587: "Kunimaipa",
+	5346: "Kunja",
+	3319: "Kunjen",
+	4853: "Kunyi",
+	3595: "Kunza",
+	7425: "Kuo",
+	3562: "Kuot",
+	3579: "Kupa",
+	4282: "Kupang Malay",
+	3209: "Kupia",
+	3475: "Kupsabiny",
+	3591: "Kur",
+	4978: "Kura Ede Nago",
+	3507: "Kurama",
+	3416: "Kuranko",
+	232:  "Kurdish",
+	4684: "Kuri",
+	3582: "Kuria",
+	3218: "Kurichiya",
+	3232: "Kurmukar",
+	6935: "Kurrama",
+	3560: "Kurti",
+	7291: "Kurtokha",
+	3323: "Kurudu",
+	229:  "Kurukh",
+	3690: "Kuruáya",
+	3589: "Kusaal",
+	3528: "Kusaghe",
+	3580: "Kushi",
+	3543: "Kusu",
+	3243: "Kusunda",
+	233:  "Kutenai",
+	3574: "Kutep",
+	7428: "Kuthant",
+	6008: "Kutong",
+	3452: "Kutto",
+	3164: "Kutu",
+	3268: "Kuturmi",
+	6811: "Kuuk-Yak",
+	3594: "Kuuku-Ya'u",
+	3668: "Kuvi",
+	1234: "Kuwaa",
+	1876: "Kuwaataay",
+	3179: "Kuy",
+	2292: "Kven Finnish",
+	7065: "Kw'adza",
+	3623: "Kwa",
+	1216: "Kwa'",
+	3538: "Kwaami",
+	3647: "Kwadi",
+	3625: "Kwaio",
+	3184: "Kwaja",
+	3638: "Kwak",
+	3632: "Kwakiutl",
+	3642: "Kwakum",
+	5648: "Kwalhioqua-Tlatskanai",
+	3397: "Kwama",
+	3634: "Kwambi",
+	6542: "Kwamera",
+	3553: "Kwami",
+	5163: "Kwamtim One",
+	3604: "Kwang",
+	3631: "Kwanga",
+	3635: "Kwangali",
+	3421: "Kwanja",
+	3627: "Kwara'ae",
+	4902: "Kwasio",
+	3673: "Kwaya",
+	7435: "Kwaza",
+	7438: "Kwegu",
+	3639: "Kwer",
+	3626: "Kwerba",
+	7441: "Kwerba Mamberamo",
+	1874: "Kwere",
+	3331: "Kwerisa",
+	3640: "Kwese",
+	3641: "Kwesten",
+	2626: "Kwini",
+	3575: "Kwinsu",
+	3644: "Kwinti",
+	3395: "Kwoma",
+	3636: "Kwomtari",
+	7429: "Kxoe",
+	1204: "Kyak",
+	3675: "Kyaka",
+	4981: "Kyan-Karyaw Naga",
+	3487: "Kyenele",
+	6754: "Kyenga",
+	3260: "Kyerung",
+	3387: "Kâte",
+	3186: "Kélé",
+	3529: "Kölsch",
+	1587: "Kɛlɛngaxo Bozo",
+	3750: "La'bi",
+	2403: "Laal",
+	3780: "Laari",
+	3739: "Laba",
+	3745: "Label",
+	3041: "Labir",
+	4563: "Labo",
+	7605: "Labo Phowa",
+	3761: "Labu",
+	2102: "Labuk-Kinabatangan Kadazan",
+	3726: "Lacandon",
+	3760: "Lachi",
+	7783: "Lachiguiri Zapotec",
+	7794: "Lachixío Zapotec",
+	3751: "Ladakhi",
+	3885: "Ladin",
+	234:  "Ladino",
+	3891: "Ladji Ladji",
+	3875: "Laeko-Libuat",
+	3728: "Lafofa",
+	3817: "Laghu",
+	3819: "Laghuu",
+	3445: "Lagwan",
+	3831: "Laha (Indonesia)",
+	3830: "Laha (Viet Nam)",
+	3835: "Lahanan",
+	235:  "Lahnda",
+	3615: "Lahta Karen",
+	3839: "Lahu",
+	3832: "Lahu Shi",
+	3833: "Lahul Lohar",
+	3923: "Laimbue",
+	1724: "Laitu Chin",
+	3864: "Laiyolo",
+	3747: "Lak",
+	3735: "Laka (Chad)",
+	3732: "Laka (Nigeria)",
+	3867: "Lakalei",
+	3922: "Lake Miwok",
+	3872: "Lakha",
+	3873: "Laki",
+	3746: "Lakkia",
+	3876: "Lakon",
+	3870: "Lakondê",
+	3880: "Lakota",
+	1984: "Lakota Dida",
+	4997: "Lala",
+	3790: "Lala-Bisa",
+	3882: "Lala-Roba",
+	1751: "Lalana Chinantec",
+	3733: "Lalia",
+	3742: "Lama (Myanmar)",
+	3738: "Lama (Togo)",
+	6033: "Lamaholot",
+	3919: "Lamalera",
+	3914: "Lamam",
+	2688: "Lamang",
+	3918: "Lamatuka",
+	236:  "Lamba",
+	3915: "Lambadi",
+	5611: "Lambayeque Quechua",
+	3909: "Lambichhong",
+	3924: "Lamboya",
+	3730: "Lambya",
+	1252: "Lame",
+	3920: "Lamenu",
+	3755: "Lamet",
+	3779: "Lamja-Dengsa-Tola",
+	3912: "Lamkang",
+	3810: "Lamma",
+	3937: "Lamnso'",
+	3908: "Lamogai",
+	3866: "Lampung Api",
+	519:  "Lampung Nyo",
+	3889: "Lamu",
+	3765: "Lamu-Lamu",
+	5799: "Lanas Lobu",
+	102:  "Land Dayak languages",
+	3784: "Landoma",
+	7583: "Lang'e",
+	3934: "Langam",
+	3926: "Langbashe",
+	3729: "Langi",
+	7557: "Langnian Buyang",
+	3936: "Lango (Sudan)",
+	3731: "Lango (Uganda)",
+	3929: "Langobardic",
+	5911: "Langue des signes de Belgique Francophone",
+	3930: "Lanoh",
+	237:  "Lao",
+	4889: "Lao Naga",
+	4029: "Laomian",
+	3749: "Laopang",
+	3990: "Laos Sign Language",
+	7824: "Lapaguía-Guivini Zapotec",
+	3971: "Laragia",
+	3979: "Larantuka Malay",
+	3766: "Lardil",
+	3980: "Larevat",
+	2715: "Large Flowery Miao",
+	3974: "Lari",
+	707:  "Larike-Wakasihu",
+	3977: "Laro",
+	3737: "Larteh",
+	3734: "Laru",
+	3894: "Lasalimu",
+	3982: "Lasgerdi",
+	3987: "Lashi",
+	3993: "Lasi",
+	3996: "Late Middle Chinese",
+	3997: "Latgalian",
+	238:  "Latin",
+	4002: "Latu",
+	3999: "Latundê",
+	239:  "Latvian",
+	3988: "Latvian Sign Language",
+	3900: "Lau",
+	4006: "Laua",
+	3901: "Lauan",
+	3740: "Lauje",
+	4013: "Laura",
+	3970: "Laurentian",
+	1729: "Lautu Chin",
+	3762: "Lavatbura-Lamusong",
+	1373: "Lave",
+	3756: "Laven",
+	4021: "Lavukaleve",
+	3764: "Lawangan",
+	6406: "Lawunuia",
+	4033: "Layakha",
+	4039: "Laz",
+	1721: "Lealao Chinantec",
+	3791: "Leco",
+	3811: "Ledo Kaili",
+	3782: "Leelau",
+	3814: "Lefa",
+	3823: "Lega-Mwenga",
+	3789: "Lega-Shabunda",
+	604:  "Legbo",
+	3767: "Legenyem",
+	6601: "Lehali",
+	6860: "Lehalurup",
+	1593: "Lehar",
+	4038: "Leinong Naga",
+	3799: "Leipon",
+	3892: "Lelak",
+	3895: "Lele (Chad)",
+	3800: "Lele (Democratic Republic of Congo)",
+	3884: "Lele (Guinea)",
+	3886: "Lele (Papua New Guinea)",
+	3794: "Lelemi",
+	3963: "Lelepa",
+	3805: "Lembena",
+	3981: "Lemerig",
+	3797: "Lemio",
+	7296: "Lemnian",
+	3813: "Lemolang",
+	3781: "Lemoro",
+	6543: "Lenakel",
+	3802: "Lenca",
+	3792: "Lendu",
+	3820: "Lengilu",
+	3826: "Lengo",
+	3798: "Lengola",
+	3795: "Lengua",
+	3932: "Leningitij",
+	3796: "Lenje",
+	3806: "Lenkau",
+	3778: "Lenyima",
+	3804: "Lepcha",
+	3964: "Lepki",
+	7301: "Lepontic",
+	2506: "Lere",
+	3807: "Lese",
+	3808: "Lesing-Gelimi",
+	4914: "Letemboi",
+	3803: "Leti (Cameroon)",
+	3998: "Leti (Indonesia)",
+	4023: "Levuka",
+	4032: "Lewo",
+	4025: "Lewo Eleng",
+	4031: "Lewotobi",
+	949:  "Leyigha",
+	240:  "Lezghian",
+	3836: "Lhokpu",
+	3834: "Lhomi",
+	3865: "Li'o",
+	3860: "Liabuku",
+	6183: "Liana-Seti",
+	4847: "Liangmai Naga",
+	7743: "Lianshan Zhuang",
+	7352: "Liberia Kpelle",
+	3855: "Liberian English",
+	3854: "Libido",
+	3862: "Libinza",
+	3753: "Libon Bikol",
+	7298: "Liburnian",
+	951:  "Libyan Arabic",
+	3759: "Libyan Sign Language",
+	3846: "Ligbi",
+	3829: "Ligenza",
+	3849: "Ligurian",
+	7297: "Ligurian (Ancient)",
+	3847: "Lihir",
+	4184: "Lijili",
+	3850: "Lika",
+	3852: "Liki",
+	3844: "Likila",
+	3670: "Likuba",
+	3841: "Likum",
+	3624: "Likwala",
+	3893: "Lilau",
+	3851: "Lillooet",
+	1256: "Limassa",
+	3845: "Limbu",
+	3917: "Limbum",
+	241:  "Limburgish",
+	7556: "Limi",
+	3904: "Limilngan",
+	3391: "Limos Kalinga",
+	3378: "Lindu",
+	3725: "Linear A",
+	242:  "Lingala",
+	5196: "Lingao",
+	3821: "Lingarak",
+	3848: "Lingkhim",
+	5447: "Lingua Franca",
+	3815: "Lingua Franca Nova",
+	795:  "Lipan Apache",
+	3966: "Lipo",
+	3775: "Lisabata-Nuniali",
+	3772: "Lisela",
+	3986: "Lish",
+	3983: "Lishana Deni",
+	651:  "Lishanid Noshan",
+	6617: "Lishán Didán",
+	3856: "Lisu",
+	4036: "Literary Chinese",
+	243:  "Lithuanian",
+	3899: "Lithuanian Sign Language",
+	4037: "Litzlitz",
+	7741: "Liujiang Zhuang",
+	7744: "Liuqian Zhuang",
+	3858: "Liv",
+	5177: "Livvi",
+	3838: "Lo-Toga",
+	3973: "Loarki",
+	3954: "Lobala",
+	3941: "Lobi",
+	3754: "Lodhi",
+	3825: "Logba",
+	3945: "Logo",
+	3944: "Logol",
+	5669: "Logooli",
+	3857: "Logorik",
+	6137: "Logudorese Sardinian",
+	3758: "Lohorung",
+	5633: "Loja Highland Quichua",
+	197:  "Lojban",
+	7474: "Lokaa",
+	3962: "Loke",
+	3949: "Loko",
+	3881: "Lokoya",
+	3768: "Lola",
+	3898: "Lolak",
+	3888: "Lole",
+	3883: "Lolo",
+	3940: "Loloda",
+	7489: "Lolopo",
+	3947: "Loma (Côte d'Ivoire)",
+	3950: "Loma (Liberia)",
+	3921: "Lomaiviti",
+	5740: "Lomavren",
+	3916: "Lombard",
+	3910: "Lombi",
+	3952: "Lombo",
+	4779: "Lomwe",
+	3769: "Loncong",
+	3965: "Long Phuri Naga",
+	6681: "Long Wat",
+	3828: "Longgu",
+	7121: "Longto",
+	3938: "Longuda",
+	3956: "Loniu",
+	1794: "Lonwolwol",
+	3939: "Lonzo",
+	3786: "Loo",
+	3953: "Lopa",
+	3959: "Lopi",
+	3967: "Lopit",
+	3976: "Lorang",
+	3935: "Lorediakarkar",
+	6116: "Loreto-Ucayali Spanish",
+	6885: "Lote",
+	4842: "Lotha Naga",
+	2110: "Lotud",
+	3948: "Lou",
+	3958: "Louisiana Creole French",
+	3961: "Loun",
+	7300: "Loup A",
+	7293: "Loup B",
+	7206: "Lower Burdekin",
+	1656: "Lower Chehalis",
+	2049: "Lower Grand Valley Dani",
+	6028: "Lower Silesian",
+	6673: "Lower Ta'oih",
+	6281: "Lower Tanana",
+	1728: "Lowland Oaxaca Chontal",
+	6283: "Lowland Tarahumara",
+	7827: "Loxicha Zapotec",
+	245:  "Lozi",
+	5516: "Lua'",
+	3812: "Luang",
+	248:  "Luba-Katanga",
+	247:  "Luba-Lulua",
+	3139: "Lubila",
+	3770: "Lubu",
+	3408: "Lubuagan Kalinga",
+	3771: "Luchazi",
+	4012: "Lucumi",
+	4004: "Ludian",
+	3788: "Lufu",
+	3818: "Lugbara",
+	5791: "Luguru",
+	3744: "Lui",
+	4010: "Luimbi",
+	250:  "Luiseno",
+	2068: "Lukpa",
+	4015: "Lumba-Yakkha",
+	3925: "Lumbee",
+	4011: "Lumbu",
+	3905: "Lumun",
+	4007: "Luna",
+	4008: "Lunanakha",
+	251:  "Lunda",
+	3928: "Lundayeh",
+	3816: "Lungga",
+	252:  "Luo",
+	4017: "Luo (Cameroon)",
+	2723: "Luopohe Hmong",
+	3777: "Luri",
+	3984: "Lusengo",
+	253:  "Lushai",
+	4014: "Lushootseed",
+	3270: "Lusi",
+	7302: "Lusitanian",
+	4739: "Lutos",
+	4005: "Luvale",
+	4016: "Luwati",
+	4030: "Luwo",
+	246:  "Luxembourgish",
+	4035: "Luyana",
+	4018: "Luyia",
+	4024: "Lwalu",
+	7294: "Lycian",
+	7295: "Lydian",
+	4034: "Lyngngam",
+	3985: "Lyons Sign Language",
+	3793: "Lyélé",
+	3785: "Láadan",
+	1500: "Láá Láá Bwamu",
+	3261: "Lü",
+	4469: "Ma (Democratic Republic of Congo)",
+	4258: "Ma (Papua New Guinea)",
+	5999: "Ma Manda",
+	4224: "Ma'anyan",
+	4209: "Ma'di",
+	6042: "Ma'ya",
+	1733: "Maa",
+	4148: "Maaka",
+	2283: "Maasina Fulfulde",
+	7571: "Maay",
+	4108: "Maba (Chad)",
+	4411: "Maba (Indonesia)",
+	4342: "Mabaale",
+	4176: "Mabaan",
+	3336: "Mabaka Valley Kalinga",
+	4518: "Mabire",
+	4078: "Maca",
+	4089: "Macaguaje",
+	4065: "Macaguán",
+	4644: "Macanese",
+	254:  "Macedonian",
+	3046: "Machame",
+	4079: "Machiguenga",
+	4389: "Machinere",
+	4551: "Machinga",
+	7129: "Maco",
+	4625: "Macuna",
+	4055: "Macushi",
+	4599: "Mada (Cameroon)",
+	4104: "Mada (Nigeria)",
+	4629: "Madagascar Sign Language",
+	4340: "Madak",
+	7327: "Maden",
+	2032: "Madhi Madhi",
+	2550: "Madi",
+	7756: "Madngele",
+	255:  "Madurese",
+	4322: "Mae",
+	2722: "Maek",
+	2949: "Maeng Itneg",
+	4043: "Mafa",
+	4290: "Mafea",
+	1249: "Mag-Indi Ayta",
+	5916: "Mag-antsi Ayta",
+	256:  "Magahi",
+	958:  "Magbukun Ayta",
+	7406: "Magdalena Peñasco Mixtec",
+	2498: "Magoma",
+	7712: "Magori",
+	4110: "Maguindanaon",
+	4268: "Mahali",
+	1110: "Mahasu Pahari",
+	4269: "Mahican",
+	4202: "Mahongwe",
+	4602: "Mahou",
+	961:  "Mai Brat",
+	6014: "Maia",
+	4651: "Maiadomu",
+	6540: "Maiani",
+	4330: "Maii",
+	4196: "Mailu",
+	1872: "Maindo",
+	6943: "Mainfränkisch",
+	7278: "Mainstream Kenyah",
+	7815: "Mairasi",
+	4068: "Maisin",
+	258:  "Maithili",
+	7094: "Maiwa (Indonesia)",
+	4493: "Maiwa (Papua New Guinea)",
+	4520: "Maiwala",
+	4390: "Majang",
+	7313: "Majera",
+	4270: "Majhi",
+	4327: "Majhwar",
+	3384: "Majukayang Kalinga",
+	4276: "Mak (China)",
+	5300: "Mak (Nigeria)",
+	4093: "Makaa",
+	4611: "Makah",
+	4294: "Makasae",
+	259:  "Makasar",
+	4166: "Makassar Malay",
+	897:  "Makayam",
+	6957: "Makhuwa",
+	7307: "Makhuwa-Marrevone",
+	4183: "Makhuwa-Meetto",
+	4213: "Makhuwa-Moniga",
+	7391: "Makhuwa-Saka",
+	6948: "Makhuwa-Shirima",
+	4181: "Maklew",
+	7752: "Makolkol",
+	3166: "Makonde",
+	4020: "Maku'a",
+	3050: "Makuri Naga",
+	4405: "Makuráp",
+	7569: "Makwe",
+	6829: "Makyan Naga",
+	4300: "Mal",
+	4272: "Mal Paharia",
+	5800: "Mala (Nigeria)",
+	5336: "Mala (Papua New Guinea)",
+	2891: "Mala Malasar",
+	1638: "Malaccan Creole Malay",
+	4090: "Malaccan Creole Portuguese",
+	275:  "Malagasy",
+	4298: "Malakhel",
+	4336: "Malalamai",
+	4307: "Malango",
+	4259: "Malankuravan",
+	4260: "Malapandaram",
+	4261: "Malaryan",
+	4286: "Malas",
+	7576: "Malasar",
+	4262: "Malavedan",
+	3951: "Malawi Lomwe",
+	6231: "Malawi Sena",
+	266:  "Malay",
+	7742: "Malay (individual language)",
+	260:  "Malayalam",
+	7232: "Malayic Dayak",
+	4317: "Malaynon",
+	4067: "Malayo",
+	7315: "Malaysian Sign Language",
+	1100: "Malba Birifor",
+	4125: "Male (Ethiopia)",
+	4106: "Male (Papua New Guinea)",
+	5515: "Malecite-Passamaquoddy",
+	5413: "Maleng",
+	4187: "Maleu-Kilenge",
+	4316: "Malfaxal",
+	6949: "Malgana",
+	4584: "Malgbe",
+	2383: "Mali",
+	4564: "Maligo",
+	4192: "Malila",
+	4630: "Malimba",
+	4302: "Malimpung",
+	6333: "Malinaltepec Me'phaa",
+	4295: "Malo",
+	4062: "Malol",
+	276:  "Maltese",
+	4114: "Maltese Sign Language",
+	4305: "Malua Bay",
+	4522: "Malvi",
+	7509: "Malyangapa",
+	2594: "Maléku Jaíka",
+	4045: "Mam",
+	4318: "Mama",
+	4206: "Mamaa",
+	7090: "Mamaindé",
+	4331: "Mamanwa",
+	4614: "Mamara Senoufo",
+	4419: "Mamasa",
+	4188: "Mambae",
+	4096: "Mambai",
+	4533: "Mamboru",
+	4193: "Mambwe-Lungu",
+	4050: "Mampruli",
+	4433: "Mamuju",
+	2199: "Mamulique",
+	3167: "Mamusi",
+	4111: "Mamvu",
+	4329: "Man Met",
+	7316: "Manado Malay",
+	4531: "Manam",
+	4299: "Manambu",
+	4908: "Manangba",
+	7774: "Manangkari",
+	277:  "Manchu",
+	7745: "Manda (Australia)",
+	4201: "Manda (India)",
+	4194: "Manda (Tanzania)",
+	4220: "Mandahuaca",
+	4228: "Mandaic",
+	4217: "Mandan",
+	7755: "Mandandanyi",
+	268:  "Mandar",
+	6308: "Mandara",
+	4430: "Mandari",
+	1739: "Mandarin Chinese",
+	4459: "Mandaya",
+	4256: "Mandeali",
+	4427: "Mander",
+	261:  "Mandingo",
+	4351: "Mandinka",
+	4172: "Mandjak",
+	507:  "Mandobo Atas",
+	1506: "Mandobo Bawah",
+	3012: "Manem",
+	7773: "Mang",
+	3135: "Manga Kanuri",
+	4139: "Mangala",
+	4388: "Mangarayi",
+	4456: "Mangareva",
+	7775: "Mangas",
+	4613: "Mangayat",
+	4112: "Mangbetu",
+	4113: "Mangbutu",
+	7749: "Mangerr",
+	2428: "Mangetti Dune !Xung",
+	4332: "Mangga Buang",
+	4434: "Manggarai",
+	4180: "Mango",
+	4413: "Mangole",
+	4059: "Mangseng",
+	4374: "Mangue",
+	7317: "Manichaean Middle Persian",
+	512:  "Manide",
+	4363: "Manikion",
+	4425: "Manipa",
+	278:  "Manipuri",
+	3412: "Mankanya",
+	4147: "Mann",
+	4265: "Manna-Dora",
+	4266: "Mannan",
+	279:  "Manobo languages",
+	7124: "Manombai",
+	4470: "Mansaka",
+	4358: "Mansi",
+	4481: "Mansoanka",
+	4610: "Manta",
+	5033: "Mantsi",
+	3653: "Manumanaw Karen",
+	7042: "Manusela",
+	151:  "Manx",
+	4635: "Manya",
+	4364: "Manyawa",
+	4581: "Manyika",
+	4647: "Manza",
+	4680: "Mao Naga",
+	4321: "Maonan",
+	6224: "Maore Comorian",
+	262:  "Maori",
+	4301: "Mape",
+	4353: "Mapena",
+	4409: "Mapia",
+	4407: "Mapidian",
+	1571: "Mapos Buang",
+	4084: "Mapoyo",
+	22:   "Mapudungun",
+	5988: "Mapun",
+	4085: "Maquiritari",
+	4129: "Mara",
+	4443: "Mara Chin",
+	3972: "Marachi",
+	6945: "Maraghei",
+	4453: "Maragus",
+	4896: "Maram Naga",
+	3975: "Marama",
+	4607: "Maramba",
+	4457: "Maranao",
+	7762: "Maranunggu",
+	4177: "Mararit",
+	264:  "Marathi",
+	4546: "Marau",
+	4391: "Marba",
+	4458: "Maremgi",
+	6953: "Marenje",
+	4549: "Marfa",
+	7747: "Margany",
+	4454: "Marghi Central",
+	4163: "Marghi South",
+	5635: "Margos-Yarowilca-Lauricocha Quechua",
+	4207: "Margu",
+	78:   "Mari",
+	4075: "Mari (East Sepik Province)",
+	2746: "Mari (Madang Province)",
+	4452: "Maria (India)",
+	4119: "Maria (Papua New Guinea)",
+	4438: "Maricopa",
+	7748: "Maridan",
+	7754: "Maridjabin",
+	1887: "Marik",
+	7757: "Marimanindji",
+	4460: "Marind",
+	4074: "Maring",
+	4928: "Maring Naga",
+	7764: "Maringarr",
+	4437: "Marino",
+	4418: "Mariri",
+	4168: "Marithiel",
+	5012: "Maritime Sign Language",
+	4475: "Maritsauá",
+	7769: "Mariyedi",
+	5730: "Marka",
+	2209: "Markweeta",
+	5755: "Marma",
+	4543: "Marovo",
+	7376: "Marriammu",
+	6824: "Marrucinian",
+	257:  "Marshallese",
+	2898: "Marsian",
+	4440: "Martha's Vineyard Sign Language",
+	7751: "Marti Ke",
+	4394: "Martu Wangka",
+	6938: "Martuyhunira",
+	4223: "Maru",
+	288:  "Marwari",
+	5806: "Marwari (India)",
+	4534: "Marwari (Pakistan)",
+	4643: "Marúbo",
+	4624: "Masaaba",
+	6456: "Masadiit Itneg",
+	265:  "Masai",
+	4312: "Masalit",
+	4091: "Masana",
+	4461: "Masbatenyo",
+	1856: "Mashco Piro",
+	3052: "Mashi (Nigeria)",
+	4215: "Mashi (Zambia)",
+	4467: "Masikoro Malagasy",
+	2934: "Masimasi",
+	1283: "Masiwang",
+	3377: "Maskelynes",
+	4208: "Maskoy Pidgin",
+	4480: "Maslam",
+	4144: "Masmaje",
+	4109: "Massalat",
+	4547: "Massep",
+	4498: "Matagalpa",
+	4158: "Matal",
+	7323: "Matbat",
+	4197: "Matengo",
+	4414: "Matepi",
+	4071: "Matigsalug Manobo",
+	4640: "Matipuhy",
+	4145: "Mato",
+	938:  "Mato Grosso Arára",
+	4497: "Mator",
+	7578: "Mator-Taygi-Karagas",
+	4083: "Matsés",
+	4532: "Mattole",
+	2710: "Matu Chin",
+	4255: "Matukar",
+	4198: "Matumbi",
+	6188: "Matya Samo",
+	4401: "Matís",
+	4392: "Maung",
+	3995: "Mauritian Sign Language",
+	4212: "Mauwake",
+	4100: "Mawa (Chad)",
+	7087: "Mawa (Nigeria)",
+	4254: "Mawak",
+	4103: "Mawan",
+	4649: "Mawayana",
+	4274: "Mawchi",
+	4186: "Mawes",
+	4063: "Maxakalí",
+	4590: "Maxi Gbe",
+	6264: "Maya Samo",
+	7328: "Mayaguduna",
+	289:  "Mayan languages",
+	7464: "Mayangna",
+	4606: "Mayeka",
+	4359: "Maykulan",
+	4175: "Mayo",
+	4115: "Mayogo",
+	2850: "Mayoyao Ifugao",
+	2025: "Mazagway",
+	7807: "Mazaltepec Zapotec",
+	4639: "Mazanderani",
+	6960: "Mazatlán Mazatec",
+	4637: "Mazatlán Mixe",
+	4153: "Mba",
+	6939: "Mbabaram",
+	4117: "Mbala",
+	3927: "Mbalanhu",
+	7770: "Mbandja",
+	4585: "Mbangala",
+	4189: "Mbangi",
+	7758: "Mbangwe",
+	4540: "Mbara (Australia)",
+	4395: "Mbara (Chad)",
+	7766: "Mbariman-Gudhinma",
+	4116: "Mbati",
+	2613: "Mbato",
+	4605: "Mbay",
+	4165: "Mbe",
+	4495: "Mbe'",
+	4421: "Mbelime",
+	4120: "Mbere",
+	7763: "Mbesa",
+	4066: "Mbo (Cameroon)",
+	7767: "Mbo (Democratic Republic of Congo)",
+	4371: "Mboi",
+	4121: "Mboko",
+	4118: "Mbole",
+	7306: "Mbonga",
+	1130: "Mbongno",
+	4123: "Mbosi",
+	4593: "Mbowe",
+	4271: "Mbre",
+	4512: "Mbu'",
+	7308: "Mbudum",
+	4204: "Mbugu",
+	4200: "Mbugwe",
+	4412: "Mbuko",
+	4222: "Mbukushu",
+	4343: "Mbula",
+	4072: "Mbula-Bwazza",
+	4296: "Mbule",
+	4073: "Mbulungish",
+	4107: "Mbum",
+	4088: "Mbunda",
+	4199: "Mbunga",
+	1007: "Mburku",
+	4171: "Mbwela",
+	2588: "Mbyá Guaraní",
+	4616: "Me'en",
+	4133: "Mea",
+	4257: "Medebur",
+	4514: "Media Lengua",
+	4576: "Mediak",
+	7309: "Median",
+	4513: "Mednyj Aleut",
+	1559: "Medumba",
+	4160: "Mefele",
+	4132: "Megam",
+	5797: "Megleno Romanian",
+	5057: "Mehek",
+	4325: "Mehináku",
+	2406: "Mehri",
+	4137: "Mekeo",
+	4539: "Mekmek",
+	4465: "Mekwei",
+	4583: "Mele-Fila",
+	4174: "Melo",
+	4130: "Melpa",
+	4076: "Memoni",
+	7270: "Mendalam Kayan",
+	4154: "Mendankwe-Nkwen",
+	269:  "Mende",
+	5970: "Mende (Papua New Guinea)",
+	7311: "Mengaka",
+	4131: "Mengen",
+	4097: "Mengisa",
+	4127: "Menka",
+	4150: "Menominee",
+	4574: "Mentawai",
+	4095: "Menya",
+	4552: "Meoswar",
+	4360: "Mer",
+	4591: "Meramera",
+	3903: "Merei",
+	4142: "Merey",
+	6817: "Meriam",
+	4447: "Merlav",
+	7321: "Meroitic",
+	4143: "Meru",
+	7148: "Merwari",
+	2965: "Mesaka",
+	796:  "Mescalero-Chiricahua Apache",
+	4086: "Mese",
+	5812: "Meskwaki",
+	7722: "Mesme",
+	4621: "Mesmes",
+	541:  "Mesopotamian Arabic",
+	4554: "Mesqan",
+	1742: "Messapic",
+	4190: "Meta'",
+	4600: "Metlatónoc Mixtec",
+	4502: "Mewari",
+	7160: "Mewati",
+	4169: "Mexican Sign Language",
+	4136: "Meyah",
+	5295: "Mezontla Popoloca",
+	5247: "Mezquital Otomi",
+	7750: "Mfinu",
+	4768: "Mfumte",
+	271:  "Mi'kmaq",
+	7686: "Miahuatlán Zapotec",
+	4226: "Miami",
+	4404: "Mian",
+	5415: "Miani",
+	1797: "Michif",
+	1738: "Michigamea",
+	4320: "Michoacán Mazahua",
+	4707: "Michoacán Nahuatl",
+	2053: "Mid Grand Valley Dani",
+	1194: "Mid-Southern Banda",
+	940:  "Middle Armenian",
+	7209: "Middle Breton",
+	1757: "Middle Cornish",
+	2783: "Middle Hittite",
+	5165: "Middle Korean (10th-16th cent.)",
+	2493: "Middle Low German",
+	7332: "Middle Mongolian",
+	5071: "Middle Newar",
+	4396: "Middle Watut",
+	7078: "Middle Welsh",
+	4135: "Midob",
+	4341: "Migaama",
+	4400: "Migabac",
+	3368: "Migum",
+	4588: "Miju-Mishmi",
+	4235: "Mikasuki",
+	7567: "Mili",
+	4303: "Miltu",
+	2894: "Miluk",
+	2899: "Milyan",
+	4355: "Min Bei Chinese",
+	1651: "Min Dong Chinese",
+	4663: "Min Nan Chinese",
+	1883: "Min Zhong Chinese",
+	2735: "Mina (Cameroon)",
+	4612: "Mina (India)",
+	2904: "Minaean",
+	272:  "Minangkabau",
+	4099: "Minanibai",
+	4542: "Minaveha",
+	2080: "Minderico",
+	4398: "Mindiri",
+	4283: "Mingang Doso",
+	7310: "Mingrelian",
+	2780: "Minica Huitoto",
+	7052: "Minidien",
+	6944: "Minigir",
+	5187: "Minoan",
+	4426: "Minokok",
+	4356: "Minriq",
+	4645: "Mintil",
+	7710: "Minz Zhuang",
+	7529: "Miqie",
+	287:  "Mirandese",
+	5688: "Miraya Bikol",
+	7811: "Mirgan",
+	4338: "Miriti",
+	4141: "Miriwung",
+	5455: "Mirpur Panjabi",
+	4263: "Miship",
+	4408: "Misima-Panaeati",
+	4442: "Mising",
+	7694: "Mitla Zapotec",
+	6950: "Mitlatongo Mixtec",
+	4573: "Mittu",
+	7761: "Mituku",
+	4399: "Miu",
+	6946: "Miwa",
+	2339: "Mixed Great Andamanese",
+	4157: "Mixifore",
+	4245: "Mixtepec Mixtec",
+	7795: "Mixtepec Zapotec",
+	4275: "Miya",
+	4538: "Miyako",
+	6103: "Miyobe",
+	4436: "Mlabri",
+	3837: "Mlahsö",
+	3306: "Mlap",
+	4308: "Mlomp",
+	4337: "Mmaala",
+	1098: "Mmen",
+	2372: "Mo'da",
+	5122: "Moabite",
+	4167: "Moba",
+	4368: "Mobilian",
+	635:  "Mobumrin Aizi",
+	3037: "Mobwa Karen",
+	5174: "Mochi",
+	5181: "Mochica",
+	4203: "Mocho",
+	4367: "Mocoví",
+	4582: "Modang",
+	4424: "Modole",
+	4545: "Moere",
+	4230: "Mofu-Gudur",
+	4210: "Mogholi",
+	4380: "Mogum",
+	4381: "Mohave",
+	280:  "Mohawk",
+	7361: "Mohegan-Pequot",
+	4382: "Moi (Congo)",
+	4592: "Moi (Indonesia)",
+	4284: "Moikodi",
+	4578: "Moingi",
+	7568: "Moji",
+	4429: "Mok",
+	4572: "Moken",
+	4170: "Mokerang",
+	4278: "Mokilese",
+	4281: "Moklen",
+	4280: "Mokole",
+	1379: "Mokpwe",
+	6954: "Moksela",
+	267:  "Moksha",
+	4057: "Molale",
+	5593: "Molbog",
+	281:  "Moldavian",
+	6969: "Moldova Sign Language",
+	1518: "Molengue",
+	4383: "Molima",
+	895:  "Molmo One",
+	7759: "Molo",
+	4471: "Molof",
+	4315: "Moloko",
+	6914: "Mom Jango",
+	4615: "Moma",
+	4484: "Momare",
+	2030: "Mombo Dogon",
+	4474: "Mombum",
+	4319: "Momina",
+	4415: "Momuna",
+	4362: "Mon",
+	274:  "Mon-Khmer languages",
+	4632: "Monastic Sign Language",
+	4972: "Mondropolon",
+	4345: "Mondé",
+	244:  "Mongo",
+	4195: "Mongol",
+	1528: "Mongolia Buriat",
+	282:  "Mongolian",
+	4477: "Mongolian Sign Language",
+	4370: "Mongondow",
+	4365: "Moni",
+	4455: "Mono (Cameroon)",
+	4349: "Mono (Democratic Republic of Congo)",
+	4489: "Mono (Solomon Islands)",
+	4357: "Mono (USA)",
+	4375: "Monom",
+	4903: "Monsang Naga",
+	4496: "Montol",
+	4589: "Monumbo",
+	4372: "Monzombo",
+	2619: "Moo",
+	1802: "Moose Cree",
+	4376: "Mopán Maya",
+	4377: "Mor (Bomberai Peninsula)",
+	4225: "Mor (Mor Islands)",
+	4466: "Moraid",
+	4631: "Morawa",
+	4804: "Morelos Nahuatl",
+	7318: "Morerebi",
+	4482: "Moresada",
+	4642: "Mori Atas",
+	7329: "Mori Bawah",
+	4105: "Morigi",
+	4155: "Morisyen",
+	4378: "Moro",
+	833:  "Moroccan Arabic",
+	7322: "Moroccan Sign Language",
+	4178: "Morokodo",
+	1053: "Morom",
+	4423: "Moronene",
+	4373: "Morori",
+	4450: "Morouas",
+	4446: "Mortlockese",
+	4179: "Moru",
+	4431: "Mosimo",
+	4577: "Mosiro",
+	4494: "Moskona",
+	283:  "Mossi",
+	4504: "Mota",
+	4314: "Motlav",
+	4146: "Motu",
+	4562: "Mouk-Aria",
+	3473: "Mountain Koiali",
+	3053: "Mouwase",
+	4641: "Movima",
+	2953: "Moyadan Itneg",
+	4910: "Moyon Naga",
+	4650: "Mozambican Sign Language",
+	4587: "Mozarabic",
+	4393: "Mpade",
+	7355: "Mpalitjanh",
+	4410: "Mpi",
+	4101: "Mpiemo",
+	4386: "Mpoto",
+	4548: "Mpotovoro",
+	4182: "Mpumpong",
+	7760: "Mpuono",
+	674:  "Mpur",
+	1741: "Mro-Khimi Chin",
+	4449: "Mru",
+	3499: "Mser",
+	868:  "Mt. Iraya Agta",
+	627:  "Mt. Iriga Agta",
+	4488: "Mualang",
+	6656: "Mubami",
+	4511: "Mubi",
+	7564: "Muda",
+	4558: "Mudbura",
+	2357: "Mudhili Gadaba",
+	6941: "Mudu Koraga",
+	6785: "Muduga",
+	774:  "Mufian",
+	4519: "Mugom",
+	1269: "Muinane",
+	4328: "Mukha-Dora",
+	4385: "Mukulu",
+	4173: "Mulaha",
+	4306: "Mulam",
+	2465: "Mulao",
+	4537: "Mulgi",
+	3453: "Mullu Kurumba",
+	4387: "Mullukmulluk",
+	6955: "Muluridyi",
+	3476: "Mum",
+	4638: "Mumuye",
+	4344: "Muna",
+	6841: "Munda",
+	285:  "Munda languages",
+	1304: "Mundabli",
+	4510: "Mundang",
+	4347: "Mundani",
+	6840: "Mundari",
+	4323: "Mundat",
+	4622: "Mundurukú",
+	4211: "Mungaka",
+	4492: "Munggui",
+	4406: "Mungkip",
+	4620: "Muniche",
+	4487: "Munit",
+	4350: "Munji",
+	6834: "Munsee",
+	4501: "Muong",
+	856:  "Muratayak",
+	4596: "Murik (Malaysia)",
+	4490: "Murik (Papua New Guinea)",
+	5739: "Murkim",
+	4524: "Murle",
+	4560: "Murrinh-Patha",
+	4530: "Mursi",
+	2801: "Murui Huitoto",
+	4432: "Murupi",
+	7765: "Muruwari",
+	4334: "Musak",
+	4326: "Musar",
+	6051: "Musasa",
+	4464: "Musey",
+	4515: "Musgu",
+	7305: "Mushungulu",
+	4517: "Musi",
+	4250: "Muskum",
+	6678: "Muslim Tat",
+	4479: "Musom",
+	2197: "Mussau-Emira",
+	4527: "Muthuvan",
+	6686: "Mutu",
+	4541: "Muya",
+	4529: "Muyang",
+	4623: "Muyuw",
+	7580: "Muzi",
+	4087: "Mvanip",
+	4586: "Mvuba",
+	6210: "Mwaghavul",
+	7072: "Mwali Comorian",
+	4366: "Mwan",
+	7099: "Mwani",
+	4555: "Mwatebu",
+	4559: "Mwera (Chimwera)",
+	4252: "Mwera (Nyasa)",
+	4571: "Mwimbi-Muthambi",
+	2499: "Mycenaean Greek",
+	4608: "Myene",
+	7577: "Mysian",
+	4900: "Mzieme Naga",
+	2492: "Mághdì",
+	4240: "Mískito",
+	4214: "Mócheno",
+	4570: "Mün Chin",
+	4516: "Mündü",
+	5443: "Māhārāṣṭri Prākrit",
+	478:  "N'Ko",
+	4775: "N/u",
+	4690: "Na",
+	4664: "Naaba",
+	4667: "Naasioi",
+	4346: "Naba",
+	4657: "Nabak",
+	4509: "Nabi",
+	4699: "Nachering",
+	4722: "Nadruvian",
+	4061: "Nadëb",
+	4767: "Nafaanra",
+	6139: "Nafi",
+	5085: "Nafri",
+	2993: "Nafusi",
+	4658: "Naga Pidgin",
+	4678: "Nagarchal",
+	5075: "Nage",
+	4789: "Nagumi",
+	4893: "Nahali",
+	4801: "Nahari",
+	291:  "Nahuatl languages",
+	1170: "Nai",
+	829:  "Najdi Arabic",
+	4656: "Naka'ela",
+	4865: "Nakai",
+	4817: "Nakame",
+	4660: "Nakanai",
+	4706: "Nakara",
+	4682: "Nake",
+	4156: "Naki",
+	4670: "Nakwi",
+	4881: "Nalca",
+	5013: "Nali",
+	4661: "Nalik",
+	4659: "Nalu",
+	7558: "Naluo Yi",
+	4895: "Nalögo",
+	4665: "Nama (Namibia)",
+	4919: "Nama (Papua New Guinea)",
+	4906: "Namakura",
+	4867: "Namat",
+	4708: "Nambo",
+	4912: "Nambya",
+	4934: "Namia",
+	5061: "Namiae",
+	4689: "Namibian Sign Language",
+	4652: "Namla",
+	4601: "Namo",
+	4915: "Namonuito",
+	1492: "Namosi-Naitasiri-Serua",
+	4920: "Namuyi",
+	2480: "Nanai",
+	4924: "Nancere",
+	4923: "Nande",
+	4830: "Nandi",
+	5899: "Nanerigé Sénoufo",
+	5114: "Nanga Dama Dogon",
+	4932: "Nankina",
+	1777: "Nanti",
+	4940: "Nanticoke",
+	595:  "Nanubae",
+	5637: "Napo Lowland Quechua",
+	4976: "Napu",
+	4966: "Nar Phu",
+	4984: "Nara",
+	4654: "Narak",
+	4987: "Narango",
+	5084: "Narau",
+	5762: "NariNari",
+	3946: "Narim",
+	4809: "Naro",
+	4990: "Narom",
+	7338: "Narragansett",
+	4671: "Narrinyeri",
+	4995: "Narua",
+	4938: "Narungga",
+	5019: "Nasal",
+	5060: "Nasarian",
+	5006: "Naskapi",
+	5029: "Natagaimas",
+	5034: "Natanzi",
+	659:  "Nataoran Amis",
+	4717: "Natchez",
+	5025: "Nateni",
+	5021: "Nathembo",
+	5022: "Natioro",
+	5030: "Natügu",
+	5073: "Nauete",
+	7586: "Naukan Yupik",
+	4709: "Nauna",
+	5069: "Nauo",
+	294:  "Nauru",
+	295:  "Navajo",
+	5017: "Navut",
+	5070: "Nawaru",
+	5063: "Nawathinehena",
+	4921: "Nawdm",
+	4669: "Nawuri",
+	5082: "Naxi",
+	4965: "Nayi",
+	5098: "Nayini",
+	4712: "Ncane",
+	4891: "Nchumbulu",
+	4946: "Nda'nda'",
+	2475: "Ndai",
+	4727: "Ndaka",
+	4711: "Ndaktup",
+	4724: "Ndali",
+	4729: "Ndam",
+	4726: "Ndamba",
+	4718: "Ndasa",
+	4720: "Ndau",
+	4744: "Nde-Gbite",
+	4721: "Nde-Nsele-Nta",
+	297:  "Ndebele, North",
+	296:  "Ndebele, South",
+	4907: "Ndemli",
+	2047: "Ndendeule",
+	4723: "Ndengereko",
+	2188: "Nding",
+	4731: "Ndo",
+	4737: "Ndobo",
+	4674: "Ndoe",
+	4740: "Ndogo",
+	4728: "Ndolo",
+	4979: "Ndom",
+	4732: "Ndombe",
+	4841: "Ndonde Hamba",
+	298:  "Ndonga",
+	4733: "Ndoola",
+	1965: "Ndrag'ngith",
+	4738: "Nduga",
+	4899: "Ndumu",
+	5041: "Ndunda",
+	4734: "Ndunga",
+	4736: "Ndut",
+	4851: "Ndyuka-Trio Pidgin",
+	7106: "Ndzwani Comorian",
+	293:  "Neapolitan",
+	4743: "Nedebang",
+	4746: "Nefamese",
+	1925: "Negerhollands",
+	7753: "Negeri Sembilan Malay",
+	4747: "Negidal",
+	5009: "Nehan",
+	4820: "Nek",
+	4862: "Nekgini",
+	4750: "Neko",
+	4751: "Neku",
+	4761: "Neme",
+	4752: "Nemi",
+	4980: "Nen",
+	747:  "Nend",
+	7617: "Nenets",
+	4753: "Nengone",
+	4759: "Neo",
+	4749: "Neo-Hittite",
+	301:  "Nepal Bhasa",
+	5010: "Nepalese Sign Language",
+	300:  "Nepali",
+	4970: "Nepali (individual language)",
+	3658: "Nepali Kurux",
+	4758: "Nete",
+	2984: "New Caledonian Javanese",
+	5111: "New Zealand Sign Language",
+	4762: "Neyo",
+	4763: "Nez Perce",
+	5023: "Ngaanyatjarra",
+	5076: "Ngad'a",
+	4852: "Ngadjunmaya",
+	3079: "Ngadjuri",
+	4927: "Ngaing",
+	4824: "Ngaju",
+	5037: "Ngala",
+	4821: "Ngalakan",
+	4778: "Ngalkbun",
+	6271: "Ngalum",
+	4898: "Ngam",
+	4692: "Ngamambo",
+	5827: "Ngambay",
+	4917: "Ngamini",
+	4679: "Ngamo",
+	4662: "Ngan'gityemerri",
+	4829: "Nganasan",
+	4818: "Ngandi",
+	4772: "Ngando (Central African Republic)",
+	5074: "Ngando (Democratic Republic of Congo)",
+	4926: "Ngandyera",
+	2505: "Ngangam",
+	5105: "Nganyaywana",
+	4681: "Ngarinman",
+	6837: "Ngarinyin",
+	4890: "Ngarla",
+	4989: "Ngarluma",
+	743:  "Ngas",
+	5003: "Ngasa",
+	4780: "Ngatik Men's Creole",
+	1756: "Ngawn Chin",
+	5081: "Ngawun",
+	5066: "Ngayawung",
+	7704: "Ngazidja Comorian",
+	4769: "Ngbaka",
+	4683: "Ngbaka Ma'bo",
+	4774: "Ngbaka Manza",
+	3014: "Ngbee",
+	4676: "Ngbinda",
+	5054: "Ngbundu",
+	610:  "Ngelima",
+	4773: "Ngemba",
+	4787: "Ngeq",
+	4935: "Ngete",
+	4687: "Nggem",
+	4791: "Nggwahyi",
+	4777: "Ngie",
+	4929: "Ngiemboon",
+	3042: "Ngile",
+	4937: "Ngindo",
+	4837: "Ngiti",
+	4776: "Ngizim",
+	3140: "Ngkâlmpw Kanum",
+	4983: "Ngom",
+	3017: "Ngomba",
+	4880: "Ngombale",
+	4905: "Ngombe (Central African Republic)",
+	4771: "Ngombe (Democratic Republic of Congo)",
+	4944: "Ngong",
+	4958: "Ngongo",
+	4782: "Ngoni",
+	5004: "Ngoshie",
+	4888: "Ngul",
+	4783: "Ngulu",
+	5056: "Nguluwan",
+	5042: "Ngumbi",
+	4730: "Ngundi",
+	5038: "Ngundu",
+	4793: "Ngungwel",
+	4694: "Ngura",
+	4784: "Ngurimi",
+	4996: "Ngurmbur",
+	5048: "Nguôn",
+	4790: "Ngwaba",
+	5065: "Ngwe",
+	4781: "Ngwo",
+	2637: "Ngäbere",
+	4794: "Nhanda",
+	7618: "Nhengatu",
+	4799: "Nhuwala",
+	302:  "Nias",
+	1574: "Nicaragua Creole English",
+	4713: "Nicaraguan Sign Language",
+	4819: "Niellim",
+	303:  "Niger-Kordofanian languages",
+	4636: "Nigeria Mambila",
+	2333: "Nigerian Fulfulde",
+	5322: "Nigerian Pidgin",
+	5005: "Nigerian Sign Language",
+	4887: "Nihali",
+	4823: "Nii",
+	4655: "Nijadali",
+	2363: "Niksek",
+	4826: "Nila",
+	4827: "Nilamba",
+	396:  "Nilo-Saharan languages",
+	4950: "Nimadi",
+	4911: "Nimanbur",
+	4913: "Nimbari",
+	4831: "Nimboran",
+	4832: "Nimi",
+	4835: "Nimo",
+	4918: "Nimoa",
+	5937: "Ninam",
+	5077: "Nindi",
+	4695: "Ningera",
+	5083: "Ninggerum",
+	4838: "Ningil",
+	4939: "Ningye",
+	4886: "Ninia Yali",
+	4828: "Ninzo",
+	4974: "Nipsan",
+	4850: "Nisa",
+	5020: "Nisenan",
+	4702: "Nisga'a",
+	7627: "Nisi (China)",
+	5046: "Niuafo'ou",
+	4870: "Niuatoputapu",
+	304:  "Niuean",
+	1595: "Nivaclé",
+	4845: "Njalgulgule",
+	5108: "Njebi",
+	4844: "Njen",
+	4849: "Njerep",
+	4854: "Njyem",
+	4871: "Nkami",
+	4868: "Nkangala",
+	4879: "Nkari",
+	2932: "Nkem-Nkum",
+	3277: "Nkhumbi",
+	4858: "Nkongho",
+	4869: "Nkonya",
+	4878: "Nkoroo",
+	4856: "Nkoya",
+	4685: "Nkukoli",
+	4877: "Nkutu",
+	4686: "Nnam",
+	486:  "No linguistic content",
+	2285: "Nobiin",
+	2358: "Nobonob",
+	4956: "Nocamán",
+	4840: "Nocte Naga",
+	307:  "Nogai",
+	4953: "Noiri",
+	4866: "Nokuku",
+	3801: "Nomaande",
+	4951: "Nomane",
+	4960: "Nomatsiguenga",
+	4952: "Nomu",
+	7716: "Nong Zhuang",
+	4954: "Nonuya",
+	4955: "Nooksack",
+	6065: "Noon",
+	4811: "Noone",
+	1877: "Nopala Chatino",
+	4985: "Noric",
+	4991: "Norn",
+	4993: "Norra",
+	308:  "Norse, Old",
+	2235: "North Alaskan Inupiatun",
+	4324: "North Ambrym",
+	292:  "North American Indian languages",
+	4873: "North Asmat",
+	7530: "North Awyu",
+	966:  "North Azerbaijani",
+	1017: "North Babar",
+	5616: "North Bolivian Quechua",
+	4755: "North Central Mixe",
+	3897: "North Efate",
+	2296: "North Fali",
+	2463: "North Giziga",
+	5636: "North Junín Quechua",
+	786:  "North Levantine Arabic",
+	4451: "North Marquesan",
+	954:  "North Mesopotamian Arabic",
+	4161: "North Mofu",
+	4051: "North Moluccan Malay",
+	3556: "North Muyu",
+	4930: "North Nuaulu",
+	4992: "North Picene",
+	5863: "North Slavey",
+	6309: "North Tairora",
+	6545: "North Tanna",
+	7043: "North Wahgi",
+	6835: "North Watut",
+	3302: "Northeast Kiwai",
+	4916: "Northeast Maidu",
+	578:  "Northeast Pashayi",
+	1995: "Northeastern Dinka",
+	5338: "Northeastern Pomo",
+	6677: "Northeastern Thai",
+	814:  "Northern Alta",
+	878:  "Northern Altai",
+	5807: "Northern Amami-Oshima",
+	1088: "Northern Bai",
+	1264: "Northern Betsimisaraka Malagasy",
+	3686: "Northern Binukidnon",
+	1002: "Northern Bobo Madaré",
+	5687: "Northern Bontok",
+	1846: "Northern Catanduanes Bikol",
+	5653: "Northern Conchucos Ancash Quechua",
+	1959: "Northern Dagara",
+	2060: "Northern Dong",
+	1801: "Northern East Cree",
+	2202: "Northern Emberá",
+	2445: "Northern Ghale",
+	2512: "Northern Gondi",
+	2373: "Northern Grebo",
+	2792: "Northern Guiyang Hmong",
+	2674: "Northern Haida",
+	2742: "Northern Hindko",
+	2720: "Northern Huishui Hmong",
+	4994: "Northern Kalapuya",
+	7334: "Northern Kankanay",
+	3659: "Northern Khmer",
+	3494: "Northern Kissi",
+	3398: "Northern Kurdish",
+	3969: "Northern Luri",
+	2725: "Northern Mashan Hmong",
+	7579: "Northern Muji",
+	7268: "Northern Nago",
+	4770: "Northern Ngbandi",
+	7534: "Northern Nisu",
+	5055: "Northern Nuni",
+	4815: "Northern Oaxaca Nahuatl",
+	1830: "Northern Ohlone",
+	5205: "Northern One",
+	5283: "Northern Paiute",
+	5451: "Northern Pame",
+	5307: "Northern Pashto",
+	5642: "Northern Pastaza Quichua",
+	5342: "Northern Pomo",
+	4705: "Northern Puebla Nahuatl",
+	5444: "Northern Pumi",
+	2677: "Northern Qiandong Miao",
+	1747: "Northern Qiang",
+	4933: "Northern Rengma Naga",
+	5769: "Northern Roglai",
+	5011: "Northern Sierra Miwok",
+	1220: "Northern Sorsoganon",
+	6181: "Northern Subanen",
+	6425: "Northern Tarahumara",
+	5027: "Northern Tepehuan",
+	4949: "Northern Thai",
+	6721: "Northern Tiwa",
+	7407: "Northern Tlaxiaco Mixtec",
+	6648: "Northern Toussian",
+	6465: "Northern Tujia",
+	6671: "Northern Tutchone",
+	6888: "Northern Uzbek",
+	7539: "Northern Yukaghir",
+	2236: "Northwest Alaska Inupiatun",
+	2629: "Northwest Gbaya",
+	4249: "Northwest Maidu",
+	4579: "Northwest Oaxaca Mixtec",
+	2481: "Northwest Pashayi",
+	2001: "Northwestern Dinka",
+	2278: "Northwestern Fars",
+	3212: "Northwestern Kolami",
+	5002: "Northwestern Nisu",
+	5148: "Northwestern Ojibwa",
+	6519: "Northwestern Tamang",
+	309:  "Norwegian",
+	306:  "Norwegian Bokmål",
+	305:  "Norwegian Nynorsk",
+	5007: "Norwegian Sign Language",
+	1250: "Notre",
+	4701: "Notsi",
+	5031: "Nottoway",
+	5072: "Nottoway-Meherrin",
+	4962: "Novial",
+	4964: "Noy",
+	844:  "Nsari",
+	5001: "Nsenga",
+	4999: "Nshi",
+	5018: "Nsongo",
+	1447: "Ntcham",
+	5026: "Ntomba",
+	976:  "Nubaca",
+	3150: "Nubi",
+	311:  "Nubian languages",
+	3552: "Nubri",
+	5052: "Nuer",
+	4942: "Nugunu (Australia)",
+	7467: "Nugunu (Cameroon)",
+	4948: "Nuk",
+	4069: "Nukak Makú",
+	3375: "Nukna",
+	5036: "Nukuini",
+	5050: "Nukumanu",
+	4872: "Nukuoro",
+	5051: "Nukuria",
+	4688: "Numana-Nunku-Gbantu-Numbu",
+	4957: "Numanggang",
+	5967: "Numbami",
+	6413: "Nume",
+	3172: "Numee",
+	5080: "Numidian",
+	5053: "Nung (Viet Nam)",
+	5040: "Nungali",
+	5058: "Nunggubuyu",
+	5719: "Nungu",
+	4967: "Nupbikha",
+	5049: "Nupe-Nupe-Tako",
+	5045: "Nusa Laut",
+	5039: "Nusu",
+	5044: "Nuu-chah-nulth",
+	5064: "Nyabwa",
+	4760: "Nyaheun",
+	1621: "Nyahkur",
+	5106: "Nyakyusa-Ngonde",
+	4885: "Nyali",
+	4904: "Nyam",
+	4894: "Nyamal",
+	4963: "Nyambo",
+	5068: "Nyamusa-Molo",
+	4567: "Nyamwanga",
+	314:  "Nyamwezi",
+	5095: "Nyaneka",
+	5097: "Nyang'i",
+	5094: "Nyanga",
+	5087: "Nyanga-li",
+	4931: "Nyangatom",
+	5086: "Nyangbo",
+	4945: "Nyangga",
+	4922: "Nyangumarta",
+	315:  "Nyankole",
+	7504: "Nyankpa",
+	5907: "Nyarafolo Senoufo",
+	5718: "Nyaturu",
+	5104: "Nyaw",
+	5101: "Nyawaygi",
+	4673: "Nyemba",
+	5089: "Nyengo",
+	4748: "Nyenkha",
+	5096: "Nyeu",
+	5092: "Nyigina",
+	5099: "Nyiha (Malawi)",
+	4822: "Nyiha (Tanzania)",
+	4876: "Nyika (Malawi and Zambia)",
+	4874: "Nyika (Tanzania)",
+	3843: "Nyindrou",
+	5091: "Nyindu",
+	4855: "Nyishi",
+	5062: "Nyokon",
+	5043: "Nyole",
+	4521: "Nyong",
+	5088: "Nyore",
+	316:  "Nyoro",
+	5103: "Nyulnyul",
+	5100: "Nyunga",
+	5102: "Nyungwe",
+	7561: "Nyâlayu",
+	5113: "Nzakambay",
+	5109: "Nzakara",
+	4839: "Nzanyi",
+	317:  "Nzima",
+	4754: "Ná-Meo",
+	4745: "Nêlêmwa-Nixumwak",
+	2804: "Nüpode Huitoto",
+	7340: "O'chi'chi'",
+	6755: "O'du",
+	1588: "Obanliku",
+	5119: "Obispeño",
+	5121: "Oblo",
+	5123: "Obo Manobo",
+	602:  "Obokuitai",
+	753:  "Obolo",
+	5126: "Obulom",
+	5127: "Ocaina",
+	318:  "Occitan",
+	4229: "Ocotepec Mixtec",
+	7676: "Ocotlán Zapotec",
+	5132: "Od",
+	1141: "Odiai",
+	3332: "Odoodee",
+	5134: "Odual",
+	5131: "Odut",
+	5221: "Ofayé",
+	19:   "Official Aramaic (700-300 BCE)",
+	5135: "Ofo",
+	5139: "Ogbah",
+	5138: "Ogbia",
+	5141: "Ogbogolo",
+	5143: "Ogbronuagum",
+	2226: "Ogea",
+	5146: "Oirata",
+	319:  "Ojibwa",
+	1673: "Ojitlán Chinantec",
+	5155: "Okanagan",
+	5166: "Oki-No-Erabu",
+	5161: "Okiek",
+	5169: "Oko-Eni-Osayen",
+	5162: "Oko-Juwoi",
+	5156: "Okobo",
+	5157: "Okodia",
+	3497: "Okolod",
+	5216: "Okpamheri",
+	5172: "Okpe (Northwestern Edo)",
+	5158: "Okpe (Southwestern Edo)",
+	5218: "Oksapmin",
+	5170: "Oku",
+	5117: "Old Aramaic (up to 700 BCE)",
+	5118: "Old Avar",
+	5125: "Old Breton",
+	5124: "Old Burmese",
+	5128: "Old Chinese",
+	5129: "Old Cornish",
+	5133: "Old Dutch",
+	5136: "Old Frisian",
+	5140: "Old Georgian",
+	5144: "Old Hittite",
+	5145: "Old Hungarian",
+	5151: "Old Japanese",
+	5164: "Old Kentish Sign Language",
+	5167: "Old Korean (3rd-9th cent.)",
+	5189: "Old Manipuri",
+	5190: "Old Marathi",
+	5194: "Old Mon",
+	5209: "Old Nubian",
+	5215: "Old Ossetic",
+	5233: "Old Russian",
+	5244: "Old Saxon",
+	5241: "Old Spanish",
+	5260: "Old Tamil",
+	5245: "Old Tibetan",
+	5249: "Old Turkish",
+	5265: "Old Uighur",
+	5269: "Old Welsh",
+	5175: "Olekha",
+	5198: "Olo",
+	5176: "Oloma",
+	5178: "Olrat",
+	4009: "Olu'bo",
+	2874: "Olulumo-Ikom",
+	5426: "Oluta Popoluca",
+	5183: "Omagua",
+	5179: "Omaha-Ponca",
+	551:  "Omani Arabic",
+	4064: "Ombamba",
+	5186: "Ombo",
+	5182: "Omejes",
+	4810: "Ometepec Nahuatl",
+	5184: "Omi",
+	5185: "Omok",
+	5191: "Omotik",
+	5192: "Omurano",
+	5195: "Ona",
+	5197: "Oneida",
+	5212: "Ong",
+	5199: "Onin",
+	5210: "Onin Based Pidgin",
+	5200: "Onjob",
+	5206: "Ono",
+	5202: "Onobasulu",
+	5203: "Onondaga",
+	5207: "Ontenu",
+	5153: "Ontong Java",
+	5214: "Oorlams",
+	5219: "Opao",
+	5220: "Opata",
+	3824: "Opuuo",
+	5227: "Orang Kanaq",
+	5230: "Orang Seletar",
+	5882: "Oraon Sadri",
+	5224: "Orejón",
+	5225: "Oring",
+	320:  "Oriya",
+	5236: "Oriya (individual language)",
+	4892: "Orizaba Nahuatl",
+	5223: "Orma",
+	5237: "Ormu",
+	5232: "Ormuri",
+	5235: "Oro",
+	5234: "Oro Win",
+	5116: "Oroch",
+	5222: "Oroha",
+	5115: "Orok",
+	5171: "Orokaiva",
+	1059: "Oroko",
+	5228: "Orokolo",
+	321:  "Oromo",
+	5226: "Oroqen",
+	1332: "Orowe",
+	5229: "Oruma",
+	6866: "Orya",
+	322:  "Osage",
+	5242: "Osatu",
+	5238: "Oscan",
+	5239: "Osing",
+	5240: "Ososo",
+	323:  "Ossetian",
+	5246: "Ot Danum",
+	6874: "Otank",
+	5248: "Oti",
+	325:  "Otomian languages",
+	5254: "Otoro",
+	5258: "Ottawa",
+	3957: "Otuho",
+	5257: "Otuke",
+	5266: "Ouma",
+	5264: "Oune",
+	6192: "Owa",
+	7153: "Owenia",
+	5268: "Owiniga",
+	5270: "Oy",
+	5273: "Oya'oya",
+	5271: "Oyda",
+	7687: "Ozolotepec Zapotec",
+	1679: "Ozumacín Chinantec",
+	5329: "Pa Di",
+	5514: "Pa'a",
+	1237: "Pa'o Karen",
+	5364: "Pa-Hng",
+	2085: "Paakantyi",
+	5437: "Paama",
+	5964: "Paasaal",
+	5324: "Pacahuara",
+	5638: "Pacaraos Quechua",
+	2203: "Pacific Gulf Yupik",
+	5276: "Pacoh",
+	5331: "Padoe",
+	5405: "Paekche",
+	5361: "Paelignian",
+	5358: "Pagi",
+	5278: "Pagibete",
+	5363: "Pagu",
+	789:  "Pahanan Agta",
+	5373: "Pahari-Potwari",
+	3827: "Pahi",
+	5376: "Pahlavani",
+	328:  "Pahlavi",
+	5557: "Pai Tavytera",
+	5523: "Paicî",
+	5502: "Paipai",
+	5320: "Paite Chin",
+	5594: "Paiwan",
+	5406: "Pak-Tong",
+	5408: "Pakanha",
+	5288: "Pakaásnovos",
+	5412: "Pakistan Sign Language",
+	5414: "Paku",
+	3039: "Paku Karen",
+	530:  "Pal",
+	5428: "Palaic",
+	5429: "Palaka Senoufo",
+	1780: "Palantla Chinantec",
+	332:  "Palauan",
+	5425: "Palenquero",
+	337:  "Pali",
+	5432: "Palikúr",
+	5315: "Paliyan",
+	5440: "Pallanganmiddang",
+	2273: "Palor",
+	5427: "Palpa",
+	5419: "Palu'e",
+	5436: "Paluan",
+	5439: "Palumata",
+	1345: "Palya Bareli",
+	5449: "Pam",
+	5438: "Pambia",
+	5446: "Pamlico",
+	5442: "Pamona",
+	2693: "Pamosu",
+	329:  "Pampanga",
+	876:  "Pamplona Atta",
+	5472: "Pana (Burkina Faso)",
+	5481: "Pana (Central African Republic)",
+	3991: "Panamanian Sign Language",
+	5285: "Panamint",
+	5325: "Panang",
+	5651: "Panao Huánuco Quechua",
+	3505: "Panará",
+	5547: "Panasuan",
+	5591: "Panawa",
+	5471: "Pancana",
+	6352: "Panchpargania",
+	1211: "Pande",
+	327:  "Pangasinan",
+	5362: "Pangseng",
+	6031: "Pangutaran Sama",
+	5304: "Pangwa",
+	5357: "Pangwali",
+	5473: "Panim",
+	5316: "Paniya",
+	5290: "Pankararé",
+	5292: "Pankararú",
+	5407: "Pankhu",
+	5462: "Pannei",
+	4435: "Pano",
+	3425: "Panoan Katukína",
+	5470: "Panobo",
+	5478: "Panytyima",
+	5500: "Pao",
+	6569: "Papantla Totonac",
+	5506: "Papapana",
+	2078: "Papar",
+	5286: "Papasena",
+	5302: "Papel",
+	5501: "Papi",
+	331:  "Papiamento",
+	5287: "Papitalai",
+	5513: "Papora",
+	5458: "Papuan Malay",
+	326:  "Papuan languages",
+	5505: "Papuma",
+	5606: "Para Naga",
+	5517: "Parachi",
+	2582: "Paraguayan Guaraní",
+	5602: "Paraguayan Sign Language",
+	5282: "Parakanã",
+	5520: "Paranan",
+	5279: "Paranawát",
+	5297: "Paraujano",
+	5524: "Parauk",
+	5534: "Parawen",
+	5317: "Pardhan",
+	5321: "Pardhi",
+	5512: "Pare",
+	5275: "Parecís",
+	5319: "Parenga",
+	3619: "Parkari Koli",
+	5299: "Parkwa",
+	5528: "Parsi",
+	5518: "Parsi-Dari",
+	7362: "Parthian",
+	5284: "Parya",
+	501:  "Pará Arára",
+	2609: "Pará Gavião",
+	5550: "Pasi",
+	7453: "Pass Valley Yali",
+	5294: "Patamona",
+	5560: "Patani",
+	5558: "Pataxó Hã-Ha-Hãe",
+	5562: "Patep",
+	5568: "Pathiya",
+	2426: "Patpatar",
+	3727: "Pattani",
+	4151: "Pattani Malay",
+	5421: "Paulohi",
+	5277: "Paumarí",
+	5467: "Paunaka",
+	1087: "Pauri Bareli",
+	5546: "Pauserna",
+	5590: "Pawaia",
+	5289: "Pawnee",
+	5452: "Paynamar",
+	5281: "Pe",
+	5311: "Pear",
+	5291: "Pech",
+	7351: "Pecheneg",
+	5354: "Peere",
+	5509: "Pei",
+	5344: "Pekal",
+	1519: "Pela",
+	859:  "Pele-Ata",
+	5508: "Pelende",
+	767:  "Pemon",
+	5542: "Penang Sign Language",
+	5343: "Penchal",
+	6833: "Pendau",
+	5339: "Pengo",
+	5328: "Pennsylvania German",
+	5465: "Penrhyn",
+	5567: "Pentlatch",
+	7031: "Perai",
+	5334: "Peranakan Indonesian",
+	4535: "Peripheral Mongolian",
+	5392: "Pero",
+	334:  "Persian",
+	5539: "Persian Sign Language",
+	333:  "Persian, Old (ca.600-400 B.C.)",
+	5525: "Peruvian Sign Language",
+	7787: "Petapa Zapotec",
+	5350: "Petats",
+	5351: "Petjo",
+	4236: "Peñoles Mixtec",
+	5355: "Pfaelzisch",
+	5532: "Phai",
+	5368: "Phake",
+	7604: "Phala",
+	5369: "Phalura",
+	5372: "Phana'",
+	5377: "Phangduwali",
+	5345: "Phende",
+	5549: "Philippine Sign Language",
+	335:  "Philippine languages",
+	5370: "Phimbi",
+	336:  "Phoenician",
+	7606: "Phola",
+	7528: "Pholo",
+	4969: "Phom Naga",
+	5479: "Phong-Kniang",
+	3325: "Phrae Pwo Karen",
+	7353: "Phrygian",
+	5374: "Phu Thai",
+	5375: "Phuan",
+	5365: "Phudagi",
+	5575: "Phuie",
+	5367: "Phukha",
+	7608: "Phuma",
+	5371: "Phunoi",
+	5366: "Phuong",
+	7611: "Phupa",
+	7607: "Phupha",
+	7612: "Phuza",
+	5563: "Piamatsina",
+	5390: "Piame",
+	5391: "Piapoco",
+	5381: "Piaroa",
+	5313: "Picard",
+	1787: "Pichis Ashéninka",
+	7354: "Pictish",
+	1946: "Pidgin Delaware",
+	5453: "Piemontese",
+	5387: "Pijao",
+	5401: "Pije",
+	5394: "Pijin",
+	5420: "Pilagá",
+	5397: "Pileni",
+	5378: "Pima Bajo",
+	5398: "Pimbwe",
+	5469: "Pinai-Hagahai",
+	5383: "Pingelapese",
+	5386: "Pini",
+	5477: "Pinigura",
+	5380: "Pinji",
+	4238: "Pinotepa Nacional Mixtec",
+	5559: "Pintiini",
+	5396: "Pintupi-Luritja",
+	5480: "Pinyin",
+	5504: "Pipil",
+	4618: "Pirahã",
+	5393: "Piratapuyo",
+	1524: "Pirlatapa",
+	5382: "Piro",
+	5510: "Piru",
+	5384: "Pisabo",
+	6590: "Pisaflores Tepehua",
+	5556: "Piscataway",
+	7363: "Pisidian",
+	5385: "Pitcairn-Norfolk",
+	5984: "Pite Sami",
+	5323: "Piti",
+	5402: "Pitjantjatjara",
+	5395: "Pitta Pitta",
+	5399: "Piu",
+	5400: "Piya-Kwonci",
+	1800: "Plains Cree",
+	5540: "Plains Indian Sign Language",
+	5456: "Plains Miwok",
+	3557: "Plapo Krumen",
+	5431: "Plateau Malagasy",
+	5332: "Plautdietsch",
+	2520: "Playero",
+	5308: "Pnar",
+	4973: "Pochuri Naga",
+	7359: "Pochutec",
+	5330: "Podena",
+	5499: "Pogolo",
+	339:  "Pohnpeian",
+	5489: "Pokangá",
+	5485: "Poke",
+	5404: "Pokomo",
+	5498: "Polabian",
+	5418: "Polari",
+	5422: "Polci",
+	338:  "Polish",
+	5548: "Polish Sign Language",
+	5416: "Polonombauk",
+	5450: "Pom",
+	5448: "Pomo",
+	4698: "Ponam",
+	5483: "Ponares",
+	5464: "Pongu",
+	5474: "Ponosakan",
+	5475: "Pontic",
+	4968: "Ponyo-Gongwang Naga",
+	2972: "Popti'",
+	5482: "Poqomam",
+	5487: "Poqomchi'",
+	5522: "Porohanon",
+	5555: "Port Sandwich",
+	5566: "Port Vato",
+	340:  "Portuguese",
+	5551: "Portuguese Sign Language",
+	5495: "Potawatomi",
+	5486: "Potiguára",
+	2392: "Pottangi Ollar Gadaba",
+	5457: "Poumei Naga",
+	1544: "Pouye",
+	5596: "Powari",
+	5389: "Powhatan",
+	5601: "Poyanáwa",
+	341:  "Prakrit languages",
+	5527: "Prasuni",
+	5536: "Pray 3",
+	5360: "Primitive Irish",
+	5519: "Principense",
+	342:  "Provençal, Old (to 1500)",
+	5537: "Providencia Sign Language",
+	5521: "Prussian",
+	3605: "Psikye",
+	5578: "Pu Ko",
+	1788: "Pu-Xian Chinese",
+	5587: "Puare",
+	872:  "Pudtol Atta",
+	5573: "Puelche",
+	5545: "Puerto Rican Sign Language",
+	4975: "Puimei Naga",
+	5576: "Puinave",
+	5410: "Pukapuka",
+	2321: "Pulaar",
+	5581: "Pulabu",
+	2324: "Pular",
+	5586: "Puluwatese",
+	5579: "Puma",
+	7357: "Pumpokol",
+	7455: "Pumé",
+	5572: "Punan Aput",
+	5460: "Punan Bah-Biau",
+	5468: "Punan Batu 1",
+	5574: "Punan Merah",
+	5571: "Punan Merap",
+	5577: "Punan Tubu",
+	7364: "Punic",
+	330:  "Punjabi",
+	5655: "Puno Quechua",
+	5585: "Punu",
+	5580: "Puoc",
+	5582: "Puquina",
+	5533: "Puragi",
+	2819: "Purari",
+	6658: "Purepecha",
+	5530: "Puri",
+	5535: "Purik",
+	5588: "Purisimeño",
+	6212: "Puroik",
+	5583: "Puruborá",
+	5570: "Purum",
+	5589: "Purum Naga",
+	343:  "Pushto",
+	4162: "Putai",
+	5584: "Putoh",
+	592:  "Putukwam",
+	7365: "Puyo",
+	7360: "Puyo-Paekche",
+	5603: "Puyuma",
+	5441: "Pwaamei",
+	5492: "Pwapwa",
+	3321: "Pwo Eastern Karen",
+	5597: "Pwo Northern Karen",
+	5595: "Pwo Western Karen",
+	5326: "Pyapun",
+	5599: "Pye Krumen",
+	5605: "Pyen",
+	5309: "Pyu",
+	5604: "Pyu (Myanmar)",
+	5293: "Páez",
+	5353: "Pááfang",
+	3878: "Päri",
+	5349: "Pémono",
+	3906: "Pévé",
+	5409: "Pökoot",
+	3307: "Q'anjob'al",
+	3736: "Qabiao",
+	1561: "Qaqet",
+	5656: "Qashqa'i",
+	7367: "Qatabanian",
+	2547: "Qau",
+	697:  "Qawasqar",
+	7575: "Qila Muji",
+	630:  "Qimant",
+	7809: "Qiubei Zhuang",
+	5607: "Quapaw",
+	2280: "Quebec Sign Language",
+	7647: "Quechan",
+	344:  "Quechua",
+	5662: "Quenya",
+	5253: "Querétaro Otomi",
+	5598: "Quetzaltepec Mixe",
+	5641: "Queyu",
+	7792: "Quiavicuzas Zapotec",
+	5614: "Quileute",
+	5618: "Quinault",
+	5620: "Quinqui",
+	7828: "Quioquitani-Quierí Zapotec",
+	1675: "Quiotepec Chinantec",
+	5663: "Quiripi",
+	5670: "Rabha",
+	5667: "Rade",
+	7374: "Raetic",
+	5685: "Rahambuu",
+	4420: "Rajah Kabunsuwan Manobo",
+	345:  "Rajasthani",
+	5725: "Rajbanshi",
+	5724: "Raji",
+	5723: "Rajong",
+	2548: "Rajput Garasia",
+	5728: "Rakahanga-Manihiki",
+	5729: "Rakhine",
+	5673: "Ralte",
+	5732: "Rama",
+	5671: "Ramoaaina",
+	3327: "Ramopa",
+	3863: "Rampi",
+	6433: "Rana Tharu",
+	5683: "Rang",
+	5707: "Rangkas",
+	5759: "Ranglong",
+	5731: "Rangpuri",
+	5676: "Rao",
+	5684: "Rapa",
+	346:  "Rapanui",
+	3696: "Rapoisi",
+	5777: "Rapting",
+	3968: "Rara Bakati'",
+	347:  "Rarotongan",
+	5666: "Rasawa",
+	1432: "Ratagnon",
+	5785: "Ratahan",
+	5787: "Rathawi",
+	1114: "Rathwi Bareli",
+	5680: "Raute",
+	7497: "Ravula",
+	5805: "Rawa",
+	5682: "Rawang",
+	3060: "Rawat",
+	7032: "Rawngtu Chin",
+	5802: "Rawo",
+	7781: "Rayón Zoque",
+	5679: "Razajerdi",
+	2462: "Red Gelao",
+	877:  "Reel",
+	5697: "Rejang",
+	5694: "Rejang Kayan",
+	5696: "Reli",
+	1321: "Rema",
+	5733: "Rembarunga",
+	5693: "Rembong",
+	5699: "Remo",
+	623:  "Remontado Dumagat",
+	5746: "Rempi",
+	3874: "Remun",
+	5698: "Rendille",
+	5700: "Rengao",
+	4361: "Rennell-Bellona",
+	5782: "Rennellese Sign Language",
+	5776: "Repanbitip",
+	5701: "Rer Bare",
+	5692: "Rerau",
+	5359: "Rerep",
+	5702: "Reshe",
+	5709: "Resígaro",
+	5703: "Retta",
+	5704: "Reyesano",
+	5714: "Riang (India)",
+	5717: "Riang (Myanmar)",
+	5675: "Riantana",
+	5720: "Ribun",
+	5715: "Rien",
+	5727: "Rikbaktsa",
+	1433: "Rinconada Bikol",
+	7689: "Rincón Zapotec",
+	5711: "Ringgou",
+	5778: "Ririo",
+	5721: "Ritarungo",
+	5722: "Riung",
+	6069: "Riverain Sango",
+	5766: "Rogo",
+	5712: "Rohingya",
+	5743: "Roma",
+	5708: "Romagnol",
+	5753: "Romam",
+	348:  "Romance languages",
+	351:  "Romanian",
+	5748: "Romanian Sign Language",
+	5706: "Romano-Greek",
+	5781: "Romano-Serbian",
+	5751: "Romanova",
+	349:  "Romansh",
+	350:  "Romany",
+	5770: "Romblomanon",
+	5768: "Rombo",
+	5741: "Romkun",
+	1718: "Ron",
+	5758: "Ronga",
+	5773: "Rongga",
+	4691: "Rongmei Naga",
+	5761: "Rongpo",
+	5767: "Ronji",
+	5760: "Roon",
+	5705: "Roria",
+	5771: "Rotokas",
+	5786: "Rotuman",
+	5792: "Roviana",
+	5314: "Ruching Palaung",
+	5691: "Rudbari",
+	5794: "Rufiji",
+	5793: "Ruga",
+	2092: "Rukai",
+	5801: "Ruma",
+	5686: "Rumai Palaung",
+	3372: "Rumu",
+	5756: "Runa",
+	352:  "Rundi",
+	5774: "Runga",
+	5784: "Rungtu Chin",
+	2083: "Rungus",
+	5763: "Rungwa",
+	1533: "Russia Buriat",
+	353:  "Russian",
+	5783: "Russian Sign Language",
+	5790: "Rusyn",
+	5798: "Rutul",
+	5789: "Ruuli",
+	5757: "Ruund",
+	5803: "Rwa",
+	5690: "Réunion Creole French",
+	3544: "S'gaw Karen",
+	5824: "Sa",
+	785:  "Sa'a",
+	6079: "Sa'ban",
+	5862: "Sa'och",
+	5822: "Saafi-Saafi",
+	5677: "Saam",
+	3989: "Saamia",
+	6255: "Saaroa",
+	5810: "Saba",
+	7378: "Sabaean",
+	1420: "Sabah Bisaya",
+	4468: "Sabah Malay",
+	5813: "Sabanê",
+	6123: "Sabaot",
+	5848: "Sabine",
+	2810: "Sabu",
+	5841: "Sabüm",
+	5623: "Sacapulteco",
+	5859: "Sadri",
+	5998: "Saek",
+	6107: "Saep",
+	5814: "Safaliba",
+	809:  "Safeyoka",
+	5837: "Safwa",
+	5839: "Sagala",
+	6398: "Sagalla",
+	6178: "Saho",
+	5815: "Sahu",
+	576:  "Saidi Arabic",
+	537:  "Saint Lucian Creole French",
+	7396: "Saisiyat",
+	5987: "Sajalong",
+	5982: "Sajau Basap",
+	5857: "Sakachep",
+	6003: "Sakalava Malagasy",
+	6016: "Sakao",
+	6015: "Sakata",
+	5816: "Sake",
+	6002: "Sakirabiá",
+	5950: "Sala",
+	6040: "Salampasu",
+	6035: "Salar",
+	5931: "Salas",
+	5652: "Salasaca Highland Quichua",
+	6034: "Salchuq",
+	5821: "Saleman",
+	5831: "Saliba",
+	6032: "Salinan",
+	358:  "Salishan languages",
+	5887: "Sallands",
+	6030: "Salt-Yui",
+	3943: "Saluan",
+	6029: "Salumá",
+	2239: "Salvadoran Sign Language",
+	6081: "Sam",
+	6045: "Sama",
+	6052: "Samaritan",
+	359:  "Samaritan Aramaic",
+	6518: "Samarokena",
+	7623: "Samatao",
+	6059: "Samba",
+	1634: "Samba Daka",
+	4725: "Samba Leko",
+	7379: "Sambal",
+	6121: "Sambalpuri",
+	7187: "Sambe",
+	6177: "Samberigi",
+	5819: "Samburu",
+	6048: "Samei",
+	381:  "Sami languages",
+	383:  "Sami, Inari",
+	382:  "Sami, Lule",
+	380:  "Sami, Northern",
+	385:  "Sami, Skolt",
+	379:  "Sami, Southern",
+	6053: "Samo",
+	384:  "Samoan",
+	5929: "Samogitian",
+	6233: "Samosa",
+	5681: "Sampang",
+	6252: "Samre",
+	6199: "Samtao",
+	6057: "Samvedi",
+	7825: "San Agustín Mixtepec Zapotec",
+	7806: "San Baltazar Loxicha Zapotec",
+	1857: "San Blas Kuna",
+	2808: "San Dionisio Del Mar Huave",
+	5497: "San Felipe Otlaltepec Popoloca",
+	2787: "San Francisco Del Mar Huave",
+	4047: "San Francisco Matlatzinca",
+	4040: "San Jerónimo Tecóatl Mazatec",
+	5484: "San Juan Atzingo Popoloca",
+	4248: "San Juan Colorado Mixtec",
+	7675: "San Juan Guelavía Zapotec",
+	7404: "San Juan Teita Mixtec",
+	5511: "San Luís Temalacayuca Popoloca",
+	5430: "San Marcos Tlalcoyalco Popoloca",
+	6626: "San Martín Itunyoso Triqui",
+	5639: "San Martín Quechua",
+	2802: "San Mateo Del Mar Huave",
+	5855: "San Miguel Creole French",
+	4231: "San Miguel El Grande Mixtec",
+	7409: "San Miguel Piedras Mixtec",
+	965:  "San Pedro Amuzgos Amuzgo",
+	7788: "San Pedro Quiatoni Zapotec",
+	3646: "San Salvador Kongo",
+	7802: "San Vicente Coatlán Zapotec",
+	952:  "Sanaani Arabic",
+	5818: "Sanapaná",
+	354:  "Sandawe",
+	6066: "Sanga (Democratic Republic of Congo)",
+	7388: "Sanga (Nigeria)",
+	5856: "Sanggau",
+	6070: "Sangil",
+	6253: "Sangir",
+	5928: "Sangisari",
+	5924: "Sangkong",
+	5934: "Sanglechi",
+	355:  "Sango",
+	4998: "Sangtam Naga",
+	6075: "Sangu (Gabon)",
+	5842: "Sangu (Tanzania)",
+	7626: "Sani",
+	7631: "Sanie",
+	6082: "Saniyo-Hiyewe",
+	4462: "Sankaran Maninka",
+	6163: "Sansi",
+	360:  "Sanskrit",
+	5659: "Santa Ana de Tusi Pasco Quechua",
+	7826: "Santa Catarina Albarradas Zapotec",
+	5310: "Santa Inés Ahuatempan Popoloca",
+	7796: "Santa Inés Yatzechi Zapotec",
+	4122: "Santa Lucía Monteverde Mixtec",
+	2811: "Santa María Del Mar Huave",
+	4816: "Santa María La Alta Nahuatl",
+	7791: "Santa María Quiegolani Zapotec",
+	4627: "Santa María Zacatepec Mixtec",
+	1767: "Santa Teresa Cora",
+	362:  "Santali",
+	7800: "Santiago Xanica Zapotec",
+	5622: "Santiago del Estero Quichua",
+	7690: "Santo Domingo Albarradas Zapotec",
+	7394: "Sanumá",
+	6117: "Saparua",
+	3512: "Sapo",
+	6110: "Saponi",
+	6118: "Saposa",
+	6120: "Sapuan",
+	6106: "Sapé",
+	4566: "Sar",
+	6138: "Sara",
+	3440: "Sara Dunjo",
+	5852: "Sara Kaba",
+	3628: "Sara Kaba Deme",
+	3643: "Sara Kaba Náà",
+	6145: "Saramaccan",
+	1340: "Sarangani Blaan",
+	4070: "Sarangani Manobo",
+	7816: "Sarasira",
+	5820: "Saraveca",
+	394:  "Sardinian",
+	6141: "Sarikoli",
+	5872: "Sarli",
+	6148: "Sarsi",
+	5204: "Sartang",
+	6245: "Sarua",
+	5885: "Sarudu",
+	6135: "Saruga",
+	361:  "Sasak",
+	6256: "Sasaru",
+	5870: "Sassarese Sardinian",
+	6201: "Satawalese",
+	6195: "Saterfriesisch",
+	4049: "Sateré-Mawé",
+	5877: "Saudi Arabian Sign Language",
+	5554: "Sauraseni Prākrit",
+	5826: "Saurashtra",
+	6149: "Sauri",
+	4264: "Sauria Paharia",
+	5817: "Sause",
+	6164: "Sausi",
+	6221: "Savara",
+	5873: "Savi",
+	6222: "Savosavo",
+	6280: "Sawai",
+	6238: "Saweru",
+	5823: "Sawi",
+	6240: "Sawila",
+	6234: "Sawknah",
+	6258: "Saxwe Gbe",
+	5825: "Saya",
+	5494: "Sayula Popoluca",
+	365:  "Scots",
+	148:  "Scottish Gaelic",
+	7380: "Scythian",
+	2586: "Sea Island Creole English",
+	3168: "Seba",
+	5932: "Sebat Bet Gurage",
+	5850: "Seberuang",
+	5961: "Sebop",
+	6062: "Sebuyau",
+	5890: "Sechelt",
+	5909: "Secoya",
+	5891: "Sedang",
+	6714: "Sedoa",
+	6098: "Seeku",
+	5919: "Segai",
+	5894: "Segeju",
+	5833: "Seget",
+	5915: "Sehwi",
+	6161: "Seimat",
+	2696: "Seit-Kaitetu",
+	5898: "Sekani",
+	6011: "Sekapan",
+	6021: "Sekar",
+	6006: "Seke (Nepal)",
+	6001: "Seke (Vanuatu)",
+	6261: "Seki",
+	6019: "Seko Padang",
+	6010: "Seko Tengah",
+	3853: "Sekpele",
+	3244: "Selangor Sign Language",
+	6038: "Selaru",
+	6041: "Selayar",
+	6080: "Selee",
+	6112: "Selepet",
+	6251: "Selian",
+	367:  "Selkup",
+	6026: "Selungai Murut",
+	6239: "Seluwasan",
+	5888: "Semai",
+	5878: "Semandang",
+	6272: "Semaq Beri",
+	5844: "Sembakung Murut",
+	6270: "Semelai",
+	2254: "Semimi",
+	368:  "Semitic languages",
+	6167: "Semnam",
+	6060: "Semnani",
+	7382: "Sempan",
+	5895: "Sena",
+	5902: "Senara Sénoufo",
+	6265: "Senaya",
+	5897: "Sene",
+	5892: "Seneca",
+	5883: "Sened",
+	6275: "Sengele",
+	6078: "Senggi",
+	6111: "Sengo",
+	6179: "Sengseng",
+	5993: "Senhaja De Srair",
+	6068: "Sensi",
+	5905: "Sentani",
+	5910: "Senthang Chin",
+	6182: "Sentinel",
+	6105: "Sepa (Indonesia)",
+	6108: "Sepa (Papua New Guinea)",
+	2961: "Sepik Iwam",
+	6154: "Sera",
+	6013: "Seraiki",
+	363:  "Serbian",
+	2670: "Serbo-Croatian",
+	6226: "Sere",
+	395:  "Serer",
+	5896: "Seri",
+	6219: "Serili",
+	3496: "Seroa",
+	5903: "Serrano",
+	6273: "Seru",
+	6152: "Serua",
+	6143: "Serudung Murut",
+	5906: "Serui-Laut",
+	1807: "Seselwa Creole French",
+	6184: "Seta",
+	6191: "Setaman",
+	5835: "Seti",
+	6180: "Settla",
+	5152: "Severn Ojibwa",
+	5908: "Sewa Bay",
+	6274: "Seze",
+	5866: "Sha",
+	5869: "Shabak",
+	5832: "Shabo",
+	6155: "Shahmirzadi",
+	5947: "Shahrudi",
+	5936: "Shall-Zwall",
+	6124: "Shama-Sambuga",
+	7383: "Shamang",
+	3523: "Shambala",
+	371:  "Shan",
+	6235: "Shanenawa",
+	5948: "Shanga",
+	4081: "Sharanahua",
+	6176: "Shark Bay",
+	6237: "Sharwa",
+	5953: "Shasta",
+	5944: "Shatt",
+	6125: "Shau",
+	5996: "Shawnee",
+	5957: "She",
+	5955: "Shehri",
+	6242: "Shekhawati",
+	4384: "Shekkacho",
+	5940: "Sheko",
+	6186: "Shelta",
+	5889: "Shempire Senoufo",
+	5946: "Shendu",
+	5865: "Sheni",
+	1455: "Sherbro",
+	5881: "Sherdukpen",
+	7392: "Sherpa",
+	3296: "Sheshi Kham",
+	5951: "Shi",
+	6162: "Shihhi Arabic",
+	2576: "Shiki",
+	5945: "Shilluk",
+	5860: "Shina",
+	6067: "Shinabo",
+	5949: "Shipibo-Conibo",
+	6249: "Shixing",
+	6024: "Sholaga",
+	5966: "Shom Peng",
+	386:  "Shona",
+	1035: "Shoo-Minda-Nye",
+	1702: "Shor",
+	5942: "Shoshoni",
+	5941: "Shua",
+	5884: "Shuadit",
+	3034: "Shuar",
+	6208: "Shubi",
+	5921: "Shughni",
+	6197: "Shumashti",
+	5864: "Shumcho",
+	5952: "Shuswap",
+	3522: "Shuwa-Zamani",
+	5956: "Shwai",
+	5424: "Shwe Palaung",
+	6039: "Sialum",
+	5963: "Siamou",
+	6109: "Sian",
+	6074: "Siane",
+	6259: "Siang",
+	5992: "Siar-Lak",
+	4333: "Siawi",
+	4710: "Sibe",
+	5886: "Sibu Melanau",
+	6247: "Sicanian",
+	5867: "Sicel",
+	182:  "Sichuan Yi",
+	364:  "Sicilian",
+	6131: "Siculo Arabic",
+	372:  "Sidamo",
+	7381: "Sidetic",
+	2224: "Sie",
+	5933: "Sierra Leone Sign Language",
+	5015: "Sierra Negra Nahuatl",
+	7674: "Sierra de Juárez Zapotec",
+	6248: "Sighu",
+	370:  "Sign Languages",
+	6076: "Sihan",
+	5647: "Sihuas Ancash Quechua",
+	6005: "Sika",
+	6020: "Sikaiana",
+	6682: "Sikaritai",
+	5968: "Sikiana",
+	5971: "Sikkimese",
+	54:   "Siksika",
+	6004: "Sikule",
+	6037: "Sila",
+	4287: "Silacayoapan Mixtec",
+	5843: "Sileibi",
+	6276: "Silesian",
+	7166: "Silimo",
+	4273: "Siliput",
+	7390: "Silopi",
+	6200: "Silt'e",
+	5962: "Simaa",
+	5849: "Simba",
+	6047: "Simbali",
+	6043: "Simbari",
+	5828: "Simbo",
+	6061: "Simeku",
+	6054: "Simeulue",
+	6055: "Simte",
+	5975: "Sinagen",
+	6174: "Sinasina",
+	6063: "Sinaugoro",
+	5989: "Sindarin",
+	387:  "Sindhi",
+	5840: "Sindhi Bhil",
+	7412: "Sindihui Mixtec",
+	5925: "Singa",
+	6036: "Singapore Sign Language",
+	5927: "Singpho",
+	373:  "Sinhala",
+	7403: "Sinicahua Mixtec",
+	6012: "Sininkere",
+	375:  "Sino-Tibetan languages",
+	6083: "Sinsauru",
+	5745: "Sinte Romani",
+	6267: "Sinyar",
+	7384: "Sio",
+	6072: "Siona",
+	374:  "Siouan languages",
+	5617: "Sipacapense",
+	6230: "Sira",
+	2308: "Siraya",
+	7629: "Sirenik Yupik",
+	5973: "Siri",
+	6142: "Siriano",
+	6147: "Sirionó",
+	6153: "Sirmauri",
+	6158: "Siroi",
+	6023: "Sissala",
+	6169: "Sissano",
+	5974: "Siuslaw",
+	5979: "Sivandi",
+	5977: "Siwai",
+	5980: "Siwi",
+	685:  "Siwu",
+	1833: "Siyin Chin",
+	5997: "Skagit",
+	6223: "Skalvian",
+	6018: "Skepi Creole Dutch",
+	6017: "Skou",
+	104:  "Slave (Athapascan)",
+	376:  "Slavic languages",
+	377:  "Slovak",
+	6220: "Slovakian Sign Language",
+	378:  "Slovenian",
+	5913: "Small Flowery Miao",
+	3663: "Smärky Kanum",
+	6073: "Snohomish",
+	6086: "So (Democratic Republic of Congo)",
+	6171: "So'a",
+	6085: "Sobei",
+	1826: "Sochiapam Chinantec",
+	7342: "Soga",
+	389:  "Sogdian",
+	6091: "Soi",
+	6007: "Sok",
+	6092: "Sokoro",
+	7389: "Solano",
+	5851: "Soli",
+	506:  "Solong",
+	6093: "Solos",
+	6044: "Som",
+	390:  "Somali",
+	1272: "Somba-Siawari",
+	6097: "Somrai",
+	6056: "Somray",
+	3255: "Somyev",
+	7624: "Sonaga",
+	5938: "Sonde",
+	5926: "Songa",
+	6095: "Songe",
+	391:  "Songhai languages",
+	6094: "Songo",
+	6088: "Songomeno",
+	6087: "Songoora",
+	6090: "Sonha",
+	5972: "Sonia",
+	388:  "Soninke",
+	6100: "Sonsorol",
+	6388: "Soo",
+	6864: "Sop",
+	6133: "Soqotri",
+	6136: "Sora",
+	456:  "Sorbian languages",
+	110:  "Sorbian, Lower",
+	175:  "Sorbian, Upper",
+	5834: "Sori-Harengan",
+	6129: "Sorkhei",
+	6254: "Sorothaptic",
+	957:  "Sorsogon Ayta",
+	5876: "Sos Kundi",
+	3521: "Sota Kanum",
+	310:  "Sotho, Northern",
+	392:  "Sotho, Southern",
+	6130: "Sou",
+	5914: "South African Sign Language",
+	357:  "South American Indian languages",
+	929:  "South Awyu",
+	963:  "South Azerbaijani",
+	5613: "South Bolivian Quechua",
+	3933: "South Central Banda",
+	1983: "South Central Dinka",
+	2227: "South Efate",
+	2271: "South Fali",
+	2469: "South Giziga",
+	3907: "South Lembata",
+	668:  "South Levantine Arabic",
+	4422: "South Marquesan",
+	3566: "South Muyu",
+	5079: "South Nuaulu",
+	6122: "South Picene",
+	7386: "South Slavey",
+	5193: "South Tairora",
+	1789: "South Ucayali Ashéninka",
+	4102: "South Watut",
+	6077: "South West Bay",
+	6707: "Southeast Ambrym",
+	6906: "Southeast Babar",
+	2869: "Southeast Ijo",
+	5544: "Southeast Pashayi",
+	2024: "Southeastern Dinka",
+	7786: "Southeastern Ixtlán Zapotec",
+	4833: "Southeastern Kolami",
+	4603: "Southeastern Nochixtlán Mixtec",
+	5490: "Southeastern Pomo",
+	4971: "Southeastern Puebla Nahuatl",
+	6346: "Southeastern Tarahumara",
+	6194: "Southeastern Tepehuan",
+	626:  "Southern Alta",
+	474:  "Southern Altai",
+	733:  "Southern Amami-Oshima",
+	944:  "Southern Aymara",
+	1104: "Southern Bai",
+	1016: "Southern Balochi",
+	1566: "Southern Betsimisaraka Malagasy",
+	4507: "Southern Binukidnon",
+	1176: "Southern Birifor",
+	1507: "Southern Bobo Madaré",
+	5120: "Southern Bontok",
+	1594: "Southern Carrier",
+	1240: "Southern Catanduanes Bikol",
+	5654: "Southern Conchucos Ancash Quechua",
+	1952: "Southern Dagaare",
+	3383: "Southern Dong",
+	1799: "Southern East Cree",
+	2444: "Southern Ghale",
+	2437: "Southern Gondi",
+	2553: "Southern Grebo",
+	2733: "Southern Guiyang Hmong",
+	2663: "Southern Haida",
+	2736: "Southern Hindko",
+	6250: "Southern Kalapuya",
+	3524: "Southern Kalinga",
+	3540: "Southern Kisi",
+	3309: "Southern Kiwai",
+	5874: "Southern Kurdish",
+	7628: "Southern Lolopo",
+	4019: "Southern Luri",
+	6071: "Southern Ma'di",
+	2712: "Southern Mashan Hmong",
+	4354: "Southern Mnong",
+	7563: "Southern Muji",
+	4977: "Southern Nago",
+	4653: "Southern Nambikuára",
+	4693: "Southern Ngbandi",
+	4825: "Southern Nicobarese",
+	5000: "Southern Nisu",
+	4943: "Southern Nuni",
+	1829: "Southern Ohlone",
+	5243: "Southern One",
+	5459: "Southern Pame",
+	5306: "Southern Pashto",
+	5619: "Southern Pastaza Quechua",
+	5347: "Southern Pomo",
+	4242: "Southern Puebla Mixtec",
+	6027: "Southern Puget Sound Salish",
+	5445: "Southern Pumi",
+	2728: "Southern Qiandong Miao",
+	5658: "Southern Qiang",
+	4986: "Southern Rengma Naga",
+	7820: "Southern Rincon Zapotec",
+	5710: "Southern Roglai",
+	6156: "Southern Sama",
+	5830: "Southern Samo",
+	6000: "Southern Sierra Miwok",
+	6151: "Southern Sorsoganon",
+	3724: "Southern Subanen",
+	6099: "Southern Thai",
+	6460: "Southern Tiwa",
+	7046: "Southern Toussian",
+	6470: "Southern Tujia",
+	6332: "Southern Tutchone",
+	6889: "Southern Uzbek",
+	3978: "Southern Yamphu",
+	7655: "Southern Yukaghir",
+	2571: "Southwest Gbaya",
+	5433: "Southwest Palawano",
+	5543: "Southwest Pashayi",
+	5067: "Southwest Tanna",
+	6907: "Southwestern Bontok",
+	1991: "Southwestern Dinka",
+	2277: "Southwestern Fars",
+	2718: "Southwestern Guiyang Hmong",
+	2719: "Southwestern Huishui Hmong",
+	5016: "Southwestern Nisu",
+	6641: "Southwestern Tamang",
+	6730: "Southwestern Tarahumara",
+	6489: "Southwestern Tepehuan",
+	4134: "Southwestern Tlaxiaco Mixtec",
+	6243: "Sowa",
+	6101: "Sowanda",
+	6951: "Soyaltepec Mazatec",
+	6952: "Soyaltepec Mixtec",
+	393:  "Spanish",
+	6170: "Spanish Sign Language",
+	6119: "Spiti Bhoti",
+	6114: "Spokane",
+	6134: "Squamish",
+	480:  "Sranan Tongo",
+	5858: "Sri Lankan Creole Malay",
+	6132: "Sri Lankan Sign Language",
+	818:  "Standard Arabic",
+	2179: "Standard Estonian",
+	4022: "Standard Latvian",
+	7819: "Standard Malay",
+	6190: "Stellingwerfs",
+	5847: "Stod Bhoti",
+	6193: "Stoney",
+	6196: "Straits Salish",
+	6278: "Suabo",
+	5900: "Suarmin",
+	6236: "Suau",
+	6246: "Suba",
+	6157: "Suba-Simbiti",
+	7385: "Subi",
+	5845: "Subiya",
+	6211: "Subtiaba",
+	787:  "Sudanese Arabic",
+	5356: "Sudanese Creole Arabic",
+	6409: "Sudest",
+	7395: "Sudovian",
+	6205: "Suena",
+	5922: "Suga",
+	6206: "Suganga",
+	3716: "Sugut Dusun",
+	6229: "Sui",
+	6207: "Suki",
+	6203: "Suku",
+	398:  "Sukuma",
+	6262: "Sukur",
+	7821: "Sukurum",
+	6277: "Sula",
+	6202: "Sulka",
+	6140: "Sulod",
+	6127: "Suma",
+	5976: "Sumariup",
+	5978: "Sumau",
+	6058: "Sumbawa",
+	6213: "Sumbwa",
+	401:  "Sumerian",
+	5008: "Sumi Naga",
+	1831: "Sumtu Chin",
+	6165: "Sunam",
+	399:  "Sundanese",
+	6215: "Sunwar",
+	6266: "Suoy",
+	6115: "Supyire Senoufo",
+	6362: "Sur",
+	5836: "Surbakhal",
+	5923: "Surgujia",
+	6209: "Suri",
+	5918: "Surigaonon",
+	5991: "Surjapuri",
+	5935: "Sursurunga",
+	6244: "Suruahá",
+	5871: "Surubu",
+	6150: "Suruí",
+	4126: "Suruí Do Pará",
+	6128: "Susquehannock",
+	400:  "Susu",
+	6175: "Susuami",
+	5875: "Suundi",
+	6241: "Suwawa",
+	6214: "Suyá",
+	6216: "Svan",
+	6227: "Swabian",
+	402:  "Swahili",
+	6228: "Swahili (individual language)",
+	1832: "Swampy Cree",
+	397:  "Swati",
+	403:  "Swedish",
+	6232: "Swedish Sign Language",
+	6172: "Swiss-French Sign Language",
+	5920: "Swiss-German Sign Language",
+	6025: "Swiss-Italian Sign Language",
+	6102: "Swo",
+	5959: "Syenara Senoufo",
+	6263: "Sylheti",
+	404:  "Syriac",
+	6022: "Sáliba",
+	7735: "São Paulo Kaingáng",
+	1798: "Sãotomense",
+	5901: "Sìcìté Sénoufo",
+	6173: "Sô",
+	6345: "T'en",
+	544:  "Ta'izzi-Adeni Arabic",
+	6293: "Taabwa",
+	7691: "Tabaa Zapotec",
+	6326: "Tabaru",
+	1671: "Tabasco Chontal",
+	4796: "Tabasco Nahuatl",
+	7780: "Tabasco Zoque",
+	6282: "Tabassaran",
+	6544: "Tabla",
+	3427: "Tabo",
+	6773: "Tabriak",
+	7413: "Tacahua Mixtec",
+	6534: "Tacana",
+	5958: "Tachawit",
+	5943: "Tachelhit",
+	4001: "Tachoni",
+	2100: "Tadaksahak",
+	6372: "Tadyawan",
+	5764: "Tae'",
+	6331: "Tafi",
+	1128: "Tagabawa",
+	3362: "Tagakaulo",
+	4550: "Tagal Murut",
+	6420: "Tagalaka",
+	414:  "Tagalog",
+	5262: "Tagargrent",
+	6324: "Tagbanwa",
+	6315: "Tagbu",
+	6351: "Tagdal",
+	6407: "Tagin",
+	6418: "Tagish",
+	6287: "Tagoi",
+	6417: "Tagwana Senoufo",
+	6437: "Tahaggart Tamahaq",
+	405:  "Tahitian",
+	6435: "Tahltan",
+	6299: "Tai",
+	6761: "Tai Daeng",
+	1246: "Tai Dam",
+	6757: "Tai Do",
+	6723: "Tai Dón",
+	6421: "Tai Hang Tong",
+	6462: "Tai Hongjin",
+	6466: "Tai Laing",
+	6502: "Tai Loi",
+	6426: "Tai Long",
+	6524: "Tai Mène",
+	6354: "Tai Nüa",
+	6589: "Tai Pao",
+	6521: "Tai Thanh",
+	1865: "Tai Ya",
+	406:  "Tai languages",
+	2542: "Taiap",
+	780:  "Taikat",
+	617:  "Tainae",
+	6548: "Taino",
+	6776: "Tairuma",
+	1900: "Taita",
+	6651: "Taiwan Sign Language",
+	5337: "Taje",
+	413:  "Tajik",
+	516:  "Tajiki Arabic",
+	6360: "Tajio",
+	6463: "Tajuasohn",
+	6478: "Takelma",
+	6483: "Takestani",
+	6305: "Takia",
+	6488: "Takua",
+	4806: "Takuu",
+	6475: "Takwane",
+	6290: "Tal",
+	6289: "Tala",
+	6492: "Talaud",
+	6507: "Taliabu",
+	6356: "Talieng",
+	6495: "Talinga-Bwisi",
+	6503: "Talise",
+	6500: "Talodi",
+	6496: "Taloki",
+	6499: "Talondo'",
+	7632: "Talu",
+	2890: "Talur",
+	6509: "Talysh",
+	6510: "Tama (Chad)",
+	6383: "Tama (Colombia)",
+	6334: "Tamagario",
+	6522: "Taman (Indonesia)",
+	6338: "Taman (Myanmar)",
+	6533: "Tamanaku",
+	423:  "Tamashek",
+	6294: "Tamasheq",
+	6958: "Tamazola Mixtec",
+	6361: "Tambas",
+	7445: "Tambora",
+	6504: "Tambotalo",
+	3717: "Tambunan Dusun",
+	6532: "Tami",
+	407:  "Tamil",
+	6300: "Tamki",
+	6520: "Tamnim Citak",
+	3960: "Tampias Lobu",
+	6594: "Tampuan",
+	6587: "Tampulma",
+	6329: "Tanacross",
+	6339: "Tanahmerah",
+	6394: "Tanaina",
+	6595: "Tanapag",
+	6408: "Tandaganon",
+	6541: "Tandia",
+	6371: "Tandroy-Mahafaly Malagasy",
+	6555: "Tanema",
+	6291: "Tangale",
+	6553: "Tangchangya",
+	6404: "Tangga",
+	6415: "Tanggu",
+	4901: "Tangkhul Naga (India)",
+	5032: "Tangkhul Naga (Myanmar)",
+	6487: "Tangko",
+	7633: "Tanglang",
+	6410: "Tangoa",
+	6320: "Tanguat",
+	6740: "Tangut",
+	6307: "Tanimbili",
+	6536: "Tanimuca-Retuarã",
+	6801: "Tanjijili",
+	6752: "Tanosy Malagasy",
+	3392: "Tanudan Kalinga",
+	6767: "Tanzanian Sign Language",
+	6304: "Tapeba",
+	598:  "Tapei",
+	6584: "Tapieté",
+	6286: "Tapirapé",
+	6624: "Tarao Naga",
+	6412: "Tareng",
+	6285: "Tariana",
+	5716: "Tarifit",
+	7503: "Tarok",
+	6631: "Taroko",
+	6582: "Tarpia",
+	6747: "Tartessian",
+	6729: "Tasawaq",
+	5014: "Tase Naga",
+	7417: "Tasmanian",
+	6528: "Tasmate",
+	1835: "Tataltepec Chatino",
+	6751: "Tatana",
+	408:  "Tatar",
+	6298: "Tatuyo",
+	6662: "Tauade",
+	6691: "Taulil",
+	6341: "Taungyo",
+	6579: "Taupota",
+	6284: "Tause",
+	6627: "Taushiro",
+	6642: "Tausug",
+	6753: "Tauya",
+	6711: "Taveta",
+	6709: "Tavoyan",
+	5750: "Tavringer Romani",
+	6317: "Tawala",
+	6675: "Tawallammat Tamajaq",
+	7415: "Tawandê",
+	6725: "Tawang Monpa",
+	6724: "Tawara",
+	6318: "Taworta",
+	6735: "Tawoyan",
+	6342: "Tawr Chin",
+	6296: "Tay Boi",
+	6552: "Tay Khang",
+	960:  "Tayabas Ayta",
+	6441: "Tayart Tamajeq",
+	1711: "Tayo",
+	2557: "Taznatit",
+	6314: "Tboli",
+	6337: "Tchitchege",
+	1348: "Tchumbuli",
+	6706: "Te'un",
+	6486: "Teanu",
+	6657: "Tebul Sign Language",
+	2113: "Tebul Ure Dogon",
+	6347: "Tecpatlán Totonac",
+	6697: "Tedaga",
+	1837: "Tedim Chin",
+	6481: "Tee",
+	6395: "Tefaro",
+	5678: "Tegali",
+	3468: "Tehit",
+	6380: "Tehuelche",
+	7830: "Tejalapan Zapotec",
+	2159: "Teke-Ebo",
+	2849: "Teke-Fuumu",
+	3352: "Teke-Kukuya",
+	3890: "Teke-Laali",
+	5112: "Teke-Nzikou",
+	6379: "Teke-Tege",
+	6756: "Teke-Tsaayi",
+	6765: "Teke-Tyee",
+	6661: "Tektiteko",
+	6708: "Tela-Masbuar",
+	6493: "Telefol",
+	409:  "Telugu",
+	6505: "Teluti",
+	3169: "Tem",
+	6469: "Temacine Tamazight",
+	4812: "Temascaltepec Nahuatl",
+	6321: "Tembo (Kitembo)",
+	6530: "Tembo (Motembo)",
+	6600: "Tembé",
+	6364: "Teme",
+	6386: "Temein",
+	6104: "Temi",
+	6373: "Temiar",
+	5256: "Temoaya Otomi",
+	6523: "Temoq",
+	6369: "Tempasuk Dusun",
+	6531: "Temuan",
+	5624: "Tena Lowland Quichua",
+	5252: "Tenango Otomi",
+	2106: "Tene Kan Dogon",
+	6934: "Tenggarong Kutai Malay",
+	6387: "Tengger",
+	5280: "Tenharim",
+	6603: "Tenino",
+	6550: "Tenis",
+	6391: "Tennet",
+	6453: "Teop",
+	6389: "Teor",
+	6385: "Tepecano",
+	1754: "Tepetotutla Chinantec",
+	1868: "Tepeuxila Cuicatec",
+	1838: "Tepinapa Chinantec",
+	6376: "Tepo Krumen",
+	5994: "Ter Sami",
+	6676: "Tera",
+	6612: "Terebu",
+	1456: "Terei",
+	411:  "Tereno",
+	6378: "Teressa",
+	6722: "Tereweng",
+	6396: "Teribe",
+	6375: "Terik",
+	6732: "Termanu",
+	6397: "Ternate",
+	6516: "Ternateño",
+	6477: "Tesaka Malagasy",
+	3191: "Tese",
+	6718: "Teshenawa",
+	6384: "Teso",
+	6497: "Tetela",
+	4800: "Tetelcingo Nahuatl",
+	6374: "Tetete",
+	412:  "Tetum",
+	6368: "Tetun Dili",
+	1864: "Teutila Cuicatec",
+	6720: "Tewa (Indonesia)",
+	6390: "Tewa (USA)",
+	6734: "Tewe",
+	5259: "Texcatepec Otomi",
+	5493: "Texistepec Popoluca",
+	7808: "Texmelucan Zapotec",
+	4580: "Tezoatlán Mixtec",
+	6440: "Tha",
+	6430: "Thachanadan",
+	6350: "Thado Chin",
+	415:  "Thai",
+	6649: "Thai Sign Language",
+	6084: "Thai Song",
+	1840: "Thaiphum Chin",
+	6434: "Thakali",
+	4864: "Thangal Naga",
+	6424: "Thangmi",
+	6160: "Thao",
+	6427: "Tharaka",
+	6422: "Thayore",
+	6760: "Thaypan",
+	6439: "The",
+	6573: "Tho",
+	6431: "Thompson",
+	7634: "Thopho",
+	6741: "Thracian",
+	6758: "Thu Lao",
+	6438: "Thudam",
+	6358: "Thulung",
+	6310: "Thurawal",
+	6436: "Thuri",
+	632:  "Tiagbamrin Aizi",
+	4352: "Tiale",
+	6312: "Tiang",
+	4792: "Tibea",
+	416:  "Tibetan",
+	6340: "Tichurong",
+	6328: "Ticuna",
+	4508: "Tidaá Mixtec",
+	6442: "Tidikelt Tamazight",
+	6444: "Tidong",
+	6710: "Tidore",
+	1314: "Tiemacèwè Bozo",
+	6447: "Tiene",
+	6445: "Tifal",
+	6400: "Tigak",
+	5107: "Tigon Mbembe",
+	417:  "Tigre",
+	418:  "Tigrinya",
+	6746: "Tii",
+	7405: "Tijaltepec Mixtec",
+	6449: "Tikar",
+	6480: "Tikopia",
+	5250: "Tilapa Otomi",
+	6450: "Tillamook",
+	7829: "Tilquiapan Zapotec",
+	6448: "Tilung",
+	6527: "Tima",
+	6451: "Timbe",
+	410:  "Timne",
+	6715: "Timor Pidgin",
+	6467: "Timucua",
+	6446: "Timugon Murut",
+	3748: "Tinani",
+	6452: "Tindi",
+	6416: "Tingui-Boto",
+	6457: "Tinigua",
+	6538: "Tinoc Kallahan",
+	6599: "Tinputz",
+	6581: "Tippera",
+	6443: "Tira",
+	6611: "Tirahi",
+	6355: "Tiranige Diga Dogon",
+	1690: "Tiri",
+	6461: "Tiruray",
+	6365: "Tita",
+	6680: "Titan",
+	419:  "Tiv",
+	3741: "Tiwa",
+	6459: "Tiwi",
+	6455: "Tiéfo",
+	1324: "Tiéyaxo Bozo",
+	6471: "Tjurruru",
+	6593: "Tlachichilco Tepehua",
+	6586: "Tlacoapa Me'phaa",
+	1841: "Tlacoatzintepec Chinantec",
+	7793: "Tlacolulita Zapotec",
+	4594: "Tlahuitoltepec Mixe",
+	5059: "Tlamacazapa Nahuatl",
+	4417: "Tlazoyaltepec Mixtec",
+	422:  "Tlingit",
+	6578: "To",
+	4313: "To'abaita",
+	6604: "Toaripi",
+	6558: "Toba",
+	6515: "Toba-Maskoy",
+	6405: "Tobagonian Creole English",
+	6539: "Tobanga",
+	6667: "Tobati",
+	6490: "Tobelo",
+	6576: "Tobian",
+	6399: "Tobilung",
+	6323: "Tobo",
+	853:  "Tocantins Asurini",
+	6302: "Tocho",
+	6348: "Toda",
+	6366: "Todrah",
+	6494: "Tofanma",
+	6393: "Tofin Gbe",
+	6571: "Togbo-Vara Banda",
+	6419: "Togoyo",
+	5211: "Tohono O'odham",
+	6565: "Tojolabal",
+	426:  "Tok Pisin",
+	7835: "Tokano",
+	420:  "Tokelau",
+	7408: "Tokharian A",
+	6737: "Tokharian B",
+	7845: "Toki Pona",
+	6479: "Toku-No-Shima",
+	3022: "Tol",
+	3763: "Tolaki",
+	6498: "Tolomako",
+	6566: "Tolowa",
+	6560: "Toma",
+	6359: "Tomadino",
+	6674: "Tombelala",
+	6736: "Tombonuo",
+	6567: "Tombulu",
+	6561: "Tomedes",
+	6743: "Tomini",
+	2108: "Tommo So Dogon",
+	2107: "Tomo Kan Dogon",
+	6605: "Tomoip",
+	6363: "Tondano",
+	6652: "Tondi Songway Kiini",
+	424:  "Tonga (Nyasa)",
+	6557: "Tonga (Thailand)",
+	425:  "Tonga (Tonga Islands)",
+	6564: "Tonga (Zambia)",
+	6556: "Tongwe",
+	6468: "Tonjon",
+	6610: "Tonkawa",
+	6554: "Tonsawang",
+	6748: "Tonsea",
+	6551: "Tontemboan",
+	6668: "Tooro",
+	6577: "Topoiyo",
+	6570: "Toposa",
+	5868: "Toraja-Sa'dan",
+	6620: "Toram",
+	6679: "Torau",
+	2289: "Tornedalen Finnish",
+	6370: "Toro",
+	2111: "Toro So Dogon",
+	2112: "Toro Tegu Dogon",
+	6546: "Toromono",
+	6607: "Torona",
+	6344: "Torres Strait Creole",
+	6381: "Torricelli",
+	6632: "Torwali",
+	6635: "Torá",
+	711:  "Tosk Albanian",
+	6670: "Totela",
+	6745: "Toto",
+	6739: "Totoli",
+	7790: "Totomachapan Zapotec",
+	4499: "Totontepec Mixe",
+	6669: "Totoro",
+	6609: "Touo",
+	4742: "Toura (Côte d'Ivoire)",
+	2066: "Toura (Papua New Guinea)",
+	6672: "Towei",
+	7402: "Transalpine Gaulish",
+	5735: "Traveller Danish",
+	5738: "Traveller Norwegian",
+	6621: "Traveller Scottish",
+	6622: "Tregami",
+	6514: "Tremembé",
+	6185: "Trieng",
+	6454: "Trimuris",
+	6411: "Tring",
+	6633: "Tringgus-Sembaan Bidayuh",
+	3994: "Trinidad and Tobago Sign Language",
+	6616: "Trinidadian Creole English",
+	6623: "Trinitario",
+	6619: "Trió",
+	6472: "Truká",
+	6598: "Trumai",
+	6646: "Ts'ün-Lao",
+	6636: "Tsaangi",
+	6482: "Tsakhur",
+	6639: "Tsakonian",
+	3621: "Tsakwambo",
+	6637: "Tsamai",
+	2797: "Tsat",
+	6645: "Tseku",
+	6738: "Tsetsaut",
+	6644: "Tshangla",
+	3173: "Tsikimba",
+	1605: "Tsimané",
+	7326: "Tsimihety Malagasy",
+	427:  "Tsimshian",
+	6655: "Tsishingini",
+	3787: "Tso",
+	2697: "Tsoa",
+	6654: "Tsogo",
+	429:  "Tsonga",
+	2299: "Tsotsitaal",
+	4000: "Tsotso",
+	6653: "Tsou",
+	6683: "Tsum",
+	6705: "Tsuvadi",
+	6643: "Tsuvan",
+	6638: "Tswa",
+	428:  "Tswana",
+	6727: "Tswapong",
+	4251: "Tu",
+	5454: "Tuamotuan",
+	6322: "Tubar",
+	6696: "Tucano",
+	6702: "Tugen",
+	6771: "Tugun",
+	6693: "Tugutil",
+	3262: "Tukang Besi North",
+	1150: "Tukang Besi South",
+	977:  "Tuki",
+	6591: "Tukpa",
+	6474: "Tukudede",
+	6476: "Tukumanféd",
+	6694: "Tula",
+	6506: "Tulehu",
+	6392: "Tulishi",
+	6349: "Tulu",
+	5672: "Tulu-Bohuai",
+	2912: "Tuma-Irumu",
+	6512: "Tumak",
+	3516: "Tumari Kanuri",
+	431:  "Tumbuka",
+	3350: "Tumi",
+	6525: "Tumleo",
+	7410: "Tumshuqese",
+	6319: "Tumtum",
+	5969: "Tumulung Sisaala",
+	4628: "Tumzabt",
+	2213: "Tundra Enets",
+	6713: "Tunen",
+	3773: "Tungag",
+	6629: "Tunggare",
+	6690: "Tunia",
+	6695: "Tunica",
+	575:  "Tunisian Arabic",
+	6640: "Tunisian Sign Language",
+	6464: "Tunjung",
+	6606: "Tunni",
+	2152: "Tunzu",
+	6664: "Tuotomb",
+	6592: "Tuparí",
+	432:  "Tupi languages",
+	6588: "Tupinambá",
+	6585: "Tupinikin",
+	6692: "Tupuri",
+	6596: "Tupí",
+	6618: "Turaka",
+	6614: "Turi",
+	6731: "Turiwára",
+	6703: "Turka",
+	6700: "Turkana",
+	3365: "Turkic Khalaj",
+	433:  "Turkish",
+	6647: "Turkish Sign Language",
+	324:  "Turkish, Ottoman",
+	430:  "Turkmen",
+	6335: "Turks And Caicos Creole English",
+	6630: "Turoyo",
+	6602: "Turumsa",
+	6634: "Turung",
+	6698: "Tuscarora",
+	6659: "Tutelo",
+	6665: "Tutong",
+	6712: "Tutsa Naga",
+	6517: "Tutuba",
+	4505: "Tututepec Mixtec",
+	6699: "Tututni",
+	435:  "Tuvalu",
+	437:  "Tuvinian",
+	2848: "Tuwali Ifugao",
+	6733: "Tuwari",
+	1320: "Tuwuli",
+	6701: "Tuxináwa",
+	6687: "Tuxá",
+	6688: "Tuyuca",
+	6716: "Twana",
+	6726: "Twendi",
+	6719: "Twents",
+	436:  "Twi",
+	3143: "Tyap",
+	7113: "Tyaraity",
+	6769: "Tz'utujil",
+	6768: "Tzeltal",
+	6772: "Tzotzil",
+	6766: "Tày",
+	6762: "Tày Sa Pa",
+	6763: "Tày Tac",
+	3955: "Téén",
+	6685: "Tübatulabal",
+	6882: "U",
+	784:  "Uab Meto",
+	6774: "Uamué",
+	3531: "Uare",
+	1542: "Ubaghara",
+	6777: "Ubang",
+	6778: "Ubi",
+	6780: "Ubir",
+	6782: "Ubykh",
+	1781: "Ucayali-Yurúa Ashéninka",
+	6783: "Uda",
+	6786: "Udi",
+	6784: "Udihe",
+	438:  "Udmurt",
+	6789: "Uduk",
+	6791: "Ufim",
+	6794: "Ugandan Sign Language",
+	439:  "Ugaritic",
+	6793: "Ughele",
+	6795: "Ugong",
+	6797: "Uhami",
+	440:  "Uighur",
+	6799: "Uisai",
+	6787: "Ujir",
+	3142: "Ukaan",
+	6804: "Ukhwejo",
+	6827: "Ukit",
+	6806: "Ukpe-Bayobiri",
+	675:  "Ukpet-Ehom",
+	441:  "Ukrainian",
+	6805: "Ukrainian Sign Language",
+	6809: "Ukue",
+	6803: "Ukuriguma",
+	6807: "Ukwa",
+	6810: "Ukwuani-Aboh-Ndoni",
+	6217: "Ulau-Suain",
+	6814: "Ulch",
+	6816: "Ulithian",
+	6818: "Ullatan",
+	6813: "Ulukwumi",
+	6819: "Ulumanda'",
+	6822: "Ulwa",
+	5503: "Uma",
+	7290: "Uma' Lasan",
+	6821: "Uma' Lung",
+	2404: "Umanakaina",
+	6823: "Umatilla",
+	6825: "Umbindhamu",
+	7423: "Umbrian",
+	6781: "Umbu-Ungu",
+	6832: "Umbugarla",
+	442:  "Umbundu",
+	6826: "Umbuygamu",
+	5995: "Ume Sami",
+	6844: "Umeda",
+	7420: "Umiida",
+	2118: "Umiray Dumaget Agta",
+	6828: "Umon",
+	6830: "Umotína",
+	6831: "Umpila",
+	4491: "Una",
+	6839: "Unami",
+	273:  "Uncoded languages",
+	6842: "Unde Kaili",
+	443:  "Undetermined",
+	1001: "Uneapa",
+	6836: "Uneme",
+	7424: "Unggarranggu",
+	7249: "Unggumi",
+	6820: "Unserdeutsch",
+	5208: "Unua",
+	6843: "Uokha",
+	1695: "Upper Chehalis",
+	2045: "Upper Grand Valley Dani",
+	5496: "Upper Guinea Crioulo",
+	2034: "Upper Kinabatangan",
+	3590: "Upper Kuskokwim",
+	6485: "Upper Necaxa Totonac",
+	6257: "Upper Saxon",
+	6666: "Upper Ta'oih",
+	6297: "Upper Tanana",
+	6574: "Upper Taromi",
+	7426: "Upper Umpqua",
+	6858: "Ura (Papua New Guinea)",
+	6881: "Ura (Vanuatu)",
+	6850: "Uradhi",
+	6854: "Urak Lawoi'",
+	6855: "Urali",
+	6856: "Urapmin",
+	6846: "Urarina",
+	7427: "Urartian",
+	6861: "Urat",
+	444:  "Urdu",
+	6852: "Urhobo",
+	6884: "Uri",
+	6851: "Urigina",
+	6853: "Urim",
+	6865: "Urimo",
+	6845: "Uripiv-Wala-Rano-Atchin",
+	6848: "Urningangg",
+	6849: "Uru",
+	6867: "Uru-Eu-Wau-Wau",
+	6859: "Uru-Pa-In",
+	6857: "Uruangnirin",
+	6863: "Uruava",
+	6847: "Urubú-Kaapor",
+	6808: "Urubú-Kaapor Sign Language",
+	6796: "Uruguayan Sign Language",
+	6879: "Urum",
+	6862: "Urumi",
+	6871: "Usaghade",
+	7111: "Usan",
+	6868: "Usarufa",
+	6869: "Ushojo",
+	1852: "Usila Chinantec",
+	6815: "Usku",
+	6872: "Uspanteco",
+	6870: "Usui",
+	5188: "Utarmbung",
+	6875: "Ute-Southern Paiute",
+	6878: "Utu",
+	2256: "Uvbie",
+	6873: "Uya",
+	2124: "Uyajitaya",
+	445:  "Uzbek",
+	905:  "Uzbeki Arabic",
+	2261: "Uzekwe",
+	6890: "Vaagri Booli",
+	6892: "Vafsi",
+	1167: "Vaghat-Ya-Bijim-Legeri",
+	6915: "Vaghri",
+	6704: "Vaghua",
+	6893: "Vagla",
+	446:  "Vai",
+	6900: "Vaiphei",
+	6891: "Vale",
+	6971: "Valencian Sign Language",
+	1870: "Valle Nacional Chinantec",
+	6956: "Valley Maidu",
+	6898: "Valman",
+	6936: "Valpei",
+	4288: "Vamale",
+	4311: "Vame",
+	7432: "Vandalic",
+	4402: "Vangunu",
+	6897: "Vanimo",
+	6961: "Vano",
+	6903: "Vanuma",
+	6899: "Vao",
+	6894: "Varhadi-Nagpuri",
+	6967: "Varisi",
+	6904: "Varli",
+	6902: "Vasavi",
+	6895: "Vasekela Bushman",
+	6909: "Veddah",
+	6896: "Vehes",
+	6910: "Veluws",
+	6911: "Vemgo-Mabas",
+	447:  "Venda",
+	6908: "Venetian",
+	7430: "Venetic",
+	6970: "Venezuelan Sign Language",
+	984:  "Vengo",
+	6912: "Ventureño",
+	6913: "Veps",
+	6965: "Vera'a",
+	7434: "Vestinian",
+	6918: "Vidunda",
+	6920: "Viemo",
+	448:  "Vietnamese",
+	6921: "Vilela",
+	6919: "Vili",
+	2146: "Villa Viciosa Agta",
+	6218: "Vincentian Creole English",
+	6962: "Vinmavis",
+	6922: "Vinza",
+	6917: "Virgin Islands Creole English",
+	6923: "Vishavan",
+	6924: "Viti",
+	6972: "Vitou",
+	7061: "Vitu",
+	6937: "Vlaams",
+	6916: "Vlaamse Gebarentaal",
+	5754: "Vlax Romani",
+	449:  "Volapük",
+	7433: "Volscian",
+	3144: "Vono",
+	6964: "Voro",
+	450:  "Votic",
+	6973: "Vumbu",
+	6963: "Vunapu",
+	6974: "Vunjo",
+	4473: "Vurës",
+	6975: "Vute",
+	7004: "Vwanji",
+	6966: "Võro",
+	7008: "Wa",
+	6983: "Wa'ema",
+	7175: "Waama",
+	7095: "Waamwang",
+	6168: "Waata",
+	6978: "Wab",
+	7000: "Wabo",
+	3404: "Waboda",
+	7016: "Waci Gbe",
+	7018: "Wadaginam",
+	7010: "Waddar",
+	3662: "Wadiyara Koli",
+	7019: "Wadjiginy",
+	7020: "Wadjigu",
+	7147: "Wae Rana",
+	6986: "Waffa",
+	7036: "Wagawaga",
+	7035: "Wagaya",
+	7011: "Wagdi",
+	6991: "Wageman",
+	2264: "Wagi",
+	7045: "Wahau Kayan",
+	7044: "Wahau Kenyah",
+	7038: "Wahgi",
+	7006: "Waigali",
+	7039: "Waigeo",
+	7076: "Wailaki",
+	7080: "Wailapa",
+	5779: "Waima",
+	7092: "Waima'a",
+	980:  "Waimaha",
+	874:  "Waimiri-Atroari",
+	7075: "Waioli",
+	6995: "Waiwai",
+	7063: "Waja",
+	7013: "Wajarri",
+	6994: "Waka",
+	7176: "Wakabunga",
+	451:  "Wakashan languages",
+	7070: "Wakawaka",
+	7067: "Wakde",
+	7007: "Wakhi",
+	6982: "Wakoná",
+	3822: "Wala",
+	7084: "Walak",
+	7085: "Wali (Ghana)",
+	7077: "Wali (Sudan)",
+	7086: "Waling",
+	7071: "Walio",
+	6977: "Walla Walla",
+	7081: "Wallisian",
+	457:  "Walloon",
+	7098: "Walmajarri",
+	6981: "Walser",
+	5173: "Walungge",
+	7089: "Wamas",
+	7088: "Wambaya",
+	7097: "Wambon",
+	7091: "Wambule",
+	1774: "Wamey",
+	7093: "Wamin",
+	6987: "Wampanoag",
+	3757: "Wampar",
+	6998: "Wampur",
+	6988: "Wan",
+	7101: "Wanambre",
+	7110: "Wanap",
+	4936: "Wancho Naga",
+	7003: "Wanda",
+	4159: "Wandala",
+	6980: "Wandamen",
+	7103: "Wandarang",
+	7017: "Wandji",
+	7104: "Waneci",
+	4026: "Wanga",
+	7182: "Wangaaybuwan-Ngiyambaa",
+	7108: "Wanggamala",
+	7037: "Wangganguru",
+	7105: "Wanggom",
+	7012: "Wanman",
+	3075: "Wannu",
+	7109: "Wano",
+	7102: "Wantoat",
+	7107: "Wanukaka",
+	7112: "Wanyi",
+	2812: "Wané",
+	885:  "Waorani",
+	3080: "Wapan",
+	6990: "Wapishana",
+	6989: "Wappo",
+	726:  "War-Jaintia",
+	7002: "Wara",
+	6999: "Warao",
+	7130: "Warapu",
+	453:  "Waray",
+	7149: "Waray (Australia)",
+	7142: "Wardaman",
+	7132: "Warduji",
+	7150: "Warembori",
+	6985: "Wares",
+	7143: "Waris",
+	7001: "Waritai",
+	7135: "Wariyangga",
+	7064: "Warji",
+	1131: "Warkay-Bipim",
+	7137: "Warlmanpa",
+	7009: "Warlpiri",
+	7131: "Warluwara",
+	7139: "Warnang",
+	7141: "Waropen",
+	7041: "Warrgamay",
+	7178: "Warrwa",
+	7144: "Waru",
+	7138: "Warumungu",
+	7145: "Waruna",
+	7133: "Warungu",
+	7154: "Wasa",
+	6979: "Wasco-Wishram",
+	2572: "Wasembo",
+	454:  "Washo",
+	7152: "Waskia",
+	7155: "Wasu",
+	7159: "Watakataui",
+	6996: "Watam",
+	7157: "Watiwa",
+	6984: "Watubela",
+	3443: "Waube",
+	6993: "Waurá",
+	7174: "Wauyai",
+	7179: "Wawa",
+	7127: "Wawonii",
+	7180: "Waxianghua",
+	5272: "Wayampi",
+	6997: "Wayana",
+	1847: "Wayanad Chetti",
+	7184: "Wayoró",
+	6905: "Wayu",
+	2578: "Wayuu",
+	7023: "Wedau",
+	7024: "Weh",
+	7033: "Wejewa",
+	3363: "Weliki",
+	455:  "Welsh",
+	5752: "Welsh Romani",
+	7027: "Wemale",
+	7026: "Weme Gbe",
+	7029: "Weri",
+	3618: "Wersing",
+	2279: "West Albay Bikol",
+	4925: "West Ambae",
+	7701: "West Berawan",
+	1003: "West Central Banda",
+	2360: "West Central Oromo",
+	1056: "West Coast Bajau",
+	2086: "West Damar",
+	1930: "West Goodenough",
+	3207: "West Kewa",
+	3911: "West Lembata",
+	4428: "West Makian",
+	4478: "West Masela",
+	6744: "West Tarangan",
+	6883: "West Uvean",
+	7477: "West Yugur",
+	3840: "West-Central Limba",
+	513:  "Western Abnaki",
+	806:  "Western Apache",
+	820:  "Western Arrarnta",
+	1123: "Western Balochi",
+	2517: "Western Bolivian Guaraní",
+	1392: "Western Bru",
+	4054: "Western Bukidnon Manobo",
+	1693: "Western Cham",
+	2056: "Western Dani",
+	968:  "Western Durango Nahuatl",
+	7185: "Western Fijian",
+	2610: "Western Gurung",
+	1845: "Western Highland Chatino",
+	5569: "Western Highland Purepecha",
+	4813: "Western Huasteca Nahuatl",
+	3054: "Western Juxtlahuaca Mixtec",
+	3415: "Western Kanjobal",
+	3699: "Western Karaboro",
+	3578: "Western Katu",
+	3693: "Western Kayah",
+	3322: "Western Keres",
+	3518: "Western Krahn",
+	7661: "Western Lalu",
+	3774: "Western Lawa",
+	4439: "Western Magar",
+	4310: "Western Maninkakan",
+	4444: "Western Mari",
+	2732: "Western Mashan Hmong",
+	5668: "Western Meohang",
+	4525: "Western Muria",
+	737:  "Western Neo-Aramaic",
+	2325: "Western Niger Fulfulde",
+	5154: "Western Ojibwa",
+	5461: "Western Panjabi",
+	3317: "Western Parbate Kham",
+	5463: "Western Penan",
+	6166: "Western Sisaala",
+	6204: "Western Subanon",
+	6357: "Western Tamang",
+	6717: "Western Tawbuid",
+	6608: "Western Totonac",
+	6535: "Western Tunebo",
+	4335: "Western Xiangxi Miao",
+	7439: "Western Xwla Gbe",
+	7521: "Western Yiddish",
+	7028: "Westphalien",
+	7177: "Wetamut",
+	7021: "Wewaw",
+	7128: "Weyto",
+	2466: "White Gelao",
+	4027: "White Lachi",
+	6547: "Whitesands",
+	6684: "Wiarumus",
+	7047: "Wichita",
+	4633: "Wichí Lhamtés Güisnay",
+	4500: "Wichí Lhamtés Nocten",
+	7083: "Wichí Lhamtés Vejoz",
+	7048: "Wik-Epa",
+	7053: "Wik-Iiyanh",
+	7049: "Wik-Keyangan",
+	7051: "Wik-Me'anha",
+	7056: "Wik-Mungkan",
+	7050: "Wik-Ngathana",
+	7054: "Wikalkan",
+	7162: "Wikngenchera",
+	7055: "Wilawila",
+	7059: "Wintu",
+	3541: "Winyé",
+	2407: "Wipi",
+	7134: "Wiradhuri",
+	7058: "Wiraféd",
+	7040: "Wirangu",
+	7060: "Wiru",
+	7062: "Wiyot",
+	7436: "Woccon",
+	7119: "Wogamusin",
+	7115: "Wogeo",
+	7014: "Woi",
+	3063: "Wojenaka",
+	452:  "Wolaitta",
+	7073: "Wolane",
+	7116: "Wolani",
+	7117: "Woleaian",
+	7079: "Wolio",
+	458:  "Wolof",
+	7122: "Wom (Nigeria)",
+	7096: "Wom (Papua New Guinea)",
+	7100: "Womo",
+	7123: "Wongo",
+	1873: "Woods Cree",
+	7125: "Woria",
+	3163: "Worimi",
+	3077: "Worodougou",
+	7140: "Worrorra",
+	7156: "Wotapuri-Katarqalai",
+	7161: "Wotu",
+	4947: "Woun Meu",
+	7440: "Written Oirat",
+	7171: "Wu Chinese",
+	7663: "Wuding-Luquan Yi",
+	7164: "Wudu",
+	7082: "Wuliwuli",
+	7173: "Wulna",
+	1359: "Wumboko",
+	7167: "Wumbvu",
+	7666: "Wumeng Nasu",
+	1504: "Wunai Bunu",
+	7163: "Wunambal",
+	7169: "Wurrugu",
+	7520: "Wusa Nasu",
+	1400: "Wushi",
+	7151: "Wusi",
+	7170: "Wutung",
+	7165: "Wutunhua",
+	7172: "Wuvulu-Aua",
+	6788: "Wuzlam",
+	7181: "Wyandot",
+	7183: "Wymysorys",
+	6336: "Wára",
+	3090: "Wãpha",
+	7114: "Wè Northern",
+	2628: "Wè Southern",
+	7022: "Wè Western",
+	3106: "Xaasongaxango",
+	7695: "Xadani Zapotec",
+	7283: "Xakriabá",
+	7194: "Xamtanga",
+	7823: "Xanaguía Zapotec",
+	941:  "Xaragure",
+	7202: "Xavánte",
+	7239: "Xerénte",
+	7241: "Xetá",
+	460:  "Xhosa",
+	7258: "Xiandao",
+	2777: "Xiang Chinese",
+	5990: "Xibe",
+	6568: "Xicotepec De Juárez Totonac",
+	7262: "Xinca",
+	847:  "Xingú Asuriní",
+	7266: "Xipaya",
+	7263: "Xipináwa",
+	7260: "Xiri",
+	7264: "Xiriâna",
+	7665: "Xishanba Lalo",
+	7344: "Xokleng",
+	7347: "Xukurú",
+	7437: "Xwela Gbe",
+	745:  "Xârâcùù",
+	4526: "Yaaku",
+	7466: "Yabarana",
+	7484: "Yabaâna",
+	2974: "Yabem",
+	7483: "Yaben",
+	7485: "Yabong",
+	7669: "Yabula Yabula",
+	2184: "Yace",
+	5808: "Yaeyama",
+	7034: "Yafi",
+	7668: "Yagara",
+	7513: "Yagaria",
+	7459: "Yagnobi",
+	7511: "Yagomi",
+	7454: "Yagua",
+	7514: "Yagwoia",
+	4756: "Yahadian",
+	5713: "Yahang",
+	7592: "Yahuna",
+	939:  "Yaka (Central African Republic)",
+	2966: "Yaka (Congo)",
+	7456: "Yaka (Democratic Republic of Congo)",
+	7541: "Yakaikeke",
+	7461: "Yakama",
+	7538: "Yakan",
+	7478: "Yakha",
+	7549: "Yakoma",
+	356:  "Yakut",
+	7475: "Yala",
+	2979: "Yalahatan",
+	7446: "Yalakalore",
+	7559: "Yalarnnga",
+	4700: "Yale",
+	7551: "Yaleba",
+	7462: "Yalunka",
+	7803: "Yalálag Zapotec",
+	7574: "Yamap",
+	7463: "Yamba",
+	7562: "Yambes",
+	7468: "Yambeta",
+	3047: "Yamdena",
+	7565: "Yameo",
+	6292: "Yami",
+	7451: "Yaminahua",
+	7572: "Yamna",
+	7566: "Yamongeri",
+	7479: "Yamphu",
+	2988: "Yan-nhangu",
+	7588: "Yana",
+	5621: "Yanahuanca Pasco Quechua",
+	2148: "Yanda Dom Dogon",
+	7582: "Yandruwandha",
+	720:  "Yanesha'",
+	7840: "Yang Zhuang",
+	7470: "Yangben",
+	7585: "Yangho",
+	1419: "Yangkam",
+	3057: "Yangman",
+	7584: "Yango",
+	7587: "Yangulam",
+	7493: "Yangum Dey",
+	7510: "Yangum Gel",
+	7573: "Yangum Mon",
+	3165: "Yankunytjatjara",
+	2595: "Yanomamö",
+	7015: "Yanomámi",
+	7591: "Yansi",
+	2982: "Yanyuwa",
+	461:  "Yao",
+	857:  "Yaosakor Asmat",
+	7615: "Yaouré",
+	462:  "Yapese",
+	7507: "Yapunda",
+	2983: "Yaqay",
+	7465: "Yaqui",
+	7621: "Yarawata",
+	7614: "Yareba",
+	7678: "Yareni Zapotec",
+	7620: "Yarsun",
+	7616: "Yarí",
+	7545: "Yasa",
+	7622: "Yassic",
+	7833: "Yatee Zapotec",
+	7693: "Yatzachi Zapotec",
+	7654: "Yau (Morobe Province)",
+	7670: "Yau (Sandaun Province)",
+	7550: "Yaul",
+	7472: "Yauma",
+	2986: "Yaur",
+	7784: "Yautepec Zapotec",
+	5625: "Yauyos Quechua",
+	7659: "Yavitero",
+	7658: "Yawa",
+	7471: "Yawalapití",
+	7662: "Yawanawa",
+	7667: "Yawarawarga",
+	7487: "Yaweyuha",
+	2997: "Yawijibaya",
+	7486: "Yawiyo",
+	7664: "Yawuru",
+	7458: "Yazgulyam",
+	6491: "Yecuatla Totonac",
+	3007: "Yei",
+	2250: "Yekhee",
+	7546: "Yekora",
+	7502: "Yela",
+	7552: "Yele",
+	3009: "Yelmek",
+	7553: "Yelogu",
+	7476: "Yemba",
+	3059: "Yemsa",
+	7590: "Yendang",
+	7500: "Yeni",
+	7498: "Yeniche",
+	7613: "Yerakai",
+	2532: "Yeretuar",
+	7619: "Yerong",
+	7506: "Yerukula",
+	7630: "Yessan-Mayo",
+	7505: "Yetfa",
+	7501: "Yevanic",
+	7508: "Yeyi",
+	463:  "Yiddish",
+	7496: "Yiddish Sign Language",
+	7494: "Yidgha",
+	7522: "Yidiny",
+	7555: "Yil",
+	7499: "Yimas",
+	7526: "Yimchungru Naga",
+	3616: "Yinbaw Karen",
+	7527: "Yinchia",
+	7523: "Yindjibarndi",
+	7525: "Yindjilandji",
+	5379: "Yine",
+	7518: "Yinggarda",
+	3620: "Yintale Karen",
+	7536: "Yir Yoront",
+	7531: "Yis",
+	2419: "Yiwom",
+	7593: "Yoba",
+	2579: "Yocoboué Dida",
+	7594: "Yogad",
+	7495: "Yoidik",
+	7540: "Yoke",
+	7596: "Yokuts",
+	7597: "Yola",
+	7416: "Yoloxochitl Mixtec",
+	5388: "Yom",
+	7598: "Yombe",
+	7595: "Yonaguni",
+	7589: "Yong",
+	7839: "Yongbei Zhuang",
+	7599: "Yongkom",
+	7842: "Yongnan Zhuang",
+	7652: "Yopno",
+	4503: "Yora",
+	7602: "Yoron",
+	7447: "Yorta Yorta",
+	464:  "Yoruba",
+	7600: "Yos",
+	4397: "Yosondúa Mixtec",
+	7601: "Yotti",
+	7841: "Youjiang Zhuang",
+	3033: "Youle Jinuo",
+	1450: "Younuo Bunu",
+	7635: "Yout Wam",
+	7603: "Yoy",
+	5035: "Yuaga",
+	4463: "Yucatec Maya Sign Language",
+	7636: "Yucateco",
+	7638: "Yuchi",
+	4536: "Yucuañe Mixtec",
+	7490: "Yucuna",
+	7640: "Yue Chinese",
+	7642: "Yug",
+	7637: "Yugambal",
+	7653: "Yugh",
+	7625: "Yugoslavian Sign Language",
+	7452: "Yuhup",
+	7645: "Yuki",
+	7649: "Yukpa",
+	7482: "Yukuben",
+	7646: "Yulu",
+	465:  "Yupik languages",
+	7650: "Yuqui",
+	7657: "Yuracare",
+	7651: "Yurok",
+	7643: "Yurutí",
+	4041: "Yutanduchi Mixtec",
+	7469: "Yuwana",
+	7457: "Yámana",
+	7832: "Zaachila Zapotec",
+	3314: "Zabana",
+	1849: "Zacatepec Chatino",
+	4802: "Zacatlán-Ahuacatlán-Tepetzintla Nahuatl",
+	7680: "Zaghawa",
+	860:  "Zaiwa",
+	7736: "Zakhring",
+	7818: "Zambian Sign Language",
+	7771: "Zan Gula",
+	7684: "Zanaki",
+	7772: "Zande (individual language)",
+	469:  "Zande languages",
+	7692: "Zangskari",
+	7681: "Zangwal",
+	7805: "Zaniza Zapotec",
+	466:  "Zapotec",
+	7683: "Zaramo",
+	7697: "Zari",
+	2009: "Zarma",
+	7814: "Zarphatic",
+	7685: "Zauzou",
+	7838: "Zay",
+	3657: "Zayein Karen",
+	7696: "Zayse-Zergulla",
+	483:  "Zaza",
+	2977: "Zazao",
+	7834: "Zeem",
+	7705: "Zeeuws",
+	1974: "Zemba",
+	5110: "Zeme Naga",
+	7449: "Zemgalian",
+	7706: "Zenag",
+	467:  "Zenaga",
+	1882: "Zenzontepec Chatino",
+	7812: "Zerenkel",
+	7713: "Zhaba",
+	7448: "Zhang-Zhung",
+	7715: "Zhire",
+	7717: "Zhoa",
+	468:  "Zhuang",
+	7718: "Zia",
+	7721: "Zialo",
+	7725: "Zigula",
+	7720: "Zimakani",
+	7746: "Zimba",
+	7719: "Zimbabwe Sign Language",
+	7723: "Zinza",
+	5965: "Zire",
+	7724: "Ziriya",
+	7726: "Zizilivakan",
+	5561: "Zo'é",
+	7673: "Zokhuo",
+	7799: "Zoogocho Zapotec",
+	2382: "Zoroastrian Dari",
+	1884: "Zotung Chin",
+	7778: "Zou",
+	2503: "Zulgo-Gemzek",
+	470:  "Zulu",
+	7837: "Zumaya",
+	3045: "Zumbun",
+	471:  "Zuni",
+	7844: "Zuojiang Zhuang",
+	7843: "Zyphe",
+	7813: "Záparo",
+	24:   "[Artificial (Other)]",
+	284:  "[Multiple languages]",
+	3026: "sTodsde",
+	2420: "ut-Ma'in",
+	636:  "Àhàn",
+	535:  "Áncá",
+	777:  "Ömie",
+	5213: "Önge",
+}
+
+// rgPrimaryTypeIDs maps from primary release group types to IDs from the
+// release_group_primary_type table.
+var rgPrimaryTypeIDs = map[ReleaseGroupType]int{
+	ReleaseGroupType_Album:     1,
+	ReleaseGroupType_Single:    2,
+	ReleaseGroupType_EP:        3,
+	ReleaseGroupType_Other:     11,
+	ReleaseGroupType_Broadcast: 12,
+}
+
+// rgSecondaryTypeIDs maps from secondary release group types to IDs from the
+// release_group_secondary_type table.
+var rgSecondaryTypeIDs = map[ReleaseGroupType]int{
+	ReleaseGroupType_Compilation:   1,
+	ReleaseGroupType_Soundtrack:    2,
+	ReleaseGroupType_Spokenword:    3,
+	ReleaseGroupType_Interview:     4,
+	ReleaseGroupType_Audiobook:     5,
+	ReleaseGroupType_Live:          6,
+	ReleaseGroupType_Remix:         7,
+	ReleaseGroupType_DJMix:         8,
+	ReleaseGroupType_MixtapeStreet: 9,
+	ReleaseGroupType_Demo:          10,
+	ReleaseGroupType_AudioDrama:    11,
+}
@@ -0,0 +1,128 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package seed
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/derat/yambs/mbdb"
+)
+
+// Place holds data used to seed the "Add Place" form at https://musicbrainz.org/place/create
+// and the edit-place form at https://musicbrainz.org/place/<MBID>/edit.
+// See https://musicbrainz.org/doc/Place for more information about place entities.
+type Place struct {
+	// MBID contains the place's MBID (for editing an existing place rather than creating a new one).
+	MBID string `json:"mbid,omitempty"`
+	// Name contains the place's official name.
+	Name string `json:"name,omitempty"`
+	// Disambiguation differentiates this place from other places with similar names.
+	// See https://musicbrainz.org/doc/Disambiguation_Comment.
+	Disambiguation string `json:"disambiguation,omitempty"`
+	// Type describes the place's main function.
+	// See https://musicbrainz.org/doc/Place#Type.
+	Type PlaceType `json:"type,omitempty"`
+	// Address contains the place's street address, formatted as is customary in its location.
+	// See https://musicbrainz.org/doc/Place#Address.
+	Address string `json:"address,omitempty"`
+	// AreaName is used to fill the search field for the area containing the place.
+	// See the comment on Label.AreaName for more details.
+	AreaName string `json:"area_name,omitempty"`
+	// Coordinates contains the place's latitude and longitude, e.g. "51.5319, -0.1779".
+	// The edit form also accepts a variety of other formats, including degrees/minutes/seconds.
+	Coordinates string `json:"coordinates,omitempty"`
+	// BeginDate contains the date when the place was founded.
+	BeginDate Date `json:"begin_date,omitempty"`
+	// EndDate contains the date when the place closed.
+	EndDate Date `json:"end_date,omitempty"`
+	// Ended describes whether the place has closed.
+	Ended bool `json:"ended,omitempty"`
+	// Relationships contains (non-URL) relationships between this place and other entities.
+	Relationships []Relationship `json:"relationships,omitempty"`
+	// URLs contains relationships between this place and one or more URLs.
+	// See https://musicbrainz.org/doc/Style/Relationships/URLs.
+	URLs []URL `json:"urls,omitempty"`
+	// Changes lists modifications to the existing place. See Edit.
+	Changes []string `json:"changes,omitempty"`
+	// EditNote contains the note attached to the edit.
+	// See https://musicbrainz.org/doc/Edit_Note.
+	EditNote string `json:"edit_note,omitempty"`
+}
+
+func (p *Place) Entity() Entity { return PlaceEntity }
+
+func (p *Place) Description() string {
+	var parts []string
+	if p.MBID != "" {
+		parts = append(parts, truncate(p.MBID, mbidPrefixLen, false))
+	}
+	if p.Name != "" {
+		parts = append(parts, p.Name)
+	}
+	if len(p.Changes) > 0 {
+		parts = append(parts, strings.Join(p.Changes, "; "))
+	}
+	if len(parts) == 0 {
+		return "[unknown]"
+	}
+	return strings.Join(parts, " / ")
+}
+
+func (p *Place) URL(serverURL string) string {
+	if p.MBID != "" {
+		return serverURL + "/place/" + p.MBID + "/edit"
+	}
+	return serverURL + "/place/create"
+}
+
+func (p *Place) Params() url.Values {
+	vals := make(url.Values)
+	if p.Name != "" {
+		vals.Set("edit-place.name", p.Name)
+	}
+	if p.Disambiguation != "" {
+		vals.Set("edit-place.comment", p.Disambiguation)
+	}
+	if p.Type != 0 {
+		vals.Set("edit-place.type_id", strconv.Itoa(int(p.Type)))
+	}
+	if p.Address != "" {
+		vals.Set("edit-place.address", p.Address)
+	}
+	if p.AreaName != "" {
+		vals.Set("edit-place.area.name", p.AreaName)
+	}
+	if p.Coordinates != "" {
+		vals.Set("edit-place.coordinates", p.Coordinates)
+	}
+
+	p.BeginDate.setParams(vals, "edit-place.period.begin_date.")
+	p.EndDate.setParams(vals, "edit-place.period.end_date.")
+	if p.Ended {
+		vals.Set("edit-place.period.ended", "1")
+	}
+
+	for i, rel := range p.Relationships {
+		rel.setParams(vals, fmt.Sprintf("rels.%d.", i))
+	}
+	for i, u := range p.URLs {
+		u.setParams(vals, fmt.Sprintf("edit-place.url.%d.", i), p.Method())
+	}
+	if p.EditNote != "" {
+		vals.Set("edit-place.edit_note", p.EditNote)
+	}
+	return vals
+}
+
+func (p *Place) Method() string { return http.MethodGet }
+
+func (p *Place) Finish(ctx context.Context, db *mbdb.DB) error {
+	finishURLs(p.URLs, PlaceEntity)
+	return nil
+}
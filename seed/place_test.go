@@ -0,0 +1,50 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package seed
+
+import (
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPlace_Params(t *testing.T) {
+	place := Place{
+		Name:           "Abbey Road Studios",
+		Disambiguation: "for testing",
+		Type:           PlaceType_Studio,
+		Address:        "3 Abbey Road, St John's Wood, London, NW8 9AY",
+		AreaName:       "City of Westminster",
+		Coordinates:    "51.5319, -0.1779",
+		BeginDate:      Date{1931, 11, 12},
+		Relationships: []Relationship{{
+			Target: "b10bbbfc-cf9e-42e0-be17-e2c3e1d2600d",
+			Type:   LinkType_Owner_Label_Place,
+		}},
+		URLs:     []URL{{URL: "https://www.abbeyroad.com/"}},
+		EditNote: "here's the edit note",
+	}
+
+	rel := place.Relationships[0]
+	want := url.Values{
+		"edit-place.name":                    {place.Name},
+		"edit-place.comment":                 {place.Disambiguation},
+		"edit-place.type_id":                 {strconv.Itoa(int(place.Type))},
+		"edit-place.address":                 {place.Address},
+		"edit-place.area.name":               {place.AreaName},
+		"edit-place.coordinates":             {place.Coordinates},
+		"edit-place.period.begin_date.year":  {"1931"},
+		"edit-place.period.begin_date.month": {"11"},
+		"edit-place.period.begin_date.day":   {"12"},
+		"rels.0.target":                      {rel.Target},
+		"rels.0.type":                        {strconv.Itoa(int(rel.Type))},
+		"edit-place.url.0.text":              {place.URLs[0].URL},
+		"edit-place.edit_note":               {place.EditNote},
+	}
+	if diff := cmp.Diff(want, place.Params()); diff != "" {
+		t.Error("Incorrect query params:\n" + diff)
+	}
+}
@@ -21,27 +21,27 @@ import (
 type Recording struct {
 	// MBID contains the recording's MBID (for editing an existing recording rather than
 	// creating a new one).
-	MBID string
+	MBID string `json:"mbid,omitempty"`
 	// Name contains the recording's title.
-	Name string
+	Name string `json:"name,omitempty"`
 	// Artist contains the MBID of the artist primarily credited with the recording.
 	// TODO: Drop this in favor of only using Artists?
-	Artist string
+	Artist string `json:"artist,omitempty"`
 	// Artists contains detailed information about artists credited with the recording.
-	Artists []ArtistCredit
+	Artists []ArtistCredit `json:"artists,omitempty"`
 	// Disambiguation differentiates this recording from other recordings with similar names.
 	// See https://musicbrainz.org/doc/Disambiguation_Comment.
-	Disambiguation string
+	Disambiguation string `json:"disambiguation,omitempty"`
 	// Length contains the recording's duration.
-	Length time.Duration
+	Length time.Duration `json:"length,omitempty"`
 	// Video is true if this is a video recording.
 	// Per https://musicbrainz.org/doc/How_to_Add_Standalone_Recordings, "an audio track uploaded to
 	// Youtube with a static photo does not qualify as a video, this should be used only for actual
 	// videos".
-	Video bool
+	Video bool `json:"video,omitempty"`
 	// ISRCs contains 12-byte alphanumeric codes that identify audio or music video recordings.
 	// See https://musicbrainz.org/doc/ISRC.
-	ISRCs []string
+	ISRCs []string `json:"isrcs,omitempty"`
 	// URLs contains relationships between this recording and one or more URLs.
 	// See https://musicbrainz.org/doc/Style/Relationships/URLs.
 	//
@@ -53,12 +53,18 @@ type Recording struct {
 	//  LinkType_StreamingMusic_Recording_URL ("stream for free")
 	//  LinkType_StreamingPaid_Recording_URL ("streaming page")
 	//  LinkType_Crowdfunding_Recording_URL ("crowdfunding page")
-	URLs []URL
+	URLs []URL `json:"urls,omitempty"`
 	// Relationships contains (non-URL) relationships between this recording and other entities.
-	Relationships []Relationship
+	Relationships []Relationship `json:"relationships,omitempty"`
+	// Changes lists modifications to the existing recording. See Edit.
+	Changes []string `json:"changes,omitempty"`
+	// Tags contains tags to add to the existing recording. See GetSubmission.
+	Tags []string `json:"tags,omitempty"`
+	// Rating contains a 1-5 rating for the existing recording. See GetSubmission.
+	Rating int `json:"rating,omitempty"`
 	// EditNote contains the note attached to the edit.
 	// See https://musicbrainz.org/doc/Edit_Note.
-	EditNote string
+	EditNote string `json:"edit_note,omitempty"`
 }
 
 func (rec *Recording) Entity() Entity { return RecordingEntity }
@@ -74,6 +80,9 @@ func (rec *Recording) Description() string {
 	if s := artistCreditsDesc(rec.Artists); s != "" {
 		parts = append(parts, s)
 	}
+	if len(rec.Changes) > 0 {
+		parts = append(parts, strings.Join(rec.Changes, "; "))
+	}
 	if len(parts) == 0 {
 		return "[unknown]"
 	}
@@ -131,6 +140,7 @@ func (rec *Recording) Params() url.Values {
 func (rec *Recording) Method() string { return http.MethodGet }
 
 func (rec *Recording) Finish(ctx context.Context, db *mbdb.DB) error {
+	finishURLs(rec.URLs, RecordingEntity)
 	for i := range rec.Artists {
 		ac := &rec.Artists[i]
 		if ac.MBID != "" {
@@ -143,3 +153,10 @@ func (rec *Recording) Finish(ctx context.Context, db *mbdb.DB) error {
 	}
 	return nil
 }
+
+// Autofill attempts to automatically fill empty fields in rec.
+// Featured artists listed in Name (e.g. "Title (feat. Artist)") are moved to Artists.
+func (rec *Recording) Autofill() {
+	// The standalone recording form seems to ignore Name, so use NameAsCredited.
+	rec.Name, rec.Artists = moveFeaturedArtists(rec.Name, rec.Artists, true)
+}
@@ -101,3 +101,37 @@ func TestRecording_Params(t *testing.T) {
 		t.Error("Incorrect query params:\n" + diff)
 	}
 }
+
+func TestRecording_Autofill(t *testing.T) {
+	for _, tc := range []struct {
+		rec, want Recording
+	}{
+		{
+			Recording{Name: "Song (feat. B, C & D)", Artists: []ArtistCredit{{NameAsCredited: "A"}}},
+			Recording{Name: "Song", Artists: []ArtistCredit{
+				{NameAsCredited: "A", JoinPhrase: " feat. "},
+				{NameAsCredited: "B", JoinPhrase: ", "},
+				{NameAsCredited: "C", JoinPhrase: " & "},
+				{NameAsCredited: "D"},
+			}},
+		},
+		{
+			Recording{Name: "Song feat. B (Live)", Artists: []ArtistCredit{{NameAsCredited: "A"}}},
+			Recording{Name: "Song (Live)", Artists: []ArtistCredit{
+				{NameAsCredited: "A", JoinPhrase: " feat. "},
+				{NameAsCredited: "B"},
+			}},
+		},
+		{
+			// The title should be left alone if there's no artist to attach the featured artist to.
+			Recording{Name: "Song (feat. B)"},
+			Recording{Name: "Song (feat. B)"},
+		},
+	} {
+		rec := tc.rec
+		rec.Autofill()
+		if diff := cmp.Diff(tc.want, rec); diff != "" {
+			t.Errorf("Autofill on %q gave bad recording:\n%s", tc.rec.Name, diff)
+		}
+	}
+}
@@ -4,9 +4,14 @@
 package seed
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
+	"sort"
 	"strconv"
+	"strings"
+
+	"github.com/derat/yambs/strutil"
 )
 
 // Relationship holds data used to seed forms with non-URL relationships between entities.
@@ -14,32 +19,32 @@ import (
 type Relationship struct {
 	// Target contains the MBID or name of the entity at the other end of the relationship.
 	// If a name is supplied rather than an MBID, the field will be seeded for a database search.
-	Target string
+	Target string `json:"target,omitempty"`
 	// Type contains the database ID of the relationship type.
 	// The relationship will be ignored if the link type is inappropriate for the type of the
 	// entity being edited (e.g. when seeding a recording, a LinkType_*_Recording type should
 	// be specified).
-	Type LinkType
+	Type LinkType `json:"type,omitempty"`
 	// TypeUUID contains the UUID of the relationship type. It is only used if Type is unset.
 	// UUIDs can be found at https://musicbrainz.org/relationships.
-	TypeUUID string
+	TypeUUID string `json:"type_uuid,omitempty"`
 	// SourceCredit contains the way in which the source entity is credited in the relationship.
-	SourceCredit string
+	SourceCredit string `json:"source_credit,omitempty"`
 	// TargetCredit contains the way in which Target is credited in the relationship.
-	TargetCredit string
+	TargetCredit string `json:"target_credit,omitempty"`
 	// Attributes contains additional attributes associated with this relationship.
-	Attributes []RelationshipAttribute
+	Attributes []RelationshipAttribute `json:"attributes,omitempty"`
 	// BeginDate contains the date when the relationship began.
-	BeginDate Date
+	BeginDate Date `json:"begin_date,omitempty"`
 	// EndMonth contains the date when the relationship ended.
-	EndDate Date
+	EndDate Date `json:"end_date,omitempty"`
 	// Ended describes whether the relationship has ended.
-	Ended bool
+	Ended bool `json:"ended,omitempty"`
 	// Backward describes whether the relationship direction should be reversed.
 	// For example, when using LinkType_SamplesMaterial_Recording_Recording,
 	// a true value indicates that Target sampled the seeded recording rather than
 	// the seeded recording sampling Target.
-	Backward bool
+	Backward bool `json:"backward,omitempty"`
 }
 
 // setParams sets query parameters in vals corresponding to non-empty fields in rel.
@@ -102,16 +107,16 @@ func (rel *Relationship) setParams(vals url.Values, prefix string) {
 // RelationshipAttribute modifies a relationship between two entities.
 type RelationshipAttribute struct {
 	// Type contains the database ID of the attribute type.
-	Type LinkAttributeType
+	Type LinkAttributeType `json:"type,omitempty"`
 	// TypeUUID contains the UUID of the attribute type. It is only used if Type is unset.
 	// UUIDs can be found at https://musicbrainz.org/relationship-attributes.
-	TypeUUID string
+	TypeUUID string `json:"type_uuid,omitempty"`
 	// CreditedAs is used to fill the "credited as" field (e.g. describing how an instrument was credited).
-	CreditedAs string
+	CreditedAs string `json:"credited_as,omitempty"`
 	// TextValue holds an additional text value associated with the relationship.
 	// This is used for e.g. holding the actual number when a LinkAttributeType_Number
 	// attribute is added to a LinkType_PartOf_Recording_Series relationship.
-	TextValue string
+	TextValue string `json:"text_value,omitempty"`
 }
 
 // setParams sets query parameters in vals corresponding to non-empty fields in attr.
@@ -129,3 +134,109 @@ func (attr *RelationshipAttribute) setParams(vals url.Values, prefix string) {
 		vals.Set(prefix+"text_value", attr.TextValue)
 	}
 }
+
+// FindLinkType returns the link type with the supplied name (e.g. "producer" or "part of")
+// between entities of types entity and target. If target is empty, link types to all
+// non-URL entity types are searched, and an error is returned if the name is ambiguous.
+// Names are matched case-insensitively, ignoring punctuation, spaces, and accents.
+func FindLinkType(entity, target Entity, name string) (LinkType, error) {
+	return findLinkType(linkTypeNames, dbEntityType(entity), dbEntityType(target), name)
+}
+
+// FindURLLinkType is like FindLinkType but searches for link types between
+// entities of type entity and URLs (e.g. "discogs" or "purchase for download").
+func FindURLLinkType(entity Entity, name string) (LinkType, error) {
+	return findLinkType(linkTypeNames, dbEntityType(entity), "url", name)
+}
+
+// findLinkType implements FindLinkType and FindURLLinkType.
+// linkNames is keyed as in linkTypeNames, and src and target are database
+// entity types, e.g. "release_group".
+func findLinkType(linkNames map[[2]string]map[string]LinkType, src, target, name string) (LinkType, error) {
+	norm := normalizeTypeName(name)
+	matches := make(map[string]LinkType) // keyed by target type
+	var names []string
+	for pair, types := range linkNames {
+		var dst string
+		switch {
+		case pair[0] == src:
+			dst = pair[1]
+		case pair[1] == src:
+			dst = pair[0]
+		default:
+			continue
+		}
+		if (target == "" && dst == "url") || (target != "" && dst != target) {
+			continue
+		}
+		for n, lt := range types {
+			names = append(names, n)
+			if normalizeTypeName(n) == norm {
+				matches[dst] = lt
+			}
+		}
+	}
+
+	desc := src
+	if target != "" {
+		desc += "-" + target
+	}
+	switch len(matches) {
+	case 0:
+		if len(names) == 0 {
+			return 0, fmt.Errorf("no %v link types", desc)
+		}
+		return 0, fmt.Errorf("unknown %v link type (valid types: %v)", desc, strings.Join(sortedUnique(names), ", "))
+	case 1:
+		for _, lt := range matches {
+			return lt, nil
+		}
+	}
+	dsts := make([]string, 0, len(matches))
+	for dst := range matches {
+		dsts = append(dsts, dst)
+	}
+	return 0, fmt.Errorf("ambiguous %v link type (target type could be %v)",
+		desc, strings.Join(sortedUnique(dsts), ", "))
+}
+
+// FindLinkAttributeType returns the link attribute type with the supplied name
+// (e.g. "guitar" or "additional"). Names are matched as in FindLinkType.
+func FindLinkAttributeType(name string) (LinkAttributeType, error) {
+	if at, ok := normLinkAttrTypeNames[normalizeTypeName(name)]; ok {
+		return at, nil
+	}
+	return 0, errors.New("unknown link attribute type (see https://musicbrainz.org/relationship-attributes)")
+}
+
+// normLinkAttrTypeNames maps normalized versions of the keys in linkAttrTypeNames to IDs.
+var normLinkAttrTypeNames = func() map[string]LinkAttributeType {
+	m := make(map[string]LinkAttributeType, len(linkAttrTypeNames))
+	for n, at := range linkAttrTypeNames {
+		m[normalizeTypeName(n)] = at
+	}
+	return m
+}()
+
+// dbEntityType converts entity to the form used in the MusicBrainz database,
+// e.g. "release_group" instead of "release-group".
+func dbEntityType(entity Entity) string {
+	return strings.ReplaceAll(string(entity), "-", "_")
+}
+
+// normalizeTypeName normalizes name for comparisons in FindLinkType and FindLinkAttributeType.
+func normalizeTypeName(name string) string {
+	return normalizeArtistName(strutil.Normalize(name))
+}
+
+// sortedUnique sorts vals and removes duplicates.
+func sortedUnique(vals []string) []string {
+	sort.Strings(vals)
+	var res []string
+	for i, v := range vals {
+		if i == 0 || v != vals[i-1] {
+			res = append(res, v)
+		}
+	}
+	return res
+}
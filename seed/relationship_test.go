@@ -0,0 +1,112 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package seed
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFindLinkType(t *testing.T) {
+	for _, tc := range []struct {
+		entity, target Entity
+		name           string
+		want           LinkType
+		errSub         string // substring of expected error
+	}{
+		{RecordingEntity, "", "producer", LinkType_Producer_Artist_Recording, ""},
+		{RecordingEntity, "", "Producer", LinkType_Producer_Artist_Recording, ""},
+		{RecordingEntity, "artist", "producer", LinkType_Producer_Artist_Recording, ""},
+		{SeriesEntity, "", "part of", 0, "ambiguous series link type (target type could be "},
+		{SeriesEntity, RecordingEntity, "part of", LinkType_PartOf_Recording_Series, ""},
+		{SeriesEntity, "release-group", "part-of", LinkType_PartOf_ReleaseGroup_Series, ""},
+		{WorkEntity, "", "lyricist", LinkType_Lyricist_Artist_Work, ""},
+		{WorkEntity, "", "bogus", 0, "unknown work link type (valid types: "},
+		{WorkEntity, "", "discogs", 0, "unknown work link type"}, // URL types are excluded
+		{WorkEntity, LabelEntity, "producer", 0, "unknown work-label link type"},
+		{WorkEntity, "bogus", "producer", 0, "no work-bogus link types"},
+	} {
+		got, err := FindLinkType(tc.entity, tc.target, tc.name)
+		if tc.errSub != "" {
+			if err == nil {
+				t.Errorf("FindLinkType(%q, %q, %q) unexpectedly succeeded", tc.entity, tc.target, tc.name)
+			} else if !strings.Contains(err.Error(), tc.errSub) {
+				t.Errorf("FindLinkType(%q, %q, %q) failed with %q; want %q",
+					tc.entity, tc.target, tc.name, err, tc.errSub)
+			}
+		} else if err != nil {
+			t.Errorf("FindLinkType(%q, %q, %q) failed: %v", tc.entity, tc.target, tc.name, err)
+		} else if got != tc.want {
+			t.Errorf("FindLinkType(%q, %q, %q) = %v; want %v", tc.entity, tc.target, tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestFindURLLinkType(t *testing.T) {
+	if got, err := FindURLLinkType(ArtistEntity, "Discogs"); err != nil {
+		t.Error("FindURLLinkType failed: ", err)
+	} else if got != LinkType_Discogs_Artist_URL {
+		t.Errorf("FindURLLinkType returned %v; want %v", got, LinkType_Discogs_Artist_URL)
+	}
+	if _, err := FindURLLinkType(RecordingEntity, "producer"); err == nil {
+		t.Error("FindURLLinkType unexpectedly succeeded for non-URL type")
+	}
+}
+
+func TestFindLinkType_URLTable(t *testing.T) {
+	// Use a fake table so place and series URL lookups are checked even if the
+	// generated names don't include them.
+	names := map[[2]string]map[string]LinkType{
+		{"place", "place"}:   {"part of": 1},
+		{"place", "url"}:     {"official homepage": 2, "wikidata": 3},
+		{"series", "url"}:    {"wikidata": 4},
+		{"series", "series"}: {"part of": 5},
+	}
+	for _, tc := range []struct {
+		src, target, name string
+		want              LinkType // 0 if an error is expected
+	}{
+		{"place", "url", "Official Homepage", 2},
+		{"place", "url", "wikidata", 3},
+		{"place", "url", "part of", 0},
+		{"place", "", "part of", 1},
+		{"place", "", "wikidata", 0}, // URL types aren't searched
+		{"series", "url", "wikidata", 4},
+		{"series", "url", "youtube", 0},
+		{"artist", "url", "wikidata", 0},
+	} {
+		got, err := findLinkType(names, tc.src, tc.target, tc.name)
+		if tc.want == 0 && err == nil {
+			t.Errorf("findLinkType(%q, %q, %q) unexpectedly returned %v", tc.src, tc.target, tc.name, got)
+		} else if tc.want != 0 && err != nil {
+			t.Errorf("findLinkType(%q, %q, %q) failed: %v", tc.src, tc.target, tc.name, err)
+		} else if got != tc.want {
+			t.Errorf("findLinkType(%q, %q, %q) = %v; want %v", tc.src, tc.target, tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestFindLinkAttributeType(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		want LinkAttributeType
+	}{
+		{"guitar", LinkAttributeType_Guitar},
+		{"Acoustic Guitar", LinkAttributeType_AcousticGuitar},
+		{"12-string guitar", LinkAttributeType_12StringGuitar},
+		{"tar lute", 560}, // renamed to avoid a conflict
+		{"bogus", 0},
+	} {
+		got, err := FindLinkAttributeType(tc.name)
+		if tc.want == 0 {
+			if err == nil {
+				t.Errorf("FindLinkAttributeType(%q) unexpectedly succeeded", tc.name)
+			}
+		} else if err != nil {
+			t.Errorf("FindLinkAttributeType(%q) failed: %v", tc.name, err)
+		} else if got != tc.want {
+			t.Errorf("FindLinkAttributeType(%q) = %v; want %v", tc.name, got, tc.want)
+		}
+	}
+}
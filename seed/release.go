@@ -22,42 +22,42 @@ import (
 // this form.
 type Release struct {
 	// MBID contains the release's MBID (for editing an existing release rather than creating a new one).
-	MBID string
+	MBID string `json:"mbid,omitempty"`
 	// Title contains the release's title.
-	Title string
+	Title string `json:"title,omitempty"`
 	// ReleaseGroup the MBID of an existing release group.
 	// See https://musicbrainz.org/doc/Release_Group.
-	ReleaseGroup string
+	ReleaseGroup string `json:"release_group,omitempty"`
 	// Types contains types for a new release group (if ReleaseGroup is empty).
 	// See https://wiki.musicbrainz.org/Release_Group/Type.
-	Types []ReleaseGroupType
+	Types []ReleaseGroupType `json:"types,omitempty"`
 	// Disambiguation differentiates this release from other releases with similar names.
 	// See https://musicbrainz.org/doc/Disambiguation_Comment.
-	Disambiguation string
+	Disambiguation string `json:"disambiguation,omitempty"`
 	// Annotation contains additional information that doesn't fit in MusicBrainz's data scheme.
 	// See https://musicbrainz.org/doc/Annotation.
-	Annotation string
+	Annotation string `json:"annotation,omitempty"`
 	// Barcode contains the release's barcode. "none" indicates that the release has no barcode.
-	Barcode string
+	Barcode string `json:"barcode,omitempty"`
 	// Language contains the release's language as an ISO 639-3 code (e.g. "eng", "deu", "jpn").
 	// See https://en.wikipedia.org/wiki/List_of_ISO_639-3_codes.
-	Language string
+	Language string `json:"language,omitempty"`
 	// Script contains the script of the text on the release as an ISO 15924 code (e.g. "Latn", "Cyrl").
 	// See https://en.wikipedia.org/wiki/ISO_15924.
-	Script string
+	Script string `json:"script,omitempty"`
 	// Status contains the release's status.
-	Status ReleaseStatus
+	Status ReleaseStatus `json:"status,omitempty"`
 	// Packaging contains the release's packaging as an English string.
 	// See https://wiki.musicbrainz.org/Release/Packaging.
-	Packaging ReleasePackaging
+	Packaging ReleasePackaging `json:"packaging,omitempty"`
 	// Events contains events corresponding to this release.
-	Events []ReleaseEvent
+	Events []ReleaseEvent `json:"events,omitempty"`
 	// Labels contains label-related information corresponding to this release.
-	Labels []ReleaseLabel
+	Labels []ReleaseLabel `json:"labels,omitempty"`
 	// ArtistCredits contains artists credited with the release.
-	Artists []ArtistCredit
+	Artists []ArtistCredit `json:"artists,omitempty"`
 	// Mediums contains the release's media (which themselves contain tracklists).
-	Mediums []Medium
+	Mediums []Medium `json:"mediums,omitempty"`
 	// URLs contains relationships between this release and one or more URLs.
 	// See https://musicbrainz.org/doc/Style/Relationships/URLs.
 	//
@@ -71,14 +71,19 @@ type Release struct {
 	//  LinkType_ShowNotes_Release_URL ("show notes")
 	//  LinkType_Crowdfunding_Release_URL ("crowdfunding page")
 	//  LinkType_Streaming_Release_URL ("streaming page")
-	URLs []URL
+	URLs []URL `json:"urls,omitempty"`
+	// RecordingMatches describes the results of matching tracks to existing recordings by ISRC.
+	// It is filled by Finish (see MatchRecordings), isn't seeded, and is reported by Validate.
+	RecordingMatches []Problem `json:"recording_matches,omitempty"`
+	// Tags contains tags to add to the existing release. See GetSubmission.
+	Tags []string `json:"tags,omitempty"`
 	// EditNote contains the note attached to the edit.
 	// See https://musicbrainz.org/doc/Edit_Note.
-	EditNote string
+	EditNote string `json:"edit_note,omitempty"`
 	// RedirectURI contains a URL for MusicBrainz to redirect to after the edit is created.
 	// The MusicBrainz server will add a "release_mbid" query parameter containing the
 	// new release's MBID.
-	RedirectURI string
+	RedirectURI string `json:"redirect_uri,omitempty"`
 }
 
 func (rel *Release) Entity() Entity { return ReleaseEntity }
@@ -148,12 +153,44 @@ func (rel *Release) Params() url.Values {
 
 func (rel *Release) Method() string { return http.MethodPost }
 
-func (rel *Release) Finish(ctx context.Context, db *mbdb.DB) error { return nil }
+func (rel *Release) Finish(ctx context.Context, db *mbdb.DB) error {
+	finishURLs(rel.URLs, ReleaseEntity)
+	rel.RecordingMatches = rel.MatchRecordings(ctx, db)
+	return nil
+}
 
 // Autofill attempts to automatically fill empty fields in rel.
+// Featured artists listed in the release and track titles (e.g. "Title (feat. Artist)")
+// are moved to the corresponding artist credits.
 // The Language and Script fields are filled based on the release and track titles.
+// The Types field is filled based on the titles, track lengths, and track artists;
+// reasons for guessed secondary types are appended to EditNote.
 // If network is true, network requests may be made.
 func (rel *Release) Autofill(ctx context.Context, network bool) {
+	// Tracks without their own artists are credited to the release's artists, so
+	// copy the release's credits before adding featured artists to them.
+	relArtists := rel.Artists
+	rel.Title, rel.Artists = moveFeaturedArtists(rel.Title, rel.Artists, false)
+	relFeat := len(rel.Artists) != len(relArtists)
+	for i := range rel.Mediums {
+		for j := range rel.Mediums[i].Tracks {
+			tr := &rel.Mediums[i].Tracks[j]
+			acs := tr.Artists
+			if len(acs) == 0 {
+				acs = relArtists
+			}
+			title, feat := moveFeaturedArtists(tr.Title, acs, false)
+			tr.Title = title
+			if len(feat) != len(acs) {
+				tr.Artists = feat
+			} else if len(tr.Artists) == 0 && relFeat {
+				// Don't let the track inherit the release's featured artists
+				// if they aren't also credited in the track's title.
+				tr.Artists = append([]ArtistCredit(nil), relArtists...)
+			}
+		}
+	}
+
 	if rel.Language == "" || rel.Script == "" {
 		titles := []string{rel.Title}
 		for _, med := range rel.Mediums {
@@ -215,6 +252,26 @@ func (rel *Release) Autofill(ctx context.Context, network bool) {
 			rel.Types = append(rel.Types, ReleaseGroupType_Album)
 		}
 	}
+
+	// Guess secondary types if none were supplied, and explain the guesses in the edit note
+	// since they're less reliable.
+	var hasSecondary bool
+	for _, t := range rel.Types {
+		if _, ok := rgSecondaryTypeIDs[t]; ok {
+			hasSecondary = true
+		}
+	}
+	if guesses := guessSecondaryTypes(rel); !hasSecondary && len(guesses) > 0 {
+		note := "Guessed release group types:"
+		for _, g := range guesses {
+			rel.Types = append(rel.Types, g.typ)
+			note += fmt.Sprintf("\n* %s: %s", g.typ, g.reason)
+		}
+		if rel.EditNote != "" {
+			note = rel.EditNote + "\n\n" + note
+		}
+		rel.EditNote = note
+	}
 }
 
 const (
@@ -253,10 +310,10 @@ func NewAddCoverArtEdit(desc, mbid string) (*Info, error) {
 // ReleaseEvent contains an event corresponding to a release. Unknown fields can be omitted.
 type ReleaseEvent struct {
 	// Date contains the event's date.
-	Date Date
+	Date Date `json:"date,omitempty"`
 	// Country contains the event's country as an ISO code (e.g. "GB", "US", "FR").
 	// "XW" corresponds to "[Worldwide]".
-	Country string
+	Country string `json:"country,omitempty"`
 }
 
 // setParams sets query parameters in vals corresponding to non-empty fields in ev.
@@ -271,11 +328,11 @@ func (ev *ReleaseEvent) setParams(vals url.Values, prefix string) {
 // ReleaseLabel contains label-related information associated with a release.
 type ReleaseLabel struct {
 	// MBID contains the label's MBID if known.
-	MBID string
+	MBID string `json:"mbid,omitempty"`
 	// CatalogNumber contains the release's catalog number.
-	CatalogNumber string
+	CatalogNumber string `json:"catalog_number,omitempty"`
 	// Name contains the label's name (to prefill the search field if MBID is empty).
-	Name string
+	Name string `json:"name,omitempty"`
 }
 
 // setParams sets query parameters in vals corresponding to non-empty fields in rl.
@@ -293,11 +350,11 @@ func (rl *ReleaseLabel) setParams(vals url.Values, prefix string) {
 type Medium struct {
 	// Format contains the medium's format name.
 	// See https://wiki.musicbrainz.org/Release/Format.
-	Format MediumFormat
+	Format MediumFormat `json:"format,omitempty"`
 	// Name contains the medium's name (e.g. "Live & Unreleased").
-	Name string
+	Name string `json:"name,omitempty"`
 	// Tracks contains the medium's tracklist.
-	Tracks []Track
+	Tracks []Track `json:"tracks,omitempty"`
 	// TODO: Include position? It's inferred based on order, so maybe not.
 }
 
@@ -318,15 +375,19 @@ func (m *Medium) setParams(vals url.Values, prefix string) {
 // See https://musicbrainz.org/doc/Track.
 type Track struct {
 	// Title contains the track's name.
-	Title string
+	Title string `json:"title,omitempty"`
 	// Number contains a free-form track number.
-	Number string
+	Number string `json:"number,omitempty"`
 	// Recording contains the MBID of the recording corresponding to the track.
-	Recording string
+	Recording string `json:"recording,omitempty"`
 	// Length contains the track's duration.
-	Length time.Duration
+	Length time.Duration `json:"length,omitempty"`
 	// Artists contains the artists credited with the track.
-	Artists []ArtistCredit
+	Artists []ArtistCredit `json:"artists,omitempty"`
+	// ISRC contains the ISRC of the track's recording, if known.
+	// It isn't seeded, but it's used by Release.Finish to fill Recording.
+	// See https://musicbrainz.org/doc/ISRC.
+	ISRC string `json:"isrc,omitempty"`
 }
 
 // setParams sets query parameters in vals corresponding to non-empty fields in tr.
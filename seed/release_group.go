@@ -0,0 +1,137 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package seed
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/derat/yambs/mbdb"
+)
+
+// ReleaseGroup holds data used to seed the "Add Release Group" form at
+// https://musicbrainz.org/release-group/create and the edit-release-group form at
+// https://musicbrainz.org/release-group/<MBID>/edit.
+// See https://musicbrainz.org/doc/Release_Group for more information about release group entities.
+//
+// Release groups are also created implicitly when a Release with an empty ReleaseGroup
+// field is seeded, but this type can be used to create a release group ahead of time.
+type ReleaseGroup struct {
+	// MBID contains the release group's MBID (for editing an existing release group rather than
+	// creating a new one).
+	MBID string `json:"mbid,omitempty"`
+	// Title contains the release group's title.
+	Title string `json:"title,omitempty"`
+	// Types contains the release group's primary type and any secondary types.
+	// If multiple primary types are supplied, only the first is used.
+	// See https://musicbrainz.org/doc/Release_Group/Type.
+	Types []ReleaseGroupType `json:"types,omitempty"`
+	// Disambiguation differentiates this release group from other release groups with
+	// similar names. See https://musicbrainz.org/doc/Disambiguation_Comment.
+	Disambiguation string `json:"disambiguation,omitempty"`
+	// Artists contains the release group's artist credits.
+	Artists []ArtistCredit `json:"artists,omitempty"`
+	// Relationships contains (non-URL) relationships between this release group and other entities.
+	Relationships []Relationship `json:"relationships,omitempty"`
+	// URLs contains relationships between this release group and one or more URLs,
+	// e.g. LinkType_Wikidata_ReleaseGroup_URL or LinkType_Review_ReleaseGroup_URL.
+	// See https://musicbrainz.org/doc/Style/Relationships/URLs.
+	URLs []URL `json:"urls,omitempty"`
+	// Changes lists modifications to the existing release group. See Edit.
+	Changes []string `json:"changes,omitempty"`
+	// Tags contains tags to add to the existing release group. See GetSubmission.
+	Tags []string `json:"tags,omitempty"`
+	// Rating contains a 1-5 rating for the existing release group. See GetSubmission.
+	Rating int `json:"rating,omitempty"`
+	// EditNote contains the note attached to the edit.
+	// See https://musicbrainz.org/doc/Edit_Note.
+	EditNote string `json:"edit_note,omitempty"`
+}
+
+func (rg *ReleaseGroup) Entity() Entity { return ReleaseGroupEntity }
+
+func (rg *ReleaseGroup) Description() string {
+	var parts []string
+	if rg.MBID != "" {
+		parts = append(parts, truncate(rg.MBID, mbidPrefixLen, false))
+	}
+	if rg.Title != "" {
+		parts = append(parts, truncate(rg.Title, maxDescLen, true))
+	}
+	if s := artistCreditsDesc(rg.Artists); s != "" {
+		parts = append(parts, s)
+	}
+	if len(rg.Changes) > 0 {
+		parts = append(parts, strings.Join(rg.Changes, "; "))
+	}
+	if len(parts) == 0 {
+		return "[unknown]"
+	}
+	return strings.Join(parts, " / ")
+}
+
+func (rg *ReleaseGroup) URL(serverURL string) string {
+	if rg.MBID != "" {
+		return serverURL + "/release-group/" + rg.MBID + "/edit"
+	}
+	return serverURL + "/release-group/create"
+}
+
+func (rg *ReleaseGroup) Params() url.Values {
+	// Unlike the /release/add form, the release group form takes
+	// database IDs rather than names for its types.
+	vals := make(url.Values)
+	if rg.Title != "" {
+		vals.Set("edit-release-group.name", rg.Title)
+	}
+	if rg.Disambiguation != "" {
+		vals.Set("edit-release-group.comment", rg.Disambiguation)
+	}
+	var nsec int
+	for _, t := range rg.Types {
+		if id, ok := rgPrimaryTypeIDs[t]; ok {
+			if vals.Get("edit-release-group.primary_type_id") == "" {
+				vals.Set("edit-release-group.primary_type_id", strconv.Itoa(id))
+			}
+		} else if id, ok := rgSecondaryTypeIDs[t]; ok {
+			vals.Set(fmt.Sprintf("edit-release-group.secondary_type_ids.%d", nsec), strconv.Itoa(id))
+			nsec++
+		}
+	}
+	for i, ac := range rg.Artists {
+		ac.setParams(vals, fmt.Sprintf("edit-release-group.artist_credit.names.%d.", i))
+	}
+	for i, rel := range rg.Relationships {
+		rel.setParams(vals, fmt.Sprintf("rels.%d.", i))
+	}
+	for i, u := range rg.URLs {
+		u.setParams(vals, fmt.Sprintf("edit-release-group.url.%d.", i), rg.Method())
+	}
+	if rg.EditNote != "" {
+		vals.Set("edit-release-group.edit_note", rg.EditNote)
+	}
+	return vals
+}
+
+func (rg *ReleaseGroup) Method() string { return http.MethodGet }
+
+func (rg *ReleaseGroup) Finish(ctx context.Context, db *mbdb.DB) error {
+	finishURLs(rg.URLs, ReleaseGroupEntity)
+	// Like the recording form, the release group form seems to require artists' database IDs.
+	for i := range rg.Artists {
+		ac := &rg.Artists[i]
+		if ac.MBID != "" {
+			var err error
+			if ac.ID, err = db.GetDatabaseID(ctx, ac.MBID); err != nil {
+				return err
+			}
+			ac.MBID = ""
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package seed
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/derat/yambs/mbdb"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestReleaseGroup_Params(t *testing.T) {
+	const (
+		artistMBID = "b10bbbfc-cf9e-42e0-be17-e2c3e1d2600d"
+		artistID   = 303
+	)
+	rg := ReleaseGroup{
+		Title:          "Let It Be",
+		Types:          []ReleaseGroupType{ReleaseGroupType_Compilation, ReleaseGroupType_Album, ReleaseGroupType_Live},
+		Disambiguation: "for testing",
+		Artists:        []ArtistCredit{{MBID: artistMBID, NameAsCredited: "Beatles"}},
+		Relationships: []Relationship{{
+			Target: "e8ba1a80-a1a2-43b1-9b2e-0fea3e9f1a05",
+			Type:   LinkType_Cover_ReleaseGroup_ReleaseGroup,
+		}},
+		URLs: []URL{{
+			URL:      "https://www.wikidata.org/wiki/Q199585",
+			LinkType: LinkType_Wikidata_ReleaseGroup_URL,
+		}},
+		EditNote: "here's the edit note",
+	}
+
+	db := mbdb.NewDB(mbdb.DisallowQueries)
+	db.SetDatabaseIDForTest(artistMBID, artistID)
+	if err := rg.Finish(context.Background(), db); err != nil {
+		t.Fatal("Finish failed:", err)
+	}
+
+	rel := rg.Relationships[0]
+	want := url.Values{
+		"edit-release-group.name":                            {rg.Title},
+		"edit-release-group.comment":                         {rg.Disambiguation},
+		"edit-release-group.primary_type_id":                 {"1"},
+		"edit-release-group.secondary_type_ids.0":            {"1"},
+		"edit-release-group.secondary_type_ids.1":            {"6"},
+		"edit-release-group.artist_credit.names.0.artist.id": {strconv.Itoa(artistID)},
+		"edit-release-group.artist_credit.names.0.name":      {"Beatles"},
+		"rels.0.target":                                      {rel.Target},
+		"rels.0.type":                                        {strconv.Itoa(int(rel.Type))},
+		"edit-release-group.url.0.text":                      {rg.URLs[0].URL},
+		"edit-release-group.url.0.link_type_id":              {strconv.Itoa(int(rg.URLs[0].LinkType))},
+		"edit-release-group.edit_note":                       {rg.EditNote},
+	}
+	if diff := cmp.Diff(want, rg.Params()); diff != "" {
+		t.Error("Incorrect query params:\n" + diff)
+	}
+}
@@ -9,9 +9,11 @@ import (
 	"net/url"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/derat/yambs/mbdb"
 	"github.com/google/go-cmp/cmp"
 )
 
@@ -59,6 +61,212 @@ func TestRelease_Autofill_Types(t *testing.T) {
 	}
 }
 
+func TestRelease_Autofill_Featured(t *testing.T) {
+	rel := Release{
+		Title:   "Single (feat. B)",
+		Artists: []ArtistCredit{{MBID: "a-mbid", Name: "A"}},
+		Mediums: []Medium{{Tracks: []Track{
+			{Title: "Single (feat. B)"},
+			{Title: "Plain"},
+			{Title: "Other [ft. C & D] (Remix)"},
+			{Title: "Another featuring E", Artists: []ArtistCredit{{Name: "F"}}},
+			{Title: "Already (feat. G)", Artists: []ArtistCredit{{Name: "A", JoinPhrase: " & "}, {Name: "G."}}},
+			{Title: "A Feat of Strength"},
+		}}},
+	}
+	rel.Autofill(context.Background(), false /* network */)
+
+	want := Release{
+		Title: "Single",
+		Artists: []ArtistCredit{
+			{MBID: "a-mbid", Name: "A", JoinPhrase: " feat. "},
+			{Name: "B"},
+		},
+		Script: "Latn",
+		Mediums: []Medium{{Tracks: []Track{
+			{Title: "Single", Artists: []ArtistCredit{
+				{MBID: "a-mbid", Name: "A", JoinPhrase: " feat. "},
+				{Name: "B"},
+			}},
+			{Title: "Plain", Artists: []ArtistCredit{{MBID: "a-mbid", Name: "A"}}},
+			{Title: "Other (Remix)", Artists: []ArtistCredit{
+				{MBID: "a-mbid", Name: "A", JoinPhrase: " feat. "},
+				{Name: "C", JoinPhrase: " & "},
+				{Name: "D"},
+			}},
+			{Title: "Another", Artists: []ArtistCredit{
+				{Name: "F", JoinPhrase: " feat. "},
+				{Name: "E"},
+			}},
+			{Title: "Already", Artists: []ArtistCredit{{Name: "A", JoinPhrase: " & "}, {Name: "G."}}},
+			{Title: "A Feat of Strength", Artists: []ArtistCredit{{MBID: "a-mbid", Name: "A"}}},
+		}}},
+	}
+	if diff := cmp.Diff(want, rel); diff != "" {
+		t.Error("Bad release after Autofill:\n" + diff)
+	}
+}
+
+func TestRelease_Autofill_FeaturedTrackCredits(t *testing.T) {
+	// Tracks should only be left to inherit the release's artists if they don't
+	// pick up featured artists from the release's title.
+	for _, tc := range []struct {
+		relTitle string
+		tracks   []Track
+		want     []Track
+	}{
+		{"Album", []Track{{Title: "One"}}, []Track{{Title: "One"}}},
+		{"Album (feat. B)", []Track{{Title: "One"}, {Title: "Two (feat. B)"}}, []Track{
+			{Title: "One", Artists: []ArtistCredit{{Name: "A"}}},
+			{Title: "Two", Artists: []ArtistCredit{{Name: "A", JoinPhrase: " feat. "}, {Name: "B"}}},
+		}},
+		{"Album (feat. B)", []Track{{Title: "One", Artists: []ArtistCredit{{Name: "C"}}}}, []Track{
+			{Title: "One", Artists: []ArtistCredit{{Name: "C"}}},
+		}},
+	} {
+		rel := Release{
+			Title:   tc.relTitle,
+			Artists: []ArtistCredit{{Name: "A"}},
+			Mediums: []Medium{{Tracks: tc.tracks}},
+		}
+		rel.Autofill(context.Background(), false /* network */)
+		if diff := cmp.Diff(tc.want, rel.Mediums[0].Tracks); diff != "" {
+			t.Errorf("Bad tracks after Autofill for %q:\n%s", tc.relTitle, diff)
+		}
+	}
+}
+
+func TestRelease_Autofill_SecondaryTypes(t *testing.T) {
+	// mkrel creates a release with the supplied title and track titles.
+	// Track titles may be suffixed by "|artist" to set the track's artist.
+	mkrel := func(title string, tracks ...string) *Release {
+		rel := Release{Title: title, Types: []ReleaseGroupType{ReleaseGroupType_Album},
+			Mediums: []Medium{{}}}
+		for _, tr := range tracks {
+			var artists []ArtistCredit
+			if i := strings.Index(tr, "|"); i >= 0 {
+				artists = []ArtistCredit{{Name: tr[i+1:]}}
+				tr = tr[:i]
+			}
+			rel.Mediums[0].Tracks = append(rel.Mediums[0].Tracks, Track{Title: tr, Artists: artists})
+		}
+		return &rel
+	}
+
+	ctx := context.Background()
+	for _, tc := range []struct {
+		rel   *Release
+		types []ReleaseGroupType // secondary types
+		note  string
+	}{
+		{mkrel("Plain", "A", "B"), nil, ""},
+		{mkrel("Live at Budokan", "A", "B"), []ReleaseGroupType{ReleaseGroupType_Live},
+			`* Live: title contains "Live at"`},
+		{mkrel("1977-05-08: Barton Hall", "A", "B"), []ReleaseGroupType{ReleaseGroupType_Live},
+			"* Live: title contains date and venue"},
+		{mkrel("Tour", "A (Live)", "B [Live in Paris]", "C"), []ReleaseGroupType{ReleaseGroupType_Live},
+			"* Live: 2 of 3 tracks are live"},
+		{mkrel("Tour", "A (Live)", "B", "C"), nil, ""},
+		{mkrel("Red Rocks, 6/5/1999", "A", "B"), []ReleaseGroupType{ReleaseGroupType_Live},
+			"* Live: title contains date and venue"},
+		{mkrel("Oliver", "A", "B"), nil, ""},
+		{mkrel("Greatest Hits", "A", "B"), []ReleaseGroupType{ReleaseGroupType_Compilation},
+			`* Compilation: title contains "Greatest Hits"`},
+		{mkrel("Various", "A|1", "B|2", "C|3", "D|4", "E|4"), []ReleaseGroupType{ReleaseGroupType_Compilation},
+			"* Compilation: 4 different track artists"},
+		{mkrel("Mostly One", "A|1", "B|1", "C|1", "D|2", "E|3"), nil, ""},
+		{mkrel("Redone", "A (Remix)", "B (X Rmx)", "C"), []ReleaseGroupType{ReleaseGroupType_Remix},
+			"* Remix: 2 of 3 tracks are remixes"},
+		{mkrel("Film (Original Motion Picture Soundtrack)", "A"), []ReleaseGroupType{ReleaseGroupType_Soundtrack},
+			`* Soundtrack: title contains "Original Motion Picture Soundtrack"`},
+		{mkrel("Club Night (DJ Mix)", "A", "B"), []ReleaseGroupType{ReleaseGroupType_DJMix},
+			`* DJ-mix: title contains "DJ Mix"`},
+	} {
+		rel := tc.rel
+		title := rel.Title
+		rel.Autofill(ctx, false /* network */)
+		want := append([]ReleaseGroupType{ReleaseGroupType_Album}, tc.types...)
+		if !reflect.DeepEqual(rel.Types, want) {
+			t.Errorf("Autofill on %q set types %q; want %q", title, rel.Types, want)
+		}
+		var wantNote string
+		if tc.note != "" {
+			wantNote = "Guessed release group types:\n" + tc.note
+		}
+		if rel.EditNote != wantNote {
+			t.Errorf("Autofill on %q set edit note %q; want %q", title, rel.EditNote, wantNote)
+		}
+	}
+
+	// Secondary types shouldn't be guessed if one was already supplied.
+	rel := mkrel("Live at Budokan", "A")
+	rel.Types = append(rel.Types, ReleaseGroupType_Compilation)
+	rel.Autofill(ctx, false /* network */)
+	if want := []ReleaseGroupType{ReleaseGroupType_Album, ReleaseGroupType_Compilation}; !reflect.DeepEqual(rel.Types, want) {
+		t.Errorf("Autofill with existing secondary type set types %q; want %q", rel.Types, want)
+	}
+}
+
+func TestRelease_MatchRecordings(t *testing.T) {
+	const (
+		mbid1 = "0a1b2c3d-0000-4000-8000-000000000001"
+		mbid2 = "0a1b2c3d-0000-4000-8000-000000000002"
+		mbid3 = "0a1b2c3d-0000-4000-8000-000000000003"
+		mbid4 = "0a1b2c3d-0000-4000-8000-000000000004"
+	)
+	db := mbdb.NewDB(mbdb.DisallowQueries)
+	db.SetRecordingsFromISRCForTest("USAAA0000001", []mbdb.RecordingInfo{{MBID: mbid1, Length: 180 * time.Second}})
+	db.SetRecordingsFromISRCForTest("USAAA0000002", []mbdb.RecordingInfo{
+		{MBID: mbid2, Length: 201 * time.Second},
+		{MBID: mbid3, Length: 300 * time.Second}, // too long
+	})
+	db.SetRecordingsFromISRCForTest("USAAA0000003", []mbdb.RecordingInfo{
+		{MBID: mbid3, Length: 120 * time.Second},
+		{MBID: mbid4}, // unknown length
+	})
+	db.SetRecordingsFromISRCForTest("USAAA0000004", []mbdb.RecordingInfo{{MBID: mbid4, Length: time.Minute}})
+
+	rel := Release{
+		Mediums: []Medium{
+			{Tracks: []Track{
+				{Title: "Matched", Length: 182 * time.Second, ISRC: "usaaa0000001"},
+				{Title: "Close Enough", Length: 200 * time.Second, ISRC: "USAAA0000002"},
+				{Title: "No ISRC", Length: time.Minute},
+			}},
+			{Tracks: []Track{
+				{Title: "Ambiguous", Length: 2 * time.Minute, ISRC: "USAAA0000003"},
+				{Title: "Wrong Length", Length: 2 * time.Minute, ISRC: "USAAA0000004"},
+				{Title: "Missing", Length: time.Minute, ISRC: "USAAA0000005"},
+				{Title: "Already Set", Recording: mbid1, ISRC: "USAAA0000004"},
+				{Title: "Unknown Length", ISRC: "USAAA0000004"},
+			}},
+		},
+	}
+	problems := rel.MatchRecordings(context.Background(), db)
+
+	var got []string
+	for _, med := range rel.Mediums {
+		for _, tr := range med.Tracks {
+			got = append(got, tr.Recording)
+		}
+	}
+	if want := []string{mbid1, mbid2, "", "", "", "", mbid1, ""}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MatchRecordings set recordings %q; want %q", got, want)
+	}
+
+	want := []Problem{
+		{"Mediums[0].Tracks[0].Recording", "matched recording " + mbid1 + " by ISRC usaaa0000001"},
+		{"Mediums[0].Tracks[1].Recording", "matched recording " + mbid2 + " by ISRC USAAA0000002"},
+		{"Mediums[1].Tracks[0].ISRC", "ISRC USAAA0000003 is ambiguous (2 recording(s), 1 with unknown length)"},
+		{"Mediums[1].Tracks[1].ISRC", "no recording with ISRC USAAA0000004 and compatible length"},
+		{"Mediums[1].Tracks[2].ISRC", "no recording with ISRC USAAA0000005 and compatible length"},
+		{"Mediums[1].Tracks[4].ISRC", "ISRC USAAA0000004 is ambiguous (1 recording(s), 1 with unknown length)"},
+	}
+	if diff := cmp.Diff(want, problems); diff != "" {
+		t.Error("MatchRecordings returned bad problems:\n" + diff)
+	}
+}
+
 func TestRelease_URL(t *testing.T) {
 	const srvURL = "https://test.musicbrainz.org"
 	for _, tc := range []struct{ mbid, want string }{
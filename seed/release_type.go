@@ -0,0 +1,107 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package seed
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// typeGuess describes a secondary release group type guessed by guessSecondaryTypes.
+type typeGuess struct {
+	typ    ReleaseGroupType
+	reason string // human-readable explanation, e.g. `title contains "Live at"`
+}
+
+const minCompilationArtists = 4 // min distinct track artists for a compilation
+
+var (
+	// liveTitleRegexp matches release titles like "Live at Budokan" or "Alive (Live)".
+	liveTitleRegexp = regexp.MustCompile(`(?i)\blive (at|from|in|on)\b|[(\[]live[)\]]`)
+	// liveTrackRegexp matches track titles like "Song (Live)", "Song [Live at Budokan]",
+	// or "Song - Live".
+	liveTrackRegexp = regexp.MustCompile(`(?i)[(\[][^)\]]*\blive\b[^)\]]*[)\]]|\s-\s.*\blive\b`)
+	// liveDateRegexp matches a date adjacent to a venue, e.g. "1977-05-08: Barton Hall"
+	// or "Red Rocks, 6/5/1999".
+	liveDateRegexp = func() *regexp.Regexp {
+		date := `(\d{4}-\d{1,2}-\d{1,2}|\d{1,2}/\d{1,2}/\d{2,4}|\d{1,2}\.\d{1,2}\.\d{2,4})`
+		sep := `\s*([-:,@]|\bat\b)\s*`
+		return regexp.MustCompile(`(?i)` + date + sep + `\pL|\pL` + sep + date)
+	}()
+	compilationTitleRegexp = regexp.MustCompile(`(?i)\b(greatest hits|best of)\b`)
+	remixTrackRegexp       = regexp.MustCompile(`(?i)\b(re-?mix|rmx)\b`)
+	soundtrackTitleRegexp  = regexp.MustCompile(`(?i)\boriginal (motion picture |television |video game |game )?` +
+		`(soundtrack|score)\b|\bmusic from the (motion picture|film)\b|\bo\.?s\.?t\b`)
+	djMixTitleRegexp = regexp.MustCompile(`(?i)\bdj[- ]mix|\bmixed by\b|\bcontinuous mix\b`)
+)
+
+// guessSecondaryTypes uses rel's titles and tracks to guess its secondary release group types.
+// See https://musicbrainz.org/doc/Release_Group/Type.
+func guessSecondaryTypes(rel *Release) []typeGuess {
+	var numTracks, liveTracks, remixTracks int
+	trackArtists := make(map[string]struct{})
+	for _, med := range rel.Mediums {
+		for _, tr := range med.Tracks {
+			numTracks++
+			if liveTrackRegexp.MatchString(tr.Title) {
+				liveTracks++
+			}
+			if remixTrackRegexp.MatchString(tr.Title) {
+				remixTracks++
+			}
+			if len(tr.Artists) > 0 {
+				ac := tr.Artists[0]
+				name := ac.Name
+				if name == "" {
+					name = ac.NameAsCredited
+				}
+				trackArtists[normalizeArtistName(ac.MBID+name)] = struct{}{}
+			}
+		}
+	}
+	most := func(n int) bool { return numTracks > 0 && n*2 > numTracks }
+	title := func(re *regexp.Regexp) string {
+		if m := re.FindString(rel.Title); m != "" {
+			return fmt.Sprintf("title contains %q", strings.TrimSpace(m))
+		}
+		return ""
+	}
+	tracks := func(n int, desc string) string {
+		if most(n) {
+			return fmt.Sprintf("%d of %d tracks %s", n, numTracks, desc)
+		}
+		return ""
+	}
+	first := func(reasons ...string) string {
+		for _, r := range reasons {
+			if r != "" {
+				return r
+			}
+		}
+		return ""
+	}
+
+	var guesses []typeGuess
+	add := func(typ ReleaseGroupType, reason string) {
+		if reason != "" {
+			guesses = append(guesses, typeGuess{typ, reason})
+		}
+	}
+
+	var artistReason string
+	if n := len(trackArtists); n >= minCompilationArtists && most(n) {
+		artistReason = fmt.Sprintf("%d different track artists", n)
+	}
+	add(ReleaseGroupType_Compilation, first(title(compilationTitleRegexp), artistReason))
+	add(ReleaseGroupType_DJMix, title(djMixTitleRegexp))
+	var dateReason string
+	if liveDateRegexp.MatchString(rel.Title) {
+		dateReason = "title contains date and venue"
+	}
+	add(ReleaseGroupType_Live, first(title(liveTitleRegexp), dateReason, tracks(liveTracks, "are live")))
+	add(ReleaseGroupType_Remix, tracks(remixTracks, "are remixes"))
+	add(ReleaseGroupType_Soundtrack, title(soundtrackTitleRegexp))
+	return guesses
+}
@@ -22,13 +22,16 @@ const (
 type Entity string
 
 const (
-	ArtistEntity    Entity = "artist"
-	EventEntity     Entity = "event"
-	LabelEntity     Entity = "label"
-	RecordingEntity Entity = "recording"
-	ReleaseEntity   Entity = "release"
-	WorkEntity      Entity = "work"
-	InfoEntity      Entity = "info" // informational edit; not a true entity
+	ArtistEntity       Entity = "artist"
+	EventEntity        Entity = "event"
+	LabelEntity        Entity = "label"
+	PlaceEntity        Entity = "place"
+	RecordingEntity    Entity = "recording"
+	ReleaseEntity      Entity = "release"
+	ReleaseGroupEntity Entity = "release-group"
+	SeriesEntity       Entity = "series"
+	WorkEntity         Entity = "work"
+	InfoEntity         Entity = "info" // informational edit; not a true entity
 )
 
 // EntityTypes lists real database entity types in alphabetical order.
@@ -36,12 +39,20 @@ var EntityTypes = []Entity{
 	ArtistEntity,
 	EventEntity,
 	LabelEntity,
+	PlaceEntity,
 	RecordingEntity,
 	ReleaseEntity,
+	ReleaseGroupEntity,
+	SeriesEntity,
 	WorkEntity,
 }
 
 // Edit represents a seeded MusicBrainz edit.
+//
+// Types for editing existing entities have a Changes field containing
+// human-readable descriptions of how the edit modifies the entity identified
+// by MBID (e.g. as produced by the musicbrainz package's Diff function).
+// Changes is only used to construct the edit's description.
 type Edit interface {
 	// Entity returns the type of entity being edited.
 	Entity() Entity
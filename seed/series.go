@@ -0,0 +1,108 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package seed
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/derat/yambs/mbdb"
+)
+
+// Series holds data used to seed the "Add Series" form at https://musicbrainz.org/series/create
+// and the edit-series form at https://musicbrainz.org/series/<MBID>/edit.
+// See https://musicbrainz.org/doc/Series for more information about series entities.
+//
+// Entities are added to the series via "part of" relationships (e.g.
+// LinkType_PartOf_Release_Series or LinkType_PartOf_Series_Work) with Backward set
+// to true when the linked entity's type sorts before "series". The position of each
+// entity within the series is specified using a LinkAttributeType_Number attribute.
+type Series struct {
+	// MBID contains the series' MBID (for editing an existing series rather than creating a new one).
+	MBID string `json:"mbid,omitempty"`
+	// Name contains the series' name.
+	Name string `json:"name,omitempty"`
+	// Disambiguation differentiates this series from other series with similar names.
+	// See https://musicbrainz.org/doc/Disambiguation_Comment.
+	Disambiguation string `json:"disambiguation,omitempty"`
+	// Type describes the type of entities that are contained in the series.
+	// See https://musicbrainz.org/doc/Series#Type.
+	Type SeriesType `json:"type,omitempty"`
+	// OrderingType describes how the entities in the series are ordered.
+	// See https://musicbrainz.org/doc/Series#Ordering_Type.
+	OrderingType SeriesOrderingType `json:"ordering_type,omitempty"`
+	// Relationships contains (non-URL) relationships between this series and other entities.
+	Relationships []Relationship `json:"relationships,omitempty"`
+	// URLs contains relationships between this series and one or more URLs.
+	// See https://musicbrainz.org/doc/Style/Relationships/URLs.
+	URLs []URL `json:"urls,omitempty"`
+	// Changes lists modifications to the existing series. See Edit.
+	Changes []string `json:"changes,omitempty"`
+	// EditNote contains the note attached to the edit.
+	// See https://musicbrainz.org/doc/Edit_Note.
+	EditNote string `json:"edit_note,omitempty"`
+}
+
+func (s *Series) Entity() Entity { return SeriesEntity }
+
+func (s *Series) Description() string {
+	var parts []string
+	if s.MBID != "" {
+		parts = append(parts, truncate(s.MBID, mbidPrefixLen, false))
+	}
+	if s.Name != "" {
+		parts = append(parts, s.Name)
+	}
+	if len(s.Changes) > 0 {
+		parts = append(parts, strings.Join(s.Changes, "; "))
+	}
+	if len(parts) == 0 {
+		return "[unknown]"
+	}
+	return strings.Join(parts, " / ")
+}
+
+func (s *Series) URL(serverURL string) string {
+	if s.MBID != "" {
+		return serverURL + "/series/" + s.MBID + "/edit"
+	}
+	return serverURL + "/series/create"
+}
+
+func (s *Series) Params() url.Values {
+	vals := make(url.Values)
+	if s.Name != "" {
+		vals.Set("edit-series.name", s.Name)
+	}
+	if s.Disambiguation != "" {
+		vals.Set("edit-series.comment", s.Disambiguation)
+	}
+	if s.Type != 0 {
+		vals.Set("edit-series.type_id", strconv.Itoa(int(s.Type)))
+	}
+	if s.OrderingType != 0 {
+		vals.Set("edit-series.ordering_type_id", strconv.Itoa(int(s.OrderingType)))
+	}
+	for i, rel := range s.Relationships {
+		rel.setParams(vals, fmt.Sprintf("rels.%d.", i))
+	}
+	for i, u := range s.URLs {
+		u.setParams(vals, fmt.Sprintf("edit-series.url.%d.", i), s.Method())
+	}
+	if s.EditNote != "" {
+		vals.Set("edit-series.edit_note", s.EditNote)
+	}
+	return vals
+}
+
+func (s *Series) Method() string { return http.MethodGet }
+
+func (s *Series) Finish(ctx context.Context, db *mbdb.DB) error {
+	finishURLs(s.URLs, SeriesEntity)
+	return nil
+}
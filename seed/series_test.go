@@ -0,0 +1,51 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package seed
+
+import (
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSeries_Params(t *testing.T) {
+	series := Series{
+		Name:           "Some Series",
+		Disambiguation: "for testing",
+		Type:           SeriesType_ReleaseSeries,
+		OrderingType:   SeriesOrderingType_Automatic,
+		Relationships: []Relationship{{
+			Target: "7f4c5b9e-0d0e-4ae5-9b0a-fb6f6c4a0e7a",
+			Type:   LinkType_PartOf_Release_Series,
+			Attributes: []RelationshipAttribute{{
+				Type:      LinkAttributeType_Number,
+				TextValue: "3",
+			}},
+			Backward: true,
+		}},
+		URLs:     []URL{{URL: "https://www.example.org/series"}},
+		EditNote: "here's the edit note",
+	}
+
+	rel := series.Relationships[0]
+	attr := rel.Attributes[0]
+	want := url.Values{
+		"edit-series.name":               {series.Name},
+		"edit-series.comment":            {series.Disambiguation},
+		"edit-series.type_id":            {strconv.Itoa(int(series.Type))},
+		"edit-series.ordering_type_id":   {strconv.Itoa(int(series.OrderingType))},
+		"rels.0.target":                  {rel.Target},
+		"rels.0.type":                    {strconv.Itoa(int(rel.Type))},
+		"rels.0.attributes.0.type":       {strconv.Itoa(int(attr.Type))},
+		"rels.0.attributes.0.text_value": {attr.TextValue},
+		"rels.0.backward":                {"1"},
+		"edit-series.url.0.text":         {series.URLs[0].URL},
+		"edit-series.edit_note":          {series.EditNote},
+	}
+	if diff := cmp.Diff(want, series.Params()); diff != "" {
+		t.Error("Incorrect query params:\n" + diff)
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package seed
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derat/yambs/mbdb"
+)
+
+// Submission contains data that can't be seeded via edit forms but can instead be
+// submitted directly to the MusicBrainz API by an authenticated user.
+// Unlike Edit, all of the entities referenced by a Submission must already exist.
+// See https://musicbrainz.org/doc/MusicBrainz_API#Submitting_data.
+type Submission struct {
+	// ISRCs maps from recording MBIDs to ISRCs that should be added to the recordings.
+	ISRCs map[string][]string `json:"isrcs,omitempty"`
+	// Barcodes maps from release MBIDs to barcodes that should be set.
+	Barcodes map[string]string `json:"barcodes,omitempty"`
+	// Tags contains tags that should be added to entities.
+	Tags []EntityTags `json:"tags,omitempty"`
+	// Ratings contains ratings that should be given to entities.
+	Ratings []EntityRating `json:"ratings,omitempty"`
+}
+
+// EntityTags describes tags to add to an entity.
+// See https://musicbrainz.org/doc/Folksonomy_Tagging.
+type EntityTags struct {
+	Entity Entity   `json:"entity"`
+	MBID   string   `json:"mbid"`
+	Tags   []string `json:"tags"`
+}
+
+// EntityRating describes a rating to give to an entity.
+// See https://musicbrainz.org/doc/Rating_System.
+type EntityRating struct {
+	Entity Entity `json:"entity"`
+	MBID   string `json:"mbid"`
+	// Stars contains the rating between 1 and 5, or 0 to remove an existing rating.
+	Stars int `json:"stars"`
+}
+
+// Empty returns true if sub doesn't contain any data.
+func (sub *Submission) Empty() bool {
+	return sub == nil ||
+		(len(sub.ISRCs) == 0 && len(sub.Barcodes) == 0 && len(sub.Tags) == 0 && len(sub.Ratings) == 0)
+}
+
+// Add adds the data from o to sub.
+func (sub *Submission) Add(o *Submission) {
+	if o == nil {
+		return
+	}
+	for mbid, isrcs := range o.ISRCs {
+		if sub.ISRCs == nil {
+			sub.ISRCs = make(map[string][]string)
+		}
+		for _, isrc := range isrcs {
+			if !hasString(sub.ISRCs[mbid], isrc) {
+				sub.ISRCs[mbid] = append(sub.ISRCs[mbid], isrc)
+			}
+		}
+	}
+	for mbid, bc := range o.Barcodes {
+		if sub.Barcodes == nil {
+			sub.Barcodes = make(map[string]string)
+		}
+		sub.Barcodes[mbid] = bc
+	}
+	sub.Tags = append(sub.Tags, o.Tags...)
+	sub.Ratings = append(sub.Ratings, o.Ratings...)
+}
+
+// Lines returns human-readable descriptions of sub's data, e.g. for asking the user to
+// confirm the submission. serverURL is used to construct entity URLs.
+func (sub *Submission) Lines(serverURL string) []string {
+	var lines []string
+	recs := make([]string, 0, len(sub.ISRCs))
+	for mbid := range sub.ISRCs {
+		recs = append(recs, mbid)
+	}
+	sort.Strings(recs)
+	for _, mbid := range recs {
+		lines = append(lines, fmt.Sprintf("Add ISRC(s) %s to %s/recording/%s",
+			strings.Join(sub.ISRCs[mbid], ", "), serverURL, mbid))
+	}
+	rels := make([]string, 0, len(sub.Barcodes))
+	for mbid := range sub.Barcodes {
+		rels = append(rels, mbid)
+	}
+	sort.Strings(rels)
+	for _, mbid := range rels {
+		lines = append(lines, fmt.Sprintf("Set barcode %s for %s/release/%s",
+			sub.Barcodes[mbid], serverURL, mbid))
+	}
+	for _, et := range sub.Tags {
+		lines = append(lines, fmt.Sprintf("Add tag(s) %s to %s/%s/%s",
+			strings.Join(et.Tags, ", "), serverURL, et.Entity, et.MBID))
+	}
+	for _, er := range sub.Ratings {
+		lines = append(lines, fmt.Sprintf("Rate %s/%s/%s with %d star(s)",
+			serverURL, er.Entity, er.MBID, er.Stars))
+	}
+	return lines
+}
+
+// Validate returns an error if sub contains malformed data.
+func (sub *Submission) Validate() error {
+	for mbid, isrcs := range sub.ISRCs {
+		if !mbdb.IsMBID(mbid) {
+			return fmt.Errorf("invalid recording MBID %q", mbid)
+		}
+		for _, isrc := range isrcs {
+			if !isrcRegexp.MatchString(isrc) {
+				return fmt.Errorf("invalid ISRC %q", isrc)
+			}
+		}
+	}
+	for mbid, bc := range sub.Barcodes {
+		if !mbdb.IsMBID(mbid) {
+			return fmt.Errorf("invalid release MBID %q", mbid)
+		}
+		if !barcodeRegexp.MatchString(bc) {
+			return fmt.Errorf("invalid barcode %q", bc)
+		}
+	}
+	for _, et := range sub.Tags {
+		if !mbdb.IsMBID(et.MBID) {
+			return fmt.Errorf("invalid %v MBID %q", et.Entity, et.MBID)
+		}
+	}
+	for _, er := range sub.Ratings {
+		if !mbdb.IsMBID(er.MBID) {
+			return fmt.Errorf("invalid %v MBID %q", er.Entity, er.MBID)
+		}
+		if er.Stars < 0 || er.Stars > 5 {
+			return fmt.Errorf("invalid rating %d for %v", er.Stars, er.MBID)
+		}
+	}
+	return nil
+}
+
+// Submit validates sub and submits it to the MusicBrainz API using db,
+// which must have been configured with credentials.
+func (sub *Submission) Submit(ctx context.Context, db *mbdb.DB) error {
+	if err := sub.Validate(); err != nil {
+		return err
+	}
+	if err := db.SubmitISRCs(ctx, sub.ISRCs); err != nil {
+		return fmt.Errorf("ISRCs: %v", err)
+	}
+	if err := db.SubmitBarcodes(ctx, sub.Barcodes); err != nil {
+		return fmt.Errorf("barcodes: %v", err)
+	}
+	if len(sub.Tags) > 0 {
+		tags := make(map[string]map[string][]string)
+		for _, et := range sub.Tags {
+			typ := string(et.Entity)
+			if tags[typ] == nil {
+				tags[typ] = make(map[string][]string)
+			}
+			tags[typ][et.MBID] = append(tags[typ][et.MBID], et.Tags...)
+		}
+		if err := db.SubmitTags(ctx, tags); err != nil {
+			return fmt.Errorf("tags: %v", err)
+		}
+	}
+	if len(sub.Ratings) > 0 {
+		ratings := make(map[string]map[string]int)
+		for _, er := range sub.Ratings {
+			typ := string(er.Entity)
+			if ratings[typ] == nil {
+				ratings[typ] = make(map[string]int)
+			}
+			ratings[typ][er.MBID] = er.Stars * 20 // the API uses 0-100
+		}
+		if err := db.SubmitRatings(ctx, ratings); err != nil {
+			return fmt.Errorf("ratings: %v", err)
+		}
+	}
+	return nil
+}
+
+// Submission returns a Submission adding the ISRCs of rel's tracks to the tracks'
+// existing recordings. Tracks without ISRCs or recordings (e.g. new recordings that
+// will be created along with the release) are skipped, as are ISRCs that are already
+// associated with the recordings. If rel is an existing release, its barcode and tags
+// are also included. nil is returned if there is nothing to submit.
+func (rel *Release) Submission(ctx context.Context, db *mbdb.DB) (*Submission, error) {
+	var sub Submission
+	if bc := strings.TrimSpace(rel.Barcode); mbdb.IsMBID(rel.MBID) && bc != "" && bc != "none" {
+		sub.Barcodes = map[string]string{rel.MBID: bc}
+	}
+	sub.addTagsAndRating(ReleaseEntity, rel.MBID, rel.Tags, 0)
+	for _, med := range rel.Mediums {
+		for _, tr := range med.Tracks {
+			isrc := strings.ToUpper(strings.TrimSpace(tr.ISRC))
+			if isrc == "" || !mbdb.IsMBID(tr.Recording) {
+				continue
+			}
+			recs, err := db.GetRecordingsFromISRC(ctx, isrc)
+			if err != nil {
+				return nil, err
+			}
+			var found bool
+			for _, rec := range recs {
+				if rec.MBID == tr.Recording {
+					found = true
+					break
+				}
+			}
+			if !found {
+				sub.Add(&Submission{ISRCs: map[string][]string{tr.Recording: {isrc}}})
+			}
+		}
+	}
+	if sub.Empty() {
+		return nil, nil
+	}
+	return &sub, nil
+}
+
+// GetSubmission returns a Submission containing additional data from edits that
+// can only be submitted via the API. nil is returned if there is nothing to submit.
+// Edits' Tags and Rating fields are only included for existing entities (i.e. those
+// with MBIDs), since they can't be seeded via edit forms.
+func GetSubmission(ctx context.Context, db *mbdb.DB, edits []Edit) (*Submission, error) {
+	var sub Submission
+	for _, ed := range edits {
+		switch ted := ed.(type) {
+		case *Artist:
+			sub.addTagsAndRating(ArtistEntity, ted.MBID, ted.Tags, ted.Rating)
+		case *Label:
+			sub.addTagsAndRating(LabelEntity, ted.MBID, ted.Tags, ted.Rating)
+		case *Recording:
+			sub.addTagsAndRating(RecordingEntity, ted.MBID, ted.Tags, ted.Rating)
+		case *Release:
+			s, err := ted.Submission(ctx, db)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", ted.Description(), err)
+			}
+			sub.Add(s)
+		case *ReleaseGroup:
+			sub.addTagsAndRating(ReleaseGroupEntity, ted.MBID, ted.Tags, ted.Rating)
+		case *Work:
+			sub.addTagsAndRating(WorkEntity, ted.MBID, ted.Tags, ted.Rating)
+		}
+	}
+	if sub.Empty() {
+		return nil, nil
+	}
+	return &sub, nil
+}
+
+// addTagsAndRating adds tags and a rating (if non-zero) for the existing entity
+// identified by entity and mbid. Nothing is added if mbid is empty, since tags and
+// ratings can only be submitted for existing entities.
+func (sub *Submission) addTagsAndRating(entity Entity, mbid string, tags []string, rating int) {
+	if mbid == "" {
+		return
+	}
+	var clean []string
+	for _, t := range tags {
+		if t = strings.TrimSpace(t); t != "" {
+			clean = append(clean, t)
+		}
+	}
+	if len(clean) > 0 {
+		sub.Tags = append(sub.Tags, EntityTags{Entity: entity, MBID: mbid, Tags: clean})
+	}
+	if rating != 0 {
+		sub.Ratings = append(sub.Ratings, EntityRating{Entity: entity, MBID: mbid, Stars: rating})
+	}
+}
+
+func hasString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
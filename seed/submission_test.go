@@ -0,0 +1,106 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package seed
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derat/yambs/mbdb"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRelease_Submission(t *testing.T) {
+	const (
+		mbid1 = "0a1b2c3d-0000-4000-8000-000000000001"
+		mbid2 = "0a1b2c3d-0000-4000-8000-000000000002"
+		mbid3 = "0a1b2c3d-0000-4000-8000-000000000003"
+		mbid4 = "0a1b2c3d-0000-4000-8000-000000000004"
+	)
+	db := mbdb.NewDB(mbdb.DisallowQueries)
+	db.SetRecordingsFromISRCForTest("USAAA0000001", []mbdb.RecordingInfo{{MBID: mbid1}})
+	db.SetRecordingsFromISRCForTest("USAAA0000002", []mbdb.RecordingInfo{{MBID: mbid3}})
+
+	rel := Release{
+		MBID:    mbid4,
+		Barcode: "036000291452",
+		Tags:    []string{"rock"},
+		Mediums: []Medium{
+			{Tracks: []Track{
+				{Title: "Already Linked", Recording: mbid1, ISRC: "USAAA0000001"},
+				{Title: "Linked Elsewhere", Recording: mbid2, ISRC: "usaaa0000002"},
+				{Title: "Unknown", Recording: mbid2, ISRC: "USAAA0000003"},
+				{Title: "New Recording", ISRC: "USAAA0000004"},
+				{Title: "No ISRC", Recording: mbid3},
+			}},
+		},
+	}
+	ctx := context.Background()
+	sub, err := GetSubmission(ctx, db, []Edit{
+		&rel,
+		&Recording{MBID: mbid3, ISRCs: []string{"USAAA0000005"}, Tags: []string{" live", ""}, Rating: 4},
+		&Artist{Name: "New Artist", Tags: []string{"pop"}, Rating: 3}, // not submitted since new
+	})
+	if err != nil {
+		t.Fatal("GetSubmission failed: ", err)
+	}
+	want := &Submission{
+		ISRCs:    map[string][]string{mbid2: {"USAAA0000002", "USAAA0000003"}},
+		Barcodes: map[string]string{mbid4: "036000291452"},
+		Tags: []EntityTags{
+			{Entity: ReleaseEntity, MBID: mbid4, Tags: []string{"rock"}},
+			{Entity: RecordingEntity, MBID: mbid3, Tags: []string{"live"}},
+		},
+		Ratings: []EntityRating{{Entity: RecordingEntity, MBID: mbid3, Stars: 4}},
+	}
+	if diff := cmp.Diff(want, sub); diff != "" {
+		t.Error("Bad submission:\n" + diff)
+	}
+	if err := sub.Validate(); err != nil {
+		t.Error("Validate failed: ", err)
+	}
+
+	const srv = "https://musicbrainz.org"
+	wantLines := []string{
+		"Add ISRC(s) USAAA0000002, USAAA0000003 to " + srv + "/recording/" + mbid2,
+		"Set barcode 036000291452 for " + srv + "/release/" + mbid4,
+		"Add tag(s) rock to " + srv + "/release/" + mbid4,
+		"Add tag(s) live to " + srv + "/recording/" + mbid3,
+		"Rate " + srv + "/recording/" + mbid3 + " with 4 star(s)",
+	}
+	if diff := cmp.Diff(wantLines, sub.Lines(srv)); diff != "" {
+		t.Error("Bad lines:\n" + diff)
+	}
+
+	// Releases without anything to submit should produce nil.
+	if sub, err := GetSubmission(ctx, db, []Edit{&Release{Title: "Empty", Barcode: "none"}}); err != nil {
+		t.Error("GetSubmission failed: ", err)
+	} else if sub != nil {
+		t.Errorf("GetSubmission returned %+v for empty release", sub)
+	}
+}
+
+func TestSubmission_Validate(t *testing.T) {
+	const mbid = "0a1b2c3d-0000-4000-8000-000000000001"
+	for _, tc := range []struct {
+		sub   Submission
+		valid bool
+	}{
+		{Submission{ISRCs: map[string][]string{mbid: {"USAAA0000001"}}}, true},
+		{Submission{ISRCs: map[string][]string{mbid: {"USAAA000001"}}}, false},
+		{Submission{ISRCs: map[string][]string{"bogus": {"USAAA0000001"}}}, false},
+		{Submission{Barcodes: map[string]string{mbid: "0123456789012"}}, true},
+		{Submission{Barcodes: map[string]string{mbid: "12345"}}, false},
+		{Submission{Tags: []EntityTags{{ArtistEntity, mbid, []string{"rock"}}}}, true},
+		{Submission{Tags: []EntityTags{{ArtistEntity, "", []string{"rock"}}}}, false},
+		{Submission{Ratings: []EntityRating{{RecordingEntity, mbid, 5}}}, true},
+		{Submission{Ratings: []EntityRating{{RecordingEntity, mbid, 6}}}, false},
+	} {
+		if err := tc.sub.Validate(); err == nil && !tc.valid {
+			t.Errorf("%+v unexpectedly valid", tc.sub)
+		} else if err != nil && tc.valid {
+			t.Errorf("%+v invalid: %v", tc.sub, err)
+		}
+	}
+}
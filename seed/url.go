@@ -12,12 +12,14 @@ import (
 // URL holds data used to seed forms with an entity's relationship to a URL.
 type URL struct {
 	// URL contains the full URL.
-	URL string
+	URL string `json:"url,omitempty"`
 	// LinkType contains the link type ID.
 	// Applicable link types should end in "<Entity>_URL_Link", depending on the
 	// type of the entity being linked to the URL (but note that the LinkType
 	// enum may not include all possible values).
-	LinkType LinkType
+	// If LinkType is unset, the edit's Finish method fills it for URLs from
+	// recognized sites. See NormalizeURL.
+	LinkType LinkType `json:"link_type,omitempty"`
 }
 
 // setParams sets query parameters in vals corresponding to non-empty fields in url.
@@ -0,0 +1,191 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package seed
+
+import (
+	"regexp"
+	"strings"
+)
+
+// urlRule describes how to clean up and categorize URLs from a particular site.
+type urlRule struct {
+	// re is matched against the start of the URL.
+	re *regexp.Regexp
+	// clean is expanded with re's submatches to produce the normalized URL.
+	// Any part of the URL following re's match (e.g. a query or fragment) is dropped.
+	clean string
+	// types maps from the type of the entity being edited to the appropriate link type.
+	types map[Entity]LinkType
+	// names is like types but contains link type names for FindURLLinkType.
+	// It is used for entity types whose link types aren't in the LinkType enum.
+	names map[Entity]string
+}
+
+// urlRules is used by NormalizeURL. The first matching rule is used.
+// The cleanup loosely follows the MusicBrainz server's URL cleanup code in
+// https://github.com/metabrainz/musicbrainz-server/blob/master/root/static/scripts/edit/URLCleanup.js.
+var urlRules = []urlRule{
+	{
+		re:    regexp.MustCompile(`(?i)^https?://([-\w]+)\.bandcamp\.com/?(?:[?#]|$)`),
+		clean: "https://$1.bandcamp.com/",
+		types: map[Entity]LinkType{
+			ArtistEntity: LinkType_Bandcamp_Artist_URL,
+			LabelEntity:  LinkType_Bandcamp_Label_URL,
+		},
+	},
+	{
+		re:    regexp.MustCompile(`(?i)^https?://([-\w]+)\.bandcamp\.com/(album|track)/([-\w]+)`),
+		clean: "https://$1.bandcamp.com/$2/$3",
+		types: map[Entity]LinkType{
+			RecordingEntity: LinkType_PurchaseForDownload_Recording_URL,
+			ReleaseEntity:   LinkType_PurchaseForDownload_Release_URL,
+		},
+	},
+	{
+		re:    regexp.MustCompile(`(?i)^https?://(?:www\.)?discogs\.com/(?:[a-z]{2}/)?artist/(\d+)`),
+		clean: "https://www.discogs.com/artist/$1",
+		types: map[Entity]LinkType{ArtistEntity: LinkType_Discogs_Artist_URL},
+	},
+	{
+		re:    regexp.MustCompile(`(?i)^https?://(?:www\.)?discogs\.com/(?:[a-z]{2}/)?label/(\d+)`),
+		clean: "https://www.discogs.com/label/$1",
+		types: map[Entity]LinkType{LabelEntity: LinkType_Discogs_Label_URL},
+	},
+	{
+		re:    regexp.MustCompile(`(?i)^https?://(?:www\.)?discogs\.com/(?:[a-z]{2}/)?(?:[^/]+/)?master/(\d+)`),
+		clean: "https://www.discogs.com/master/$1",
+		types: map[Entity]LinkType{ReleaseGroupEntity: LinkType_Discogs_ReleaseGroup_URL},
+	},
+	{
+		re:    regexp.MustCompile(`(?i)^https?://(?:www\.)?discogs\.com/(?:[a-z]{2}/)?(?:[^/]+/)?release/(\d+)`),
+		clean: "https://www.discogs.com/release/$1",
+		types: map[Entity]LinkType{ReleaseEntity: LinkType_Discogs_Release_URL},
+	},
+	{
+		re:    regexp.MustCompile(`(?i)^https?://(?:www\.)?imdb\.com/name/(nm\d+)`),
+		clean: "https://www.imdb.com/name/$1/",
+		types: map[Entity]LinkType{ArtistEntity: LinkType_IMDB_Artist_URL},
+	},
+	{
+		re:    regexp.MustCompile(`(?i)^https?://(?:www\.)?imdb\.com/company/(co\d+)`),
+		clean: "https://www.imdb.com/company/$1/",
+		types: map[Entity]LinkType{LabelEntity: LinkType_IMDB_Label_URL},
+	},
+	{
+		re:    regexp.MustCompile(`(?i)^https?://(?:www\.|m\.)?imdb\.com/title/(tt\d+)`),
+		clean: "https://www.imdb.com/title/$1/",
+		types: map[Entity]LinkType{
+			ReleaseGroupEntity: LinkType_IMDB_ReleaseGroup_URL,
+			WorkEntity:         LinkType_IMDB_URL_Work,
+		},
+	},
+	{
+		re:    regexp.MustCompile(`(?i)^https?://(?:www\.)?isni\.org/(?:isni/)?(\d{4})\s*(\d{4})\s*(\d{4})\s*(\d{3}[\dX])`),
+		clean: "https://isni.org/isni/$1$2$3$4",
+		types: map[Entity]LinkType{
+			ArtistEntity: LinkType_OtherDatabases_Artist_URL,
+			LabelEntity:  LinkType_OtherDatabases_Label_URL,
+		},
+	},
+	{
+		re:    regexp.MustCompile(`(?i)^https?://open\.spotify\.com/(?:intl-[a-z]+/)?album/(\w+)`),
+		clean: "https://open.spotify.com/album/$1",
+		types: map[Entity]LinkType{ReleaseEntity: LinkType_FreeStreaming_Release_URL},
+	},
+	{
+		re:    regexp.MustCompile(`(?i)^https?://open\.spotify\.com/(?:intl-[a-z]+/)?artist/(\w+)`),
+		clean: "https://open.spotify.com/artist/$1",
+		types: map[Entity]LinkType{ArtistEntity: LinkType_FreeStreaming_Artist_URL},
+	},
+	{
+		re:    regexp.MustCompile(`(?i)^https?://open\.spotify\.com/(?:intl-[a-z]+/)?track/(\w+)`),
+		clean: "https://open.spotify.com/track/$1",
+		types: map[Entity]LinkType{RecordingEntity: LinkType_FreeStreaming_Recording_URL},
+	},
+	{
+		re:    regexp.MustCompile(`(?i)^https?://(?:www\.)?viaf\.org/viaf/(\d+)`),
+		clean: "http://viaf.org/viaf/$1",
+		types: map[Entity]LinkType{
+			ArtistEntity: LinkType_VIAF_Artist_URL,
+			LabelEntity:  LinkType_VIAF_Label_URL,
+		},
+	},
+	{
+		re:    regexp.MustCompile(`(?i)^https?://(?:www\.|m\.)?wikidata\.org/(?:wiki|entity)/(Q\d+)`),
+		clean: "https://www.wikidata.org/wiki/$1",
+		types: map[Entity]LinkType{
+			ArtistEntity:       LinkType_Wikidata_Artist_URL,
+			EventEntity:        LinkType_Wikidata_Event_URL,
+			LabelEntity:        LinkType_Wikidata_Label_URL,
+			ReleaseGroupEntity: LinkType_Wikidata_ReleaseGroup_URL,
+			WorkEntity:         LinkType_Wikidata_URL_Work,
+		},
+		names: map[Entity]string{PlaceEntity: "wikidata", SeriesEntity: "wikidata"},
+	},
+	{
+		re:    regexp.MustCompile(`(?i)^https?://music\.youtube\.com/channel/([-\w]+)`),
+		clean: "https://music.youtube.com/channel/$1",
+		types: map[Entity]LinkType{ArtistEntity: LinkType_YouTubeMusic_Artist_URL},
+	},
+	{
+		re:    regexp.MustCompile(`(?i)^https?://(?:www\.|m\.)?youtube\.com/((?:channel|c|user)/[^/?#]+|@[^/?#]+)`),
+		clean: "https://www.youtube.com/$1",
+		types: map[Entity]LinkType{
+			ArtistEntity: LinkType_YouTube_Artist_URL,
+			EventEntity:  LinkType_YouTube_Event_URL,
+			LabelEntity:  LinkType_YouTube_Label_URL,
+		},
+		names: map[Entity]string{PlaceEntity: "youtube", SeriesEntity: "youtube"},
+	},
+	{
+		re: regexp.MustCompile(`(?i)^https?://(?:(?:www\.|m\.|music\.)?youtube\.com/watch\?(?:[^#]*&)?v=|` +
+			`youtu\.be/)([-\w]{11})`),
+		clean: "https://www.youtube.com/watch?v=$1",
+		types: map[Entity]LinkType{
+			RecordingEntity: LinkType_FreeStreaming_Recording_URL,
+			ReleaseEntity:   LinkType_FreeStreaming_Release_URL,
+		},
+	},
+}
+
+// NormalizeURL returns a cleaned-up version of orig (e.g. with tracking parameters
+// removed and the hostname canonicalized) and the link type that should be used to
+// relate the URL to an entity of the supplied type. If orig isn't from a recognized
+// site, it is returned unchanged (aside from surrounding whitespace being trimmed)
+// and the returned link type is 0.
+func NormalizeURL(orig string, entity Entity) (string, LinkType) {
+	return normalizeURL(orig, entity, FindURLLinkType)
+}
+
+// normalizeURL implements NormalizeURL.
+// findType is used to look up link types from urlRule.names.
+func normalizeURL(orig string, entity Entity,
+	findType func(Entity, string) (LinkType, error)) (string, LinkType) {
+	orig = strings.TrimSpace(orig)
+	for _, rule := range urlRules {
+		m := rule.re.FindStringSubmatchIndex(orig)
+		if m == nil {
+			continue
+		}
+		cleaned := string(rule.re.ExpandString(nil, rule.clean, orig, m))
+		lt := rule.types[entity]
+		if name, ok := rule.names[entity]; ok && lt == 0 {
+			lt, _ = findType(entity, name) // 0 if the link type is unavailable
+		}
+		return cleaned, lt
+	}
+	return orig, 0
+}
+
+// finishURLs normalizes the URLs in urls (see NormalizeURL) and fills in unset link types.
+// entity is the type of the entity being edited.
+func finishURLs(urls []URL, entity Entity) {
+	for i := range urls {
+		u := &urls[i]
+		var lt LinkType
+		if u.URL, lt = NormalizeURL(u.URL, entity); u.LinkType == 0 {
+			u.LinkType = lt
+		}
+	}
+}
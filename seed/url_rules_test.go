@@ -0,0 +1,127 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package seed
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/derat/yambs/mbdb"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNormalizeURL(t *testing.T) {
+	for _, tc := range []struct {
+		orig   string
+		entity Entity
+		url    string
+		lt     LinkType
+	}{
+		{"https://www.example.org/foo?bar", ArtistEntity, "https://www.example.org/foo?bar", 0},
+		{" https://www.example.org/ ", ArtistEntity, "https://www.example.org/", 0},
+		{"http://artist.bandcamp.com", ArtistEntity, "https://artist.bandcamp.com/", LinkType_Bandcamp_Artist_URL},
+		{"https://label.bandcamp.com/?from=x", LabelEntity, "https://label.bandcamp.com/", LinkType_Bandcamp_Label_URL},
+		{"https://artist.bandcamp.com/music", ArtistEntity, "https://artist.bandcamp.com/music", 0},
+		{"https://artist.bandcamp.com/album/some-album?from=x", ReleaseEntity,
+			"https://artist.bandcamp.com/album/some-album", LinkType_PurchaseForDownload_Release_URL},
+		{"https://artist.bandcamp.com/track/some-track", RecordingEntity,
+			"https://artist.bandcamp.com/track/some-track", LinkType_PurchaseForDownload_Recording_URL},
+		{"https://artist.bandcamp.com/album/some-album", ArtistEntity,
+			"https://artist.bandcamp.com/album/some-album", 0},
+		{"http://discogs.com/artist/123-Some-Artist", ArtistEntity,
+			"https://www.discogs.com/artist/123", LinkType_Discogs_Artist_URL},
+		{"https://www.discogs.com/de/label/456-Some-Label", LabelEntity,
+			"https://www.discogs.com/label/456", LinkType_Discogs_Label_URL},
+		{"https://www.discogs.com/Artist-Album/master/789", ReleaseGroupEntity,
+			"https://www.discogs.com/master/789", LinkType_Discogs_ReleaseGroup_URL},
+		{"https://www.discogs.com/release/1011-Artist-Album", ReleaseEntity,
+			"https://www.discogs.com/release/1011", LinkType_Discogs_Release_URL},
+		{"https://m.imdb.com/title/tt0105793/?ref_=x", ReleaseGroupEntity,
+			"https://www.imdb.com/title/tt0105793/", LinkType_IMDB_ReleaseGroup_URL},
+		{"https://www.imdb.com/name/nm0000123", ArtistEntity,
+			"https://www.imdb.com/name/nm0000123/", LinkType_IMDB_Artist_URL},
+		{"http://www.isni.org/isni/000000012103268X", ArtistEntity,
+			"https://isni.org/isni/000000012103268X", LinkType_OtherDatabases_Artist_URL},
+		{"https://open.spotify.com/intl-de/album/4aawyAB9vmqN3uQ7FjRGTy?si=abc", ReleaseEntity,
+			"https://open.spotify.com/album/4aawyAB9vmqN3uQ7FjRGTy", LinkType_FreeStreaming_Release_URL},
+		{"https://open.spotify.com/album/4aawyAB9vmqN3uQ7FjRGTy", RecordingEntity,
+			"https://open.spotify.com/album/4aawyAB9vmqN3uQ7FjRGTy", 0},
+		{"https://viaf.org/viaf/123456/", LabelEntity, "http://viaf.org/viaf/123456", LinkType_VIAF_Label_URL},
+		{"https://m.wikidata.org/wiki/Q42#sitelinks", WorkEntity,
+			"https://www.wikidata.org/wiki/Q42", LinkType_Wikidata_URL_Work},
+		{"https://www.youtube.com/@SomeArtist/videos", ArtistEntity,
+			"https://www.youtube.com/@SomeArtist", LinkType_YouTube_Artist_URL},
+		{"https://m.youtube.com/channel/UC123_abc-DEF", LabelEntity,
+			"https://www.youtube.com/channel/UC123_abc-DEF", LinkType_YouTube_Label_URL},
+		{"https://music.youtube.com/channel/UC123_abc-DEF", ArtistEntity,
+			"https://music.youtube.com/channel/UC123_abc-DEF", LinkType_YouTubeMusic_Artist_URL},
+		{"https://youtu.be/dQw4w9WgXcQ?t=10", RecordingEntity,
+			"https://www.youtube.com/watch?v=dQw4w9WgXcQ", LinkType_FreeStreaming_Recording_URL},
+		{"https://www.youtube.com/watch?feature=share&v=dQw4w9WgXcQ&list=x", ReleaseEntity,
+			"https://www.youtube.com/watch?v=dQw4w9WgXcQ", LinkType_FreeStreaming_Release_URL},
+	} {
+		url, lt := NormalizeURL(tc.orig, tc.entity)
+		if url != tc.url || lt != tc.lt {
+			t.Errorf("NormalizeURL(%q, %q) = %q, %v; want %q, %v", tc.orig, tc.entity, url, lt, tc.url, tc.lt)
+		}
+	}
+}
+
+func TestNormalizeURL_PlaceSeries(t *testing.T) {
+	// Place and series link types are looked up by name, so use fake types.
+	fakeTypes := map[Entity]map[string]LinkType{
+		PlaceEntity:  {"wikidata": 9001, "youtube": 9002},
+		SeriesEntity: {"wikidata": 9003, "youtube": 9004},
+	}
+	findType := func(entity Entity, name string) (LinkType, error) {
+		if lt, ok := fakeTypes[entity][name]; ok {
+			return lt, nil
+		}
+		return 0, fmt.Errorf("unknown %v link type %q", entity, name)
+	}
+
+	for _, tc := range []struct {
+		orig   string
+		entity Entity
+		url    string
+		lt     LinkType
+	}{
+		{"https://m.wikidata.org/wiki/Q42", PlaceEntity,
+			"https://www.wikidata.org/wiki/Q42", 9001},
+		{"https://www.youtube.com/@SomeVenue/videos", PlaceEntity,
+			"https://www.youtube.com/@SomeVenue", 9002},
+		{"https://www.discogs.com/artist/123", PlaceEntity, "https://www.discogs.com/artist/123", 0},
+		{"https://www.wikidata.org/entity/Q42", SeriesEntity,
+			"https://www.wikidata.org/wiki/Q42", 9003},
+		{"https://m.youtube.com/channel/UC123_abc-DEF", SeriesEntity,
+			"https://www.youtube.com/channel/UC123_abc-DEF", 9004},
+		{"https://artist.bandcamp.com/", SeriesEntity, "https://artist.bandcamp.com/", 0},
+	} {
+		url, lt := normalizeURL(tc.orig, tc.entity, findType)
+		if url != tc.url || lt != tc.lt {
+			t.Errorf("normalizeURL(%q, %q) = %q, %v; want %q, %v", tc.orig, tc.entity, url, lt, tc.url, tc.lt)
+		}
+	}
+}
+
+func TestFinishURLs(t *testing.T) {
+	// Finish should clean URLs and fill in missing link types without overwriting existing ones.
+	a := Artist{URLs: []URL{
+		{URL: "https://m.wikidata.org/wiki/Q42"},
+		{URL: "https://artist.bandcamp.com/", LinkType: LinkType_OfficialHomepage_Artist_URL},
+		{URL: "https://www.example.org/"},
+	}}
+	if err := a.Finish(context.Background(), mbdb.NewDB(mbdb.DisallowQueries)); err != nil {
+		t.Fatal("Finish failed: ", err)
+	}
+	want := []URL{
+		{URL: "https://www.wikidata.org/wiki/Q42", LinkType: LinkType_Wikidata_Artist_URL},
+		{URL: "https://artist.bandcamp.com/", LinkType: LinkType_OfficialHomepage_Artist_URL},
+		{URL: "https://www.example.org/"},
+	}
+	if diff := cmp.Diff(want, a.URLs); diff != "" {
+		t.Error("Bad URLs after Finish:\n" + diff)
+	}
+}
@@ -0,0 +1,301 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package seed
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// Problem describes a likely problem with one of an edit's fields.
+type Problem struct {
+	// Field describes the problematic field, e.g. "ISRCs[1]" or "Mediums[0].Tracks[3].Length".
+	Field string `json:"field"`
+	// Msg contains a human-readable description of the problem.
+	Msg string `json:"msg"`
+}
+
+func (p Problem) String() string { return p.Field + ": " + p.Msg }
+
+// Validate checks edit's fields for malformed values that would likely be rejected
+// (or silently dropped) by the MusicBrainz edit form. Release.RecordingMatches is
+// also included.
+func Validate(edit Edit) []Problem {
+	var v validator
+	switch ed := edit.(type) {
+	case *Artist:
+		v.ipis("IPICodes", ed.IPICodes)
+		v.isnis("ISNICodes", ed.ISNICodes)
+		v.period("", ed.BeginDate, ed.EndDate)
+		v.relationships(ed.Relationships)
+		v.submitted(ed.MBID, ed.Tags, ed.Rating)
+	case *Event:
+		v.period("", ed.BeginDate, ed.EndDate)
+		v.check("Time", ed.Time != "" && !eventTimeRegexp.MatchString(ed.Time),
+			"time %q not in HH:MM format", ed.Time)
+		v.relationships(ed.Relationships)
+	case *Label:
+		if ed.LabelCode != "" {
+			n, err := strconv.Atoi(ed.LabelCode)
+			v.check("LabelCode", err != nil || n <= 0 || n > maxLabelCode,
+				"label code %q not 1-5 digits", ed.LabelCode)
+		}
+		v.ipis("IPICodes", ed.IPICodes)
+		v.isnis("ISNICodes", ed.ISNICodes)
+		v.period("", ed.BeginDate, ed.EndDate)
+		v.relationships(ed.Relationships)
+		v.submitted(ed.MBID, ed.Tags, ed.Rating)
+	case *Place:
+		v.period("", ed.BeginDate, ed.EndDate)
+		v.relationships(ed.Relationships)
+	case *Recording:
+		v.length("Length", ed.Length)
+		for i, isrc := range ed.ISRCs {
+			v.check(fmt.Sprintf("ISRCs[%d]", i), !isrcRegexp.MatchString(isrc),
+				"invalid ISRC %q", isrc)
+		}
+		v.relationships(ed.Relationships)
+		v.submitted(ed.MBID, ed.Tags, ed.Rating)
+	case *Release:
+		if ed.Barcode != "" && ed.Barcode != "none" {
+			v.check("Barcode", !barcodeRegexp.MatchString(ed.Barcode),
+				"barcode %q isn't 8, 12, 13, or 14 digits", ed.Barcode)
+			v.check("Barcode", barcodeRegexp.MatchString(ed.Barcode) && !validGTIN(ed.Barcode),
+				"barcode %q has bad check digit", ed.Barcode)
+		}
+		if ed.Language != "" {
+			_, err := language.ParseBase(ed.Language)
+			v.check("Language", !langRegexp.MatchString(ed.Language) || err != nil,
+				"language %q isn't known ISO 639-3 code", ed.Language)
+		}
+		if ed.Script != "" {
+			_, err := language.ParseScript(ed.Script)
+			v.check("Script", !scriptRegexp.MatchString(ed.Script) || err != nil,
+				"script %q isn't known ISO 15924 code", ed.Script)
+		}
+		for i, ev := range ed.Events {
+			v.date(fmt.Sprintf("Events[%d].Date", i), ev.Date)
+		}
+		for i, med := range ed.Mediums {
+			// Zero means that a track's length is unknown, but if other tracks on the same
+			// medium have lengths, it's more likely that the track's length was wrongly set to 0.
+			var known bool
+			for _, tr := range med.Tracks {
+				known = known || tr.Length != 0
+			}
+			for j, tr := range med.Tracks {
+				field := fmt.Sprintf("Mediums[%d].Tracks[%d].Length", i, j)
+				v.check(field, known && tr.Length == 0, "zero length")
+				v.length(field, tr.Length)
+			}
+		}
+		v.submitted(ed.MBID, ed.Tags, 0)
+		v.problems = append(v.problems, ed.RecordingMatches...)
+	case *ReleaseGroup:
+		var primary bool
+		for i, t := range ed.Types {
+			field := fmt.Sprintf("Types[%d]", i)
+			if _, ok := rgPrimaryTypeIDs[t]; ok {
+				v.check(field, primary, "multiple primary types")
+				primary = true
+			} else {
+				_, ok := rgSecondaryTypeIDs[t]
+				v.check(field, !ok, "unknown type %q", t)
+			}
+		}
+		v.relationships(ed.Relationships)
+		v.submitted(ed.MBID, ed.Tags, ed.Rating)
+	case *Series:
+		v.relationships(ed.Relationships)
+	case *Work:
+		for i, lang := range ed.Languages {
+			_, ok := languageNames[lang]
+			v.check(fmt.Sprintf("Languages[%d]", i), !ok, "unknown language ID %d", int(lang))
+		}
+		for i, iswc := range ed.ISWCs {
+			field := fmt.Sprintf("ISWCs[%d]", i)
+			if ms := iswcRegexp.FindStringSubmatch(iswc); ms == nil {
+				v.add(field, "invalid ISWC %q", iswc)
+			} else {
+				v.check(field, !validISWC(ms[1]+ms[2]+ms[3], ms[4]),
+					"ISWC %q has bad check digit", iswc)
+			}
+		}
+		v.relationships(ed.Relationships)
+		v.submitted(ed.MBID, ed.Tags, ed.Rating)
+	}
+	return v.problems
+}
+
+const (
+	maxLabelCode   = 99999
+	maxTrackLength = 24 * time.Hour
+)
+
+var (
+	barcodeRegexp   = regexp.MustCompile(`^(\d{8}|\d{12,14})$`)
+	eventTimeRegexp = regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d$`)
+	ipiRegexp       = regexp.MustCompile(`^\d{9}(\d{2})?$`)
+	isniRegexp      = regexp.MustCompile(`^\d{15}[\dX]$`)
+	isrcRegexp      = regexp.MustCompile(`^[A-Z]{2}[A-Z0-9]{3}\d{7}$`)
+	iswcRegexp      = regexp.MustCompile(`^T-?(\d{3})\.?(\d{3})\.?(\d{3})-?(\d)$`)
+	langRegexp      = regexp.MustCompile(`^[a-z]{3}$`)
+	scriptRegexp    = regexp.MustCompile(`^[A-Z][a-z]{3}$`)
+)
+
+// validator accumulates Problems.
+type validator struct{ problems []Problem }
+
+func (v *validator) add(field, format string, args ...interface{}) {
+	v.problems = append(v.problems, Problem{Field: field, Msg: fmt.Sprintf(format, args...)})
+}
+
+// check adds a problem if bad is true.
+func (v *validator) check(field string, bad bool, format string, args ...interface{}) {
+	if bad {
+		v.add(field, format, args...)
+	}
+}
+
+func (v *validator) ipis(field string, codes []string) {
+	// IPI name numbers have two trailing check digits, but CISAC doesn't publish
+	// the algorithm, so only the format is checked.
+	for i, code := range codes {
+		code = strings.NewReplacer(" ", "", ".", "").Replace(code)
+		v.check(fmt.Sprintf("%s[%d]", field, i), !ipiRegexp.MatchString(code),
+			"IPI code %q isn't 9 or 11 digits", codes[i])
+	}
+}
+
+func (v *validator) isnis(field string, codes []string) {
+	for i, code := range codes {
+		f := fmt.Sprintf("%s[%d]", field, i)
+		code = strings.ReplaceAll(code, " ", "")
+		if !isniRegexp.MatchString(code) {
+			v.add(f, "ISNI code %q isn't 16 digits", codes[i])
+		} else {
+			v.check(f, !validISNI(code), "ISNI code %q has bad check digit", codes[i])
+		}
+	}
+}
+
+// period checks begin and end, which share the supplied field name prefix.
+func (v *validator) period(prefix string, begin, end Date) {
+	bok := v.date(prefix+"BeginDate", begin)
+	eok := v.date(prefix+"EndDate", end)
+	v.check(prefix+"EndDate", bok && eok && end.before(begin), "end date precedes begin date")
+}
+
+// date adds a problem if d is invalid. It returns false in that case.
+func (v *validator) date(field string, d Date) bool {
+	if msg := d.problem(); msg != "" {
+		v.add(field, "%s", msg)
+		return false
+	}
+	return true
+}
+
+func (v *validator) length(field string, d time.Duration) {
+	// Zero is used to indicate that the length is unknown.
+	switch {
+	case d < 0:
+		v.add(field, "negative length %v", d)
+	case d > 0 && d < time.Millisecond:
+		v.add(field, "length %v rounds to zero", d)
+	case d > maxTrackLength:
+		v.add(field, "length %v exceeds %v", d, maxTrackLength)
+	}
+}
+
+// submitted checks tags and rating, which can only be submitted via the API
+// for the existing entity identified by mbid.
+func (v *validator) submitted(mbid string, tags []string, rating int) {
+	v.check("Tags", len(tags) > 0 && mbid == "", "tags can only be added to existing entities")
+	v.check("Rating", rating != 0 && mbid == "", "ratings can only be given to existing entities")
+	v.check("Rating", rating < 0 || rating > 5, "rating %d not between 1 and 5", rating)
+}
+
+func (v *validator) relationships(rels []Relationship) {
+	for i, rel := range rels {
+		v.period(fmt.Sprintf("Relationships[%d].", i), rel.BeginDate, rel.EndDate)
+	}
+}
+
+// problem returns a description of the problem with d, or an empty string if d is valid.
+// Unset components are allowed.
+func (d *Date) problem() string {
+	switch {
+	case d.Year < 0 || d.Month < 0 || d.Day < 0:
+		return "date has negative component"
+	case d.Month > 12:
+		return fmt.Sprintf("invalid month %d", d.Month)
+	case d.Day > 0 && d.Month == 0:
+		return "date has day but no month"
+	case d.Day > 0:
+		// If the year is unknown, use a leap year so that February 29 is accepted.
+		year := d.Year
+		if year == 0 {
+			year = 2000
+		}
+		// Normalizing day 0 of the next month yields the last day of this month.
+		last := time.Date(year, time.Month(d.Month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+		if d.Day > last {
+			return fmt.Sprintf("invalid date %04d-%02d-%02d", d.Year, d.Month, d.Day)
+		}
+	}
+	return ""
+}
+
+// before returns true if d is known to precede o.
+// Components that are unset in either date are not compared.
+func (d *Date) before(o Date) bool {
+	if d.Year == 0 || o.Year == 0 || d.Year != o.Year {
+		return d.Year != 0 && o.Year != 0 && d.Year < o.Year
+	}
+	if d.Month == 0 || o.Month == 0 || d.Month != o.Month {
+		return d.Month != 0 && o.Month != 0 && d.Month < o.Month
+	}
+	return d.Day != 0 && o.Day != 0 && d.Day < o.Day
+}
+
+// validGTIN returns true if the final digit of code (a UPC or EAN barcode)
+// is the correct check digit.
+func validGTIN(code string) bool {
+	var sum int
+	for i := 0; i < len(code)-1; i++ {
+		d := int(code[len(code)-2-i] - '0')
+		if i%2 == 0 {
+			d *= 3 // digits are weighted 3, 1, 3, ... starting next to the check digit
+		}
+		sum += d
+	}
+	return int(code[len(code)-1]-'0') == (10-sum%10)%10
+}
+
+// validISNI returns true if code (16 characters) has a correct ISO 7064 MOD 11-2 check character.
+func validISNI(code string) bool {
+	var total int
+	for i := 0; i < 15; i++ {
+		total = (total + int(code[i]-'0')) * 2
+	}
+	want := (12 - total%11) % 11
+	if want == 10 {
+		return code[15] == 'X'
+	}
+	return int(code[15]-'0') == want
+}
+
+// validISWC returns true if check is the correct check digit for the 9-digit ISWC body digits.
+func validISWC(digits, check string) bool {
+	sum := 1 // for the "T" prefix
+	for i, ch := range digits {
+		sum += (i + 1) * int(ch-'0')
+	}
+	return strconv.Itoa((10-sum%10)%10) == check
+}
@@ -0,0 +1,98 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package seed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestValidate(t *testing.T) {
+	for _, tc := range []struct {
+		edit Edit
+		want []string // Problem.Field values
+	}{
+		{&Artist{
+			IPICodes:  []string{"00123456789", "123456789", "123.456.789"},
+			ISNICodes: []string{"0000 0001 2146 438X", "0000000121707484"},
+			BeginDate: MakeDate(1956, 2, 29),
+			EndDate:   MakeDate(2018, 12, 31),
+		}, nil},
+		{&Artist{
+			IPICodes:  []string{"1234", "12345678901a"},
+			ISNICodes: []string{"1234567899999799", "123"},
+			BeginDate: MakeDate(2023, 2, 30),
+		}, []string{"IPICodes[0]", "IPICodes[1]", "ISNICodes[0]", "ISNICodes[1]", "BeginDate"}},
+		{&Artist{BeginDate: MakeDate(2000, 0, 0), EndDate: MakeDate(1999, 5, 0)}, []string{"EndDate"}},
+		{&Artist{BeginDate: MakeDate(2000, 5, 0), EndDate: MakeDate(2000, 0, 0)}, nil},
+		{&Artist{BeginDate: MakeDate(2000, 5, 3), EndDate: MakeDate(2000, 5, 2)}, []string{"EndDate"}},
+		{&Artist{BeginDate: MakeDate(0, 2, 29), EndDate: MakeDate(2000, 13, 0)}, []string{"EndDate"}},
+		{&Artist{BeginDate: MakeDate(2000, 0, 5)}, []string{"BeginDate"}},
+		{&Event{Time: "19:30", BeginDate: MakeDate(2020, 1, 2), EndDate: MakeDate(2020, 1, 3)}, nil},
+		{&Event{Time: "7:30pm", BeginDate: MakeDate(2020, 1, 2), EndDate: MakeDate(2019, 1, 3)},
+			[]string{"EndDate", "Time"}},
+		{&Label{LabelCode: "02070", ISNICodes: []string{"0000000121032683"}}, nil},
+		{&Label{LabelCode: "LC-2070"}, []string{"LabelCode"}},
+		{&Label{LabelCode: "123456"}, []string{"LabelCode"}},
+		{&Label{LabelCode: "0"}, []string{"LabelCode"}},
+		{&Recording{ISRCs: []string{"USRC17607839"}, Length: 3 * time.Minute}, nil},
+		{&Recording{ISRCs: []string{"US-RC1-76-07839", "usrc17607839"}, Length: -time.Second},
+			[]string{"Length", "ISRCs[0]", "ISRCs[1]"}},
+		{&Recording{
+			Length: 25 * time.Hour,
+			Relationships: []Relationship{
+				{BeginDate: MakeDate(2001, 0, 0)},
+				{BeginDate: MakeDate(2001, 0, 0), EndDate: MakeDate(2000, 0, 0)},
+			},
+		}, []string{"Length", "Relationships[1].EndDate"}},
+		{&Release{
+			Barcode:  "4006381333931",
+			Language: "eng",
+			Script:   "Latn",
+			Events:   []ReleaseEvent{{Date: MakeDate(2021, 5, 3)}},
+			Mediums:  []Medium{{Tracks: []Track{{Length: time.Minute}}}, {Tracks: []Track{{}, {}}}},
+		}, nil},
+		{&Release{Barcode: "none"}, nil},
+		{&Release{Barcode: "036000291452"}, nil},
+		{&Release{Barcode: "4006381333932"}, []string{"Barcode"}},
+		{&Release{Barcode: "12345"}, []string{"Barcode"}},
+		{&Release{Barcode: "ABCDEFGHIJKLM"}, []string{"Barcode"}},
+		{&Release{Language: "en", Script: "latn"}, []string{"Language", "Script"}},
+		{&Release{Language: "xyz", Script: "Abcd"}, []string{"Language", "Script"}},
+		{&Release{Language: "mul", Script: "Zyyy"}, nil},
+		{&Release{Mediums: []Medium{{Tracks: []Track{{Length: time.Minute}, {}}}}},
+			[]string{"Mediums[0].Tracks[1].Length"}},
+		{&Release{
+			Events: []ReleaseEvent{{Date: MakeDate(2021, 4, 31)}},
+			Mediums: []Medium{
+				{Tracks: []Track{{Length: time.Minute}}},
+				{Tracks: []Track{{Length: time.Microsecond}, {Length: 30 * time.Hour}}},
+			},
+		}, []string{"Events[0].Date", "Mediums[1].Tracks[0].Length", "Mediums[1].Tracks[1].Length"}},
+		{&Recording{MBID: "0a1b2c3d-0000-4000-8000-000000000001", Tags: []string{"rock"}, Rating: 5}, nil},
+		{&Recording{Tags: []string{"rock"}, Rating: 5}, []string{"Tags", "Rating"}},
+		{&Work{MBID: "0a1b2c3d-0000-4000-8000-000000000001", Rating: 6}, []string{"Rating"}},
+		{&Release{RecordingMatches: []Problem{{"Mediums[0].Tracks[0].ISRC", "no recording"}}},
+			[]string{"Mediums[0].Tracks[0].ISRC"}},
+		{&ReleaseGroup{Types: []ReleaseGroupType{ReleaseGroupType_Album, ReleaseGroupType_Live}}, nil},
+		{&ReleaseGroup{Types: []ReleaseGroupType{
+			ReleaseGroupType_Album, "Bogus", ReleaseGroupType_Live, ReleaseGroupType_EP,
+		}}, []string{"Types[1]", "Types[3]"}},
+		{&Work{ISWCs: []string{"T-034.524.680-1", "T0345246801"}}, nil},
+		{&Work{ISWCs: []string{"T-034.524.680-2", "034.524.680-1"}}, []string{"ISWCs[0]", "ISWCs[1]"}},
+		{&Work{Languages: []Language{Language_English, 5267}}, nil}, // 5267 is low-frequency
+		{&Work{Languages: []Language{Language_English, 0, 999999}}, []string{"Languages[1]", "Languages[2]"}},
+	} {
+		var got []string
+		for _, p := range Validate(tc.edit) {
+			got = append(got, p.Field)
+		}
+		if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Errorf("Validate(%+v) returned wrong problems:\n%s", tc.edit, diff)
+		}
+	}
+}
@@ -19,30 +19,36 @@ import (
 // See https://musicbrainz.org/doc/Work for more information about work entities.
 type Work struct {
 	// MBID contains the work's MBID (for editing an existing work rather than creating a new one).
-	MBID string
+	MBID string `json:"mbid,omitempty"`
 	// Name contains the work's name.
-	Name string
+	Name string `json:"name,omitempty"`
 	// Disambiguation differentiates this work from other works with similar names.
 	// See https://musicbrainz.org/doc/Disambiguation_Comment.
-	Disambiguation string
+	Disambiguation string `json:"disambiguation,omitempty"`
 	// Type describes the work's type.
 	// See "Types of works" at https://musicbrainz.org/doc/Work.
-	Type WorkType
+	Type WorkType `json:"type,omitempty"`
 	// Languages contains database IDs corresponding to the language(s) of the work's lyrics.
-	Languages []Language
+	Languages []Language `json:"languages,omitempty"`
 	// ISWCs contains unique identifiers for the work in T-DDD.DDD.DDD-C format.
 	// See https://wiki.musicbrainz.org/ISWC.
-	ISWCs []string
+	ISWCs []string `json:"iswcs,omitempty"`
 	// Attributes contains attributes describing this work.
-	Attributes []WorkAttribute
+	Attributes []WorkAttribute `json:"attributes,omitempty"`
 	// Relationships contains (non-URL) relationships between this work and other entities.
-	Relationships []Relationship
+	Relationships []Relationship `json:"relationships,omitempty"`
 	// URLs contains relationships between this work and one or more URLs.
 	// See https://musicbrainz.org/doc/Style/Relationships/URLs.
-	URLs []URL
+	URLs []URL `json:"urls,omitempty"`
+	// Changes lists modifications to the existing work. See Edit.
+	Changes []string `json:"changes,omitempty"`
+	// Tags contains tags to add to the existing work. See GetSubmission.
+	Tags []string `json:"tags,omitempty"`
+	// Rating contains a 1-5 rating for the existing work. See GetSubmission.
+	Rating int `json:"rating,omitempty"`
 	// EditNote contains the note attached to the edit.
 	// See https://musicbrainz.org/doc/Edit_Note.
-	EditNote string
+	EditNote string `json:"edit_note,omitempty"`
 }
 
 func (w *Work) Entity() Entity { return WorkEntity }
@@ -55,6 +61,9 @@ func (w *Work) Description() string {
 	if w.Name != "" {
 		parts = append(parts, w.Name)
 	}
+	if len(w.Changes) > 0 {
+		parts = append(parts, strings.Join(w.Changes, "; "))
+	}
 	if len(parts) == 0 {
 		return "[unknown]"
 	}
@@ -104,14 +113,17 @@ func (w *Work) Params() url.Values {
 
 func (w *Work) Method() string { return http.MethodGet }
 
-func (w *Work) Finish(ctx context.Context, db *mbdb.DB) error { return nil }
+func (w *Work) Finish(ctx context.Context, db *mbdb.DB) error {
+	finishURLs(w.URLs, WorkEntity)
+	return nil
+}
 
 // WorkAttribute describes an attribute associated with a work.
 type WorkAttribute struct {
 	// Type specifies the attribute's type.
-	Type WorkAttributeType
+	Type WorkAttributeType `json:"type,omitempty"`
 	// Value holds the attribute's value, e.g. an actual ID.
-	Value string
+	Value string `json:"value,omitempty"`
 }
 
 // setParams sets query parameters in vals corresponding to non-empty fields in attr.
@@ -5,12 +5,14 @@
 package mp3
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"time"
 
 	"github.com/derat/mpeg"
 	"github.com/derat/taglib-go/taglib"
+	"github.com/derat/yambs/mbdb"
 	"github.com/derat/yambs/seed"
 	"github.com/derat/yambs/sources/text"
 )
@@ -18,7 +20,9 @@ import (
 // ReadFile reads the passed-in MP3 file and returns an edit of the requested type
 // (i.e. either a standalone recording or a "single" release) and additional
 // informational edits for any embedded images.
-func ReadFile(f *os.File, typ seed.Entity, rawSetCmds []string) ([]seed.Edit, error) {
+// db is used to finish the edit (see seed.Edit.Finish).
+func ReadFile(ctx context.Context, f *os.File, typ seed.Entity, rawSetCmds []string,
+	db *mbdb.DB) ([]seed.Edit, error) {
 	setCmds, err := text.ParseSetCommands(rawSetCmds, typ)
 	if err != nil {
 		return nil, err
@@ -39,6 +43,9 @@ func ReadFile(f *os.File, typ seed.Entity, rawSetCmds []string) ([]seed.Edit, er
 			return nil, fmt.Errorf("failed setting %q: %v", pair[0]+"="+pair[1], err)
 		}
 	}
+	if err := edit.Finish(ctx, db); err != nil {
+		return nil, err
+	}
 	edits := []seed.Edit{edit}
 
 	// Add an informational edit for each embedded image.
@@ -67,6 +74,7 @@ type songInfo struct {
 	artist string
 	title  string
 	album  string
+	isrc   string
 	length time.Duration
 	time   mpeg.Time
 	images []imgInfo
@@ -103,6 +111,9 @@ func readSongInfo(f *os.File) (*songInfo, error) {
 		song.artist = v2.Artist()
 		song.title = v2.Title()
 		song.album = v2.Album()
+		if song.isrc, err = mpeg.GetID3v2TextFrame(v2, "TSRC"); err != nil {
+			return nil, err
+		}
 
 		for _, tt := range []mpeg.TimeType{mpeg.ReleaseTime, mpeg.RecordingTime} {
 			if tm, err := mpeg.GetID3v2Time(v2, tt); err != nil {
@@ -126,6 +137,17 @@ func readSongInfo(f *os.File) (*songInfo, error) {
 	return &song, nil
 }
 
+// songArtists splits the supplied artist string into individual credits.
+// NameAsCredited is used rather than Name since the standalone recording
+// form seems to ignore the latter.
+func songArtists(artist string) []seed.ArtistCredit {
+	acs := seed.ParseArtistCredits(artist)
+	for i := range acs {
+		acs[i].NameAsCredited, acs[i].Name = acs[i].Name, ""
+	}
+	return acs
+}
+
 // MP3 release date per https://en.wikipedia.org/wiki/MP3.
 var mp3RelDate = time.Date(1991, 12, 6, 0, 0, 0, 0, time.UTC)
 
@@ -133,11 +155,16 @@ var mp3RelDate = time.Date(1991, 12, 6, 0, 0, 0, 0, time.UTC)
 func createSongEdit(song *songInfo, typ seed.Entity) (seed.Edit, error) {
 	switch typ {
 	case seed.RecordingEntity:
-		return &seed.Recording{
+		rec := seed.Recording{
 			Name:    song.title,
-			Artists: []seed.ArtistCredit{{NameAsCredited: song.artist}},
+			Artists: songArtists(song.artist),
 			Length:  song.length,
-		}, nil
+		}
+		if song.isrc != "" {
+			rec.ISRCs = []string{song.isrc}
+		}
+		rec.Autofill()
+		return &rec, nil
 
 	case seed.ReleaseEntity:
 		rel := seed.Release{
@@ -148,12 +175,13 @@ func createSongEdit(song *songInfo, typ seed.Entity) (seed.Edit, error) {
 			Script:    "Latn",
 			Status:    seed.ReleaseStatus_Official,
 			Packaging: seed.ReleasePackaging_None,
-			Artists:   []seed.ArtistCredit{{NameAsCredited: song.artist}},
+			Artists:   songArtists(song.artist),
 			Mediums: []seed.Medium{{
 				Format: seed.MediumFormat_DigitalMedia,
 				Tracks: []seed.Track{{
 					Title:  song.title,
 					Length: song.length,
+					ISRC:   song.isrc,
 				}},
 			}},
 		}
@@ -4,10 +4,12 @@
 package mp3
 
 import (
+	"context"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/derat/yambs/mbdb"
 	"github.com/derat/yambs/seed"
 	"github.com/google/go-cmp/cmp"
 )
@@ -70,5 +72,5 @@ func getEdits(p string, typ seed.Entity, rawSetCmds []string) ([]seed.Edit, erro
 	}
 	defer f.Close()
 
-	return ReadFile(f, typ, rawSetCmds)
+	return ReadFile(context.Background(), f, typ, rawSetCmds, mbdb.NewDB(mbdb.DisallowQueries))
 }
@@ -0,0 +1,194 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package musicbrainz
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/derat/yambs/mbdb"
+	"github.com/derat/yambs/seed"
+)
+
+// Diff fetches the existing entity that will be modified by edit and clears
+// fields in edit that already match the entity's current data, so that only
+// changed fields will be seeded in the edit form.
+//
+// Human-readable descriptions of the remaining changes are saved to edit's
+// Changes field (making them appear in Description) and appended to its edit
+// note. The descriptions are also returned; an empty (but non-nil) slice
+// indicates that the edit doesn't change anything.
+//
+// Edits that don't refer to existing entities (e.g. ones with empty MBIDs) are
+// left unchanged and a nil slice is returned for them. Relationships and URLs
+// are also left untouched, since Fetch doesn't return them and the edit form
+// adds them alongside the entity's existing relationships. Release edits are
+// unsupported.
+func Diff(ctx context.Context, db *mbdb.DB, edit seed.Edit) ([]string, error) {
+	ev := reflect.ValueOf(edit).Elem()
+	if ev.Kind() != reflect.Struct {
+		return nil, nil
+	}
+	mv := ev.FieldByName("MBID")
+	if !mv.IsValid() || mv.String() == "" {
+		return nil, nil
+	}
+	if !ev.FieldByName("Changes").IsValid() {
+		return nil, fmt.Errorf("diffs not supported for %v edits", edit.Entity())
+	}
+
+	cur, err := Fetch(ctx, db, edit.Entity(), mv.String())
+	if err != nil {
+		return nil, err
+	}
+	cv := reflect.ValueOf(cur).Elem()
+
+	changes := []string{}
+	for i := 0; i < ev.NumField(); i++ {
+		name := ev.Type().Field(i).Name
+		if skipDiffFields[name] || skipDiffFields[string(edit.Entity())+"."+name] {
+			continue
+		}
+		fv, cfv := ev.Field(i), cv.Field(i)
+		if fv.IsZero() {
+			continue // not being seeded
+		}
+		var same bool
+		if acs, ok := fv.Interface().([]seed.ArtistCredit); ok {
+			if same, err = sameArtistCredits(ctx, db, cfv.Interface().([]seed.ArtistCredit), acs); err != nil {
+				return nil, err
+			}
+		} else if edit.Entity() == seed.LabelEntity && name == "LabelCode" {
+			same = sameLabelCodes(cfv.String(), fv.String())
+		} else {
+			same = reflect.DeepEqual(fv.Interface(), cfv.Interface())
+		}
+		if same {
+			fv.Set(reflect.Zero(fv.Type()))
+		} else {
+			changes = append(changes, fmt.Sprintf("%s: %s → %s",
+				name, formatDiffValue(cfv), formatDiffValue(fv)))
+		}
+	}
+
+	ev.FieldByName("Changes").Set(reflect.ValueOf(changes))
+	if len(changes) > 0 {
+		nv := ev.FieldByName("EditNote")
+		note := "Changes:\n" + strings.Join(changes, "\n")
+		if nv.String() != "" {
+			note = nv.String() + "\n\n" + note
+		}
+		nv.SetString(note)
+	}
+	return changes, nil
+}
+
+// skipDiffFields contains names of fields that are ignored by Diff.
+// Fields that only apply to a single entity type are prefixed by the type,
+// e.g. "work.Languages".
+var skipDiffFields = map[string]bool{
+	"MBID":          true,
+	"Relationships": true,
+	"URLs":          true,
+	"Changes":       true,
+	"EditNote":      true,
+
+	// These fields aren't returned by Fetch.
+	"Tags":                true,
+	"Rating":              true,
+	"recording.Artist":    true,
+	"series.OrderingType": true,
+	"work.Languages":      true,
+	"work.Attributes":     true,
+}
+
+// sameArtistCredits returns true if seeded describes the same artist credits as cur,
+// which should have been returned by Fetch. Finish may have already replaced MBIDs in
+// seeded with database IDs, and names are only compared if they were supplied.
+func sameArtistCredits(ctx context.Context, db *mbdb.DB, cur, seeded []seed.ArtistCredit) (bool, error) {
+	if len(cur) != len(seeded) {
+		return false, nil
+	}
+	for i, c := range cur {
+		s := seeded[i]
+		switch {
+		case s.MBID != "":
+			if s.MBID != c.MBID {
+				return false, nil
+			}
+		case s.ID != 0:
+			id, err := db.GetDatabaseID(ctx, c.MBID)
+			if err != nil {
+				return false, err
+			}
+			if s.ID != id {
+				return false, nil
+			}
+		case s.Name != c.Name:
+			return false, nil
+		}
+		credited := c.NameAsCredited
+		if credited == "" {
+			credited = c.Name
+		}
+		if s.NameAsCredited != "" && s.NameAsCredited != credited {
+			return false, nil
+		}
+		if s.JoinPhrase != c.JoinPhrase {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// sameLabelCodes returns true if a and b contain the same label code,
+// ignoring zero-padding (e.g. "2070" and "02070").
+func sameLabelCodes(a, b string) bool {
+	trim := func(s string) string { return strings.TrimLeft(strings.TrimSpace(s), "0") }
+	return trim(a) == trim(b)
+}
+
+// formatDiffValue returns a human-readable representation of v for Diff.
+func formatDiffValue(v reflect.Value) string {
+	if v.IsZero() || (v.Kind() == reflect.Slice && v.Len() == 0) {
+		return "[none]"
+	}
+	switch val := v.Interface().(type) {
+	case string:
+		return fmt.Sprintf("%q", val)
+	case []string:
+		return fmt.Sprintf("%q", val)
+	case seed.Date:
+		s := fmt.Sprintf("%04d", val.Year)
+		if val.Month > 0 {
+			s += fmt.Sprintf("-%02d", val.Month)
+			if val.Day > 0 {
+				s += fmt.Sprintf("-%02d", val.Day)
+			}
+		}
+		return s
+	case time.Duration:
+		return val.String()
+	case []seed.ArtistCredit:
+		var names []string
+		for _, ac := range val {
+			switch {
+			case ac.NameAsCredited != "":
+				names = append(names, ac.NameAsCredited)
+			case ac.Name != "":
+				names = append(names, ac.Name)
+			case ac.MBID != "":
+				names = append(names, ac.MBID)
+			default:
+				names = append(names, fmt.Sprint(ac.ID))
+			}
+			names[len(names)-1] += ac.JoinPhrase
+		}
+		return fmt.Sprintf("%q", strings.Join(names, ""))
+	}
+	return fmt.Sprint(v.Interface())
+}
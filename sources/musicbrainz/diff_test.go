@@ -0,0 +1,131 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package musicbrainz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/derat/yambs/mbdb"
+	"github.com/derat/yambs/seed"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiff(t *testing.T) {
+	const (
+		artistMBID = "b10bbbfc-cf9e-42e0-be17-e2c3e1d2600d"
+		artistData = `{"id":"b10bbbfc-cf9e-42e0-be17-e2c3e1d2600d","name":"The Beatles","sort-name":"Beatles, The","disambiguation":"","type":"Group","gender":null,"area":{"name":"United Kingdom"},"ipis":[],"isnis":["0000000121707484"],"life-span":{"begin":"1960","end":"1970-04-10","ended":true}}`
+
+		recMBID     = "bd5ae3f5-3c3b-4b8e-9d40-2b3f0a07d0f3"
+		recData     = `{"id":"bd5ae3f5-3c3b-4b8e-9d40-2b3f0a07d0f3","title":"Song","disambiguation":"","length":225123,"video":false,"isrcs":[],"artist-credit":[{"name":"Someone","joinphrase":"","artist":{"id":"65389277-491a-4055-8e71-0a9be1c9c99c","name":"Someone"}}]}`
+		recArtistID = 123
+
+		labelMBID = "1ca5ed29-e00b-4ea5-b817-0bcca0e04946"
+		labelData = `{"id":"1ca5ed29-e00b-4ea5-b817-0bcca0e04946","name":"Warp","disambiguation":"","type":null,"label-code":2070,"area":null,"ipis":[],"isnis":[],"life-span":{"begin":null,"end":null,"ended":false}}`
+	)
+
+	srv := newTestServer(map[string]string{
+		"/ws/2/artist/" + artistMBID + "?fmt=json":                          artistData,
+		"/ws/2/recording/" + recMBID + "?fmt=json&inc=artist-credits+isrcs": recData,
+		"/ws/2/label/" + labelMBID + "?fmt=json":                            labelData,
+	})
+	defer srv.Close()
+	db := mbdb.NewDB(mbdb.ServerURL(srv.URL), mbdb.MaxQPS(100))
+	db.SetDatabaseIDForTest("65389277-491a-4055-8e71-0a9be1c9c99c", recArtistID)
+
+	for _, tc := range []struct {
+		edit    seed.Edit
+		want    seed.Edit
+		changes []string
+	}{
+		{
+			// Unchanged fields should be cleared.
+			&seed.Artist{
+				MBID:           artistMBID,
+				Name:           "The Beatles",
+				Disambiguation: "Liverpool band",
+				Type:           seed.ArtistType_Group,
+				BeginDate:      seed.MakeDate(1960, 8, 0),
+				Ended:          true,
+				URLs:           []seed.URL{{URL: "https://www.example.org/"}},
+				EditNote:       "Fixing things",
+			},
+			&seed.Artist{
+				MBID:           artistMBID,
+				Disambiguation: "Liverpool band",
+				BeginDate:      seed.MakeDate(1960, 8, 0),
+				URLs:           []seed.URL{{URL: "https://www.example.org/"}},
+				Changes: []string{
+					`Disambiguation: [none] → "Liverpool band"`,
+					`BeginDate: 1960 → 1960-08`,
+				},
+				EditNote: "Fixing things\n\nChanges:\n" +
+					`Disambiguation: [none] → "Liverpool band"` + "\n" +
+					`BeginDate: 1960 → 1960-08`,
+			},
+			[]string{
+				`Disambiguation: [none] → "Liverpool band"`,
+				`BeginDate: 1960 → 1960-08`,
+			},
+		},
+		{
+			// Artist credits that were already converted to database IDs should still match.
+			&seed.Recording{
+				MBID:    recMBID,
+				Name:    "Song (remix)",
+				Artists: []seed.ArtistCredit{{ID: recArtistID}},
+				Length:  225123 * time.Millisecond,
+			},
+			&seed.Recording{
+				MBID:     recMBID,
+				Name:     "Song (remix)",
+				Changes:  []string{`Name: "Song" → "Song (remix)"`},
+				EditNote: "Changes:\n" + `Name: "Song" → "Song (remix)"`,
+			},
+			[]string{`Name: "Song" → "Song (remix)"`},
+		},
+		{
+			// An empty slice should be returned if nothing is changed.
+			&seed.Artist{MBID: artistMBID, Name: "The Beatles"},
+			&seed.Artist{MBID: artistMBID, Changes: []string{}},
+			[]string{},
+		},
+		{
+			// Label codes should be compared without zero-padding.
+			&seed.Label{MBID: labelMBID, Name: "Warp", LabelCode: "2070"},
+			&seed.Label{MBID: labelMBID, Changes: []string{}},
+			[]string{},
+		},
+		{
+			// Tags and ratings aren't seeded, so they shouldn't be reported as changes.
+			&seed.Label{MBID: labelMBID, Name: "Warp", Tags: []string{"electronic"}, Rating: 4},
+			&seed.Label{MBID: labelMBID, Tags: []string{"electronic"}, Rating: 4, Changes: []string{}},
+			[]string{},
+		},
+		{
+			// Edits creating new entities should be left alone.
+			&seed.Artist{Name: "New Artist"},
+			&seed.Artist{Name: "New Artist"},
+			nil,
+		},
+	} {
+		desc := tc.edit.Description()
+		changes, err := Diff(context.Background(), db, tc.edit)
+		if err != nil {
+			t.Errorf("Diff(%v) failed: %v", desc, err)
+			continue
+		}
+		if diff := cmp.Diff(tc.changes, changes); diff != "" {
+			t.Errorf("Diff(%v) returned wrong changes:\n%s", desc, diff)
+		}
+		if diff := cmp.Diff(tc.want, tc.edit); diff != "" {
+			t.Errorf("Diff(%v) produced wrong edit:\n%s", desc, diff)
+		}
+	}
+
+	if _, err := Diff(context.Background(), db, &seed.Release{MBID: artistMBID}); err == nil {
+		t.Error("Diff unexpectedly succeeded for release")
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package musicbrainz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/derat/yambs/mbdb"
+	"github.com/derat/yambs/seed"
+)
+
+// maxDuplicateResults is the maximum number of search results to request per query.
+const maxDuplicateResults = 10
+
+// Duplicate describes an existing release that appears to be the same as a seeded release.
+type Duplicate struct {
+	// MBID contains the existing release's MBID.
+	MBID string
+	// Title contains the existing release's title.
+	Title string
+	// Artist contains the existing release's full artist credit.
+	// It is empty for releases found via URL relationships.
+	Artist string
+	// Reason describes why the release was matched, e.g. "same barcode 0123456789012".
+	Reason string
+}
+
+// Describe returns a human-readable description of d including its URL on serverURL,
+// e.g. `https://musicbrainz.org/release/… ("Album" by Artist; same barcode 0123456789012)`.
+func (d *Duplicate) Describe(serverURL string) string {
+	desc := fmt.Sprintf("%q", d.Title)
+	if d.Artist != "" {
+		desc += " by " + d.Artist
+	}
+	return fmt.Sprintf("%s/release/%s (%s; %s)", serverURL, d.MBID, desc, d.Reason)
+}
+
+// FindDuplicates searches for existing releases that are already related to one of
+// edit's URLs (e.g. a store page) or that have the same barcode or the same catalog
+// number and label as edit, which should be a new release. nil is returned for other
+// edits (including ones that modify existing releases).
+func FindDuplicates(ctx context.Context, db *mbdb.DB, edit seed.Edit) ([]Duplicate, error) {
+	rel, ok := edit.(*seed.Release)
+	if !ok || rel.MBID != "" {
+		return nil, nil
+	}
+
+	var dups []Duplicate
+	seen := make(map[string]struct{})
+
+	if len(rel.URLs) > 0 {
+		urls := make([]string, len(rel.URLs))
+		for i, u := range rel.URLs {
+			urls[i] = u.URL
+		}
+		linked, err := db.GetReleasesFromURLs(ctx, urls)
+		if err != nil {
+			return nil, err
+		}
+		// Iterate over the slice rather than the map to get a consistent order.
+		for _, u := range urls {
+			for _, info := range linked[u] {
+				if _, ok := seen[info.MBID]; ok {
+					continue
+				}
+				seen[info.MBID] = struct{}{}
+				dups = append(dups, Duplicate{MBID: info.MBID, Title: info.Name, Reason: "linked to " + u})
+			}
+		}
+	}
+	add := func(query, reason string, match func(*releaseResult) bool) error {
+		var data struct {
+			Releases []releaseResult `json:"releases"`
+		}
+		if err := db.Search(ctx, "release", query, maxDuplicateResults, &data); err != nil {
+			return err
+		}
+		for i := range data.Releases {
+			res := &data.Releases[i]
+			if _, ok := seen[res.ID]; ok || !match(res) {
+				continue
+			}
+			seen[res.ID] = struct{}{}
+			dups = append(dups, Duplicate{
+				MBID:   res.ID,
+				Title:  res.Title,
+				Artist: res.artist(),
+				Reason: reason,
+			})
+		}
+		return nil
+	}
+
+	if bc := strings.TrimSpace(rel.Barcode); bc != "" && bc != "none" {
+		// Also search for the UPC-A or EAN-13 form of the barcode.
+		query := "barcode:" + quoteQuery(bc)
+		if len(bc) == 12 {
+			query = fmt.Sprintf("barcode:(%s OR %s)", quoteQuery(bc), quoteQuery("0"+bc))
+		} else if len(bc) == 13 && bc[0] == '0' {
+			query = fmt.Sprintf("barcode:(%s OR %s)", quoteQuery(bc), quoteQuery(bc[1:]))
+		}
+		if err := add(query, "same barcode "+bc, func(res *releaseResult) bool {
+			return sameBarcode(res.Barcode, bc)
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, rl := range rel.Labels {
+		catno := strings.TrimSpace(rl.CatalogNumber)
+		if catno == "" || strings.EqualFold(catno, "[none]") || (rl.MBID == "" && rl.Name == "") {
+			continue
+		}
+		query := "catno:" + quoteQuery(catno)
+		if rl.MBID != "" {
+			query += " AND laid:" + rl.MBID
+		} else {
+			query += " AND label:" + quoteQuery(rl.Name)
+		}
+		label := rl.Name
+		if label == "" {
+			label = rl.MBID
+		}
+		reason := fmt.Sprintf("same catalog number %s on %s", catno, label)
+		if err := add(query, reason, func(res *releaseResult) bool {
+			for _, li := range res.LabelInfo {
+				if li.Label == nil || !sameCatalogNumber(li.CatalogNumber, catno) {
+					continue
+				}
+				if (rl.MBID != "" && li.Label.ID == rl.MBID) ||
+					(rl.MBID == "" && strings.EqualFold(li.Label.Name, rl.Name)) {
+					return true
+				}
+			}
+			return false
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return dups, nil
+}
+
+// releaseResult is a release returned by the /ws/2/release search endpoint.
+type releaseResult struct {
+	ID           string         `json:"id"`
+	Title        string         `json:"title"`
+	Barcode      string         `json:"barcode"`
+	ArtistCredit []artistCredit `json:"artist-credit"`
+	LabelInfo    []struct {
+		CatalogNumber string `json:"catalog-number"`
+		Label         *struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"label"`
+	} `json:"label-info"`
+}
+
+// artist returns the full artist credit for res, e.g. "Artist feat. Other".
+func (res *releaseResult) artist() string {
+	var s string
+	for _, ac := range res.ArtistCredit {
+		s += ac.Name + ac.JoinPhrase
+	}
+	return s
+}
+
+// quoteQuery returns s as a quoted Lucene phrase.
+func quoteQuery(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// sameBarcode returns true if a and b represent the same barcode.
+// Leading zeros are ignored so that UPC-A and EAN-13 forms of the same code match.
+func sameBarcode(a, b string) bool {
+	return a != "" && strings.TrimLeft(a, "0") == strings.TrimLeft(b, "0")
+}
+
+// sameCatalogNumber returns true if a and b are the same catalog number,
+// ignoring case, whitespace, and punctuation.
+func sameCatalogNumber(a, b string) bool {
+	norm := func(s string) string {
+		return strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				return unicode.ToLower(r)
+			}
+			return -1
+		}, s)
+	}
+	return norm(a) != "" && norm(a) == norm(b)
+}
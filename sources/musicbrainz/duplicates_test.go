@@ -0,0 +1,66 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package musicbrainz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derat/yambs/mbdb/mbdbtest"
+	"github.com/derat/yambs/seed"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFindDuplicates(t *testing.T) {
+	const (
+		barcode   = "123456789012"
+		labelMBID = "1ca5ed29-e00b-4ea5-b817-0bcca0e04946"
+		mbid1     = "7f4c5b9e-0d0e-4ae5-9b0a-fb6f6c4a0e7a"
+		mbid2     = "0096a0bf-804e-4e47-bf2a-e0878dbb3eb7"
+		mbid3     = "65389277-491a-4055-8e71-0a9be1c9c99c"
+		mbid4     = "bd5ae3f5-3c3b-4b8e-9d40-2b3f0a07d0f3"
+		linkURL   = "https://tidal.com/album/1234"
+
+		// The first release matches the barcode and catalog number, the second release only
+		// matches the catalog number, and the third release has a different catalog number.
+		barcodeData = `{"count":1,"releases":[` +
+			`{"id":"` + mbid1 + `","score":100,"title":"Album","barcode":"0123456789012","artist-credit":[{"name":"Someone","joinphrase":" & "},{"name":"Other","joinphrase":""}],"label-info":[{"catalog-number":"WARP-1","label":{"id":"` + labelMBID + `","name":"Warp"}}]}]}`
+		catnoData = `{"count":3,"releases":[` +
+			`{"id":"` + mbid1 + `","score":100,"title":"Album","barcode":"0123456789012","artist-credit":[{"name":"Someone","joinphrase":" & "},{"name":"Other","joinphrase":""}],"label-info":[{"catalog-number":"WARP-1","label":{"id":"` + labelMBID + `","name":"Warp"}}]},` +
+			`{"id":"` + mbid2 + `","score":100,"title":"Album (reissue)","barcode":"","artist-credit":[{"name":"Someone","joinphrase":""}],"label-info":[{"catalog-number":"warp 1","label":{"id":"` + labelMBID + `","name":"Warp"}}]},` +
+			`{"id":"` + mbid3 + `","score":80,"title":"Other Album","barcode":"","artist-credit":[{"name":"Someone","joinphrase":""}],"label-info":[{"catalog-number":"WARP 10","label":{"id":"` + labelMBID + `","name":"Warp"}}]}]}`
+	)
+
+	srv := mbdbtest.NewServer(t)
+	srv.AddURLRel(linkURL, "release", mbid4, "Album (digital)")
+	srv.SetSearchResult("release", `barcode:("123456789012" OR "0123456789012")`, barcodeData)
+	srv.SetSearchResult("release", `catno:"WARP 1" AND laid:`+labelMBID, catnoData)
+	db := srv.NewDB()
+
+	ctx := context.Background()
+	rel := &seed.Release{
+		Title:   "Album",
+		Barcode: barcode,
+		Labels:  []seed.ReleaseLabel{{MBID: labelMBID, CatalogNumber: "WARP 1"}},
+		URLs:    []seed.URL{{URL: linkURL, LinkType: seed.LinkType_Streaming_Release_URL}},
+	}
+	got, err := FindDuplicates(ctx, db, rel)
+	if err != nil {
+		t.Fatal("FindDuplicates failed: ", err)
+	}
+	want := []Duplicate{
+		{MBID: mbid4, Title: "Album (digital)", Reason: "linked to " + linkURL},
+		{MBID: mbid1, Title: "Album", Artist: "Someone & Other", Reason: "same barcode " + barcode},
+		{MBID: mbid2, Title: "Album (reissue)", Artist: "Someone", Reason: "same catalog number WARP 1 on " + labelMBID},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error("FindDuplicates returned unexpected results:\n" + diff)
+	}
+
+	// Edits of existing releases shouldn't be checked.
+	rel.MBID = mbid1
+	if got, err := FindDuplicates(ctx, db, rel); err != nil || got != nil {
+		t.Errorf("FindDuplicates for existing release = %v, %v; want nil, nil", got, err)
+	}
+}
@@ -0,0 +1,451 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+// Package musicbrainz creates seed edits from existing MusicBrainz entities.
+package musicbrainz
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derat/yambs/mbdb"
+	"github.com/derat/yambs/seed"
+)
+
+// Fetch fetches the existing entity of type typ with the supplied MBID
+// and returns an edit of the entity with the entity's current data.
+//
+// Relationships (including URL relationships) are intentionally omitted,
+// since seeding them in the edit form would result in duplicate relationships
+// being added. Work languages and attributes are also omitted.
+func Fetch(ctx context.Context, db *mbdb.DB, typ seed.Entity, mbid string) (seed.Edit, error) {
+	switch typ {
+	case seed.ArtistEntity:
+		return fetchArtist(ctx, db, mbid)
+	case seed.EventEntity:
+		return fetchEvent(ctx, db, mbid)
+	case seed.LabelEntity:
+		return fetchLabel(ctx, db, mbid)
+	case seed.PlaceEntity:
+		return fetchPlace(ctx, db, mbid)
+	case seed.RecordingEntity:
+		return fetchRecording(ctx, db, mbid)
+	case seed.ReleaseEntity:
+		return fetchRelease(ctx, db, mbid)
+	case seed.ReleaseGroupEntity:
+		return fetchReleaseGroup(ctx, db, mbid)
+	case seed.SeriesEntity:
+		return fetchSeries(ctx, db, mbid)
+	case seed.WorkEntity:
+		return fetchWork(ctx, db, mbid)
+	default:
+		return nil, fmt.Errorf("unsupported entity type %q", typ)
+	}
+}
+
+// entityURLRegexp matches a URL like "https://musicbrainz.org/artist/<MBID>".
+var entityURLRegexp = regexp.MustCompile(
+	`^https?://(?:[-a-z0-9]+\.)?musicbrainz\.org/([-a-z]+)/` +
+		`([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})(?:[/?#].*)?$`)
+
+// ParseURL extracts the entity type and MBID from a MusicBrainz URL like
+// "https://musicbrainz.org/release/7f4c5b9e-0d0e-4ae5-9b0a-fb6f6c4a0e7a".
+// ok is false if the URL wasn't recognized or refers to an unsupported entity type.
+func ParseURL(u string) (typ seed.Entity, mbid string, ok bool) {
+	ms := entityURLRegexp.FindStringSubmatch(strings.ToLower(u))
+	if ms == nil {
+		return "", "", false
+	}
+	for _, t := range seed.EntityTypes {
+		if string(t) == ms[1] {
+			return t, ms[2], true
+		}
+	}
+	return "", "", false
+}
+
+// lifeSpan corresponds to the "life-span" object in /ws/2 responses.
+type lifeSpan struct {
+	Begin string `json:"begin"`
+	End   string `json:"end"`
+	Ended bool   `json:"ended"`
+}
+
+// area corresponds to an area object in /ws/2 responses.
+type area struct {
+	Name  string   `json:"name"`
+	Codes []string `json:"iso-3166-1-codes"`
+}
+
+// artistCredit corresponds to an element of an "artist-credit" array in /ws/2 responses.
+type artistCredit struct {
+	Name       string `json:"name"`
+	JoinPhrase string `json:"joinphrase"`
+	Artist     struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"artist"`
+}
+
+func fetchArtist(ctx context.Context, db *mbdb.DB, mbid string) (*seed.Artist, error) {
+	var data struct {
+		Name           string   `json:"name"`
+		SortName       string   `json:"sort-name"`
+		Disambiguation string   `json:"disambiguation"`
+		Type           string   `json:"type"`
+		Gender         string   `json:"gender"`
+		Area           *area    `json:"area"`
+		BeginArea      *area    `json:"begin-area"`
+		EndArea        *area    `json:"end-area"`
+		IPIs           []string `json:"ipis"`
+		ISNIs          []string `json:"isnis"`
+		LifeSpan       lifeSpan `json:"life-span"`
+	}
+	if err := db.GetEntity(ctx, "artist", mbid, nil, &data); err != nil {
+		return nil, err
+	}
+	artist := seed.Artist{
+		MBID:           mbid,
+		Name:           data.Name,
+		SortName:       data.SortName,
+		Disambiguation: data.Disambiguation,
+		Type:           artistTypes[data.Type],
+		Gender:         genders[data.Gender],
+		AreaName:       areaName(data.Area),
+		IPICodes:       data.IPIs,
+		ISNICodes:      data.ISNIs,
+		BeginDate:      parseDate(data.LifeSpan.Begin),
+		BeginAreaName:  areaName(data.BeginArea),
+		EndDate:        parseDate(data.LifeSpan.End),
+		Ended:          data.LifeSpan.Ended,
+		EndAreaName:    areaName(data.EndArea),
+	}
+	return &artist, nil
+}
+
+func fetchEvent(ctx context.Context, db *mbdb.DB, mbid string) (*seed.Event, error) {
+	var data struct {
+		Name           string   `json:"name"`
+		Disambiguation string   `json:"disambiguation"`
+		Type           string   `json:"type"`
+		Cancelled      bool     `json:"cancelled"`
+		Setlist        string   `json:"setlist"`
+		Time           string   `json:"time"`
+		LifeSpan       lifeSpan `json:"life-span"`
+	}
+	if err := db.GetEntity(ctx, "event", mbid, nil, &data); err != nil {
+		return nil, err
+	}
+	event := seed.Event{
+		MBID:           mbid,
+		Name:           data.Name,
+		Disambiguation: data.Disambiguation,
+		Type:           eventTypes[data.Type],
+		Cancelled:      data.Cancelled,
+		Setlist:        data.Setlist,
+		BeginDate:      parseDate(data.LifeSpan.Begin),
+		EndDate:        parseDate(data.LifeSpan.End),
+		Time:           data.Time,
+	}
+	return &event, nil
+}
+
+func fetchLabel(ctx context.Context, db *mbdb.DB, mbid string) (*seed.Label, error) {
+	var data struct {
+		Name           string   `json:"name"`
+		Disambiguation string   `json:"disambiguation"`
+		Type           string   `json:"type"`
+		Area           *area    `json:"area"`
+		LabelCode      int      `json:"label-code"`
+		IPIs           []string `json:"ipis"`
+		ISNIs          []string `json:"isnis"`
+		LifeSpan       lifeSpan `json:"life-span"`
+	}
+	if err := db.GetEntity(ctx, "label", mbid, nil, &data); err != nil {
+		return nil, err
+	}
+	label := seed.Label{
+		MBID:           mbid,
+		Name:           data.Name,
+		Disambiguation: data.Disambiguation,
+		Type:           labelTypes[data.Type],
+		AreaName:       areaName(data.Area),
+		IPICodes:       data.IPIs,
+		ISNICodes:      data.ISNIs,
+		BeginDate:      parseDate(data.LifeSpan.Begin),
+		EndDate:        parseDate(data.LifeSpan.End),
+		Ended:          data.LifeSpan.Ended,
+	}
+	if data.LabelCode > 0 {
+		// Pad the code to five digits, as MusicBrainz does when displaying it.
+		label.LabelCode = fmt.Sprintf("%05d", data.LabelCode)
+	}
+	return &label, nil
+}
+
+func fetchPlace(ctx context.Context, db *mbdb.DB, mbid string) (*seed.Place, error) {
+	var data struct {
+		Name           string `json:"name"`
+		Disambiguation string `json:"disambiguation"`
+		Type           string `json:"type"`
+		Address        string `json:"address"`
+		Area           *area  `json:"area"`
+		Coordinates    *struct {
+			Latitude  float64 `json:"latitude"`
+			Longitude float64 `json:"longitude"`
+		} `json:"coordinates"`
+		LifeSpan lifeSpan `json:"life-span"`
+	}
+	if err := db.GetEntity(ctx, "place", mbid, nil, &data); err != nil {
+		return nil, err
+	}
+	place := seed.Place{
+		MBID:           mbid,
+		Name:           data.Name,
+		Disambiguation: data.Disambiguation,
+		Type:           placeTypes[data.Type],
+		Address:        data.Address,
+		AreaName:       areaName(data.Area),
+		BeginDate:      parseDate(data.LifeSpan.Begin),
+		EndDate:        parseDate(data.LifeSpan.End),
+		Ended:          data.LifeSpan.Ended,
+	}
+	if c := data.Coordinates; c != nil {
+		place.Coordinates = strconv.FormatFloat(c.Latitude, 'f', -1, 64) + ", " +
+			strconv.FormatFloat(c.Longitude, 'f', -1, 64)
+	}
+	return &place, nil
+}
+
+func fetchRecording(ctx context.Context, db *mbdb.DB, mbid string) (*seed.Recording, error) {
+	var data struct {
+		Title          string         `json:"title"`
+		Disambiguation string         `json:"disambiguation"`
+		Length         int64          `json:"length"` // milliseconds
+		Video          bool           `json:"video"`
+		ISRCs          []string       `json:"isrcs"`
+		ArtistCredit   []artistCredit `json:"artist-credit"`
+	}
+	if err := db.GetEntity(ctx, "recording", mbid,
+		[]string{"artist-credits", "isrcs"}, &data); err != nil {
+		return nil, err
+	}
+	rec := seed.Recording{
+		MBID:           mbid,
+		Name:           data.Title,
+		Artists:        makeArtistCredits(data.ArtistCredit),
+		Disambiguation: data.Disambiguation,
+		Length:         time.Duration(data.Length) * time.Millisecond,
+		Video:          data.Video,
+		ISRCs:          data.ISRCs,
+	}
+	return &rec, nil
+}
+
+func fetchRelease(ctx context.Context, db *mbdb.DB, mbid string) (*seed.Release, error) {
+	var data struct {
+		Title              string `json:"title"`
+		Disambiguation     string `json:"disambiguation"`
+		Barcode            string `json:"barcode"`
+		Status             string `json:"status"`
+		Packaging          string `json:"packaging"`
+		TextRepresentation struct {
+			Language string `json:"language"`
+			Script   string `json:"script"`
+		} `json:"text-representation"`
+		ReleaseGroup struct {
+			ID string `json:"id"`
+		} `json:"release-group"`
+		ReleaseEvents []struct {
+			Date string `json:"date"`
+			Area *area  `json:"area"`
+		} `json:"release-events"`
+		LabelInfo []struct {
+			CatalogNumber string `json:"catalog-number"`
+			Label         *struct {
+				ID   string `json:"id"`
+				Name string `json:"name"`
+			} `json:"label"`
+		} `json:"label-info"`
+		ArtistCredit []artistCredit `json:"artist-credit"`
+		Media        []struct {
+			Format string `json:"format"`
+			Title  string `json:"title"`
+			Tracks []struct {
+				Number       string         `json:"number"`
+				Title        string         `json:"title"`
+				Length       int64          `json:"length"` // milliseconds
+				ArtistCredit []artistCredit `json:"artist-credit"`
+				Recording    struct {
+					ID string `json:"id"`
+				} `json:"recording"`
+			} `json:"tracks"`
+		} `json:"media"`
+	}
+	if err := db.GetEntity(ctx, "release", mbid,
+		[]string{"artist-credits", "labels", "recordings", "release-groups"}, &data); err != nil {
+		return nil, err
+	}
+
+	rel := seed.Release{
+		MBID:           mbid,
+		Title:          data.Title,
+		ReleaseGroup:   data.ReleaseGroup.ID,
+		Disambiguation: data.Disambiguation,
+		Barcode:        data.Barcode,
+		Language:       data.TextRepresentation.Language,
+		Script:         data.TextRepresentation.Script,
+		Status:         seed.ReleaseStatus(data.Status),
+		Packaging:      seed.ReleasePackaging(data.Packaging),
+		Artists:        makeArtistCredits(data.ArtistCredit),
+	}
+	for _, ev := range data.ReleaseEvents {
+		sev := seed.ReleaseEvent{Date: parseDate(ev.Date)}
+		if ev.Area != nil && len(ev.Area.Codes) > 0 {
+			sev.Country = ev.Area.Codes[0]
+		}
+		rel.Events = append(rel.Events, sev)
+	}
+	for _, li := range data.LabelInfo {
+		rl := seed.ReleaseLabel{CatalogNumber: li.CatalogNumber}
+		if li.Label != nil {
+			rl.MBID = li.Label.ID
+			rl.Name = li.Label.Name
+		}
+		rel.Labels = append(rel.Labels, rl)
+	}
+	for _, m := range data.Media {
+		med := seed.Medium{
+			Format: seed.MediumFormat(m.Format),
+			Name:   m.Title,
+		}
+		for _, t := range m.Tracks {
+			med.Tracks = append(med.Tracks, seed.Track{
+				Title:     t.Title,
+				Number:    t.Number,
+				Recording: t.Recording.ID,
+				Length:    time.Duration(t.Length) * time.Millisecond,
+				Artists:   makeArtistCredits(t.ArtistCredit),
+			})
+		}
+		rel.Mediums = append(rel.Mediums, med)
+	}
+	return &rel, nil
+}
+
+func fetchReleaseGroup(ctx context.Context, db *mbdb.DB, mbid string) (*seed.ReleaseGroup, error) {
+	var data struct {
+		Title          string         `json:"title"`
+		Disambiguation string         `json:"disambiguation"`
+		PrimaryType    string         `json:"primary-type"`
+		SecondaryTypes []string       `json:"secondary-types"`
+		ArtistCredit   []artistCredit `json:"artist-credit"`
+	}
+	if err := db.GetEntity(ctx, "release-group", mbid, []string{"artist-credits"}, &data); err != nil {
+		return nil, err
+	}
+	rg := seed.ReleaseGroup{
+		MBID:           mbid,
+		Title:          data.Title,
+		Disambiguation: data.Disambiguation,
+		Artists:        makeArtistCredits(data.ArtistCredit),
+	}
+	if data.PrimaryType != "" {
+		rg.Types = append(rg.Types, seed.ReleaseGroupType(data.PrimaryType))
+	}
+	for _, t := range data.SecondaryTypes {
+		rg.Types = append(rg.Types, seed.ReleaseGroupType(t))
+	}
+	return &rg, nil
+}
+
+func fetchSeries(ctx context.Context, db *mbdb.DB, mbid string) (*seed.Series, error) {
+	var data struct {
+		Name           string `json:"name"`
+		Disambiguation string `json:"disambiguation"`
+		Type           string `json:"type"`
+	}
+	if err := db.GetEntity(ctx, "series", mbid, nil, &data); err != nil {
+		return nil, err
+	}
+	// The API doesn't report the ordering type, so it's left unset.
+	series := seed.Series{
+		MBID:           mbid,
+		Name:           data.Name,
+		Disambiguation: data.Disambiguation,
+		Type:           seriesTypes[data.Type],
+	}
+	return &series, nil
+}
+
+func fetchWork(ctx context.Context, db *mbdb.DB, mbid string) (*seed.Work, error) {
+	var data struct {
+		Title          string   `json:"title"`
+		Disambiguation string   `json:"disambiguation"`
+		Type           string   `json:"type"`
+		ISWCs          []string `json:"iswcs"`
+	}
+	if err := db.GetEntity(ctx, "work", mbid, nil, &data); err != nil {
+		return nil, err
+	}
+	// The API reports languages as ISO 639-3 codes, but the edit form wants
+	// database IDs, so they're skipped here.
+	work := seed.Work{
+		MBID:           mbid,
+		Name:           data.Title,
+		Disambiguation: data.Disambiguation,
+		Type:           workTypes[data.Type],
+		ISWCs:          data.ISWCs,
+	}
+	return &work, nil
+}
+
+// makeArtistCredits converts acs to seed.ArtistCredit objects.
+func makeArtistCredits(acs []artistCredit) []seed.ArtistCredit {
+	var credits []seed.ArtistCredit
+	for _, ac := range acs {
+		sac := seed.ArtistCredit{
+			MBID:       ac.Artist.ID,
+			Name:       ac.Artist.Name,
+			JoinPhrase: ac.JoinPhrase,
+		}
+		if ac.Name != ac.Artist.Name {
+			sac.NameAsCredited = ac.Name
+		}
+		credits = append(credits, sac)
+	}
+	return credits
+}
+
+// areaName returns a's name, or an empty string if a is nil.
+func areaName(a *area) string {
+	if a == nil {
+		return ""
+	}
+	return a.Name
+}
+
+// parseDate parses a partial date like "2021-05-03", "2021-05", or "2021".
+// Unparseable components are left unset.
+func parseDate(s string) seed.Date {
+	var d seed.Date
+	for i, p := range strings.SplitN(s, "-", 3) {
+		v, err := strconv.Atoi(p)
+		if err != nil {
+			continue
+		}
+		switch i {
+		case 0:
+			d.Year = v
+		case 1:
+			d.Month = v
+		case 2:
+			d.Day = v
+		}
+	}
+	return d
+}
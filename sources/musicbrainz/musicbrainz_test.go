@@ -0,0 +1,202 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package musicbrainz
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/derat/yambs/mbdb"
+	"github.com/derat/yambs/seed"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFetch(t *testing.T) {
+	const (
+		artistMBID = "b10bbbfc-cf9e-42e0-be17-e2c3e1d2600d"
+		// Abridged version of https://musicbrainz.org/ws/2/artist/b10bbbfc-cf9e-42e0-be17-e2c3e1d2600d?fmt=json.
+		artistData = `{"id":"b10bbbfc-cf9e-42e0-be17-e2c3e1d2600d","name":"The Beatles","sort-name":"Beatles, The","disambiguation":"","type":"Group","type-id":"e431f5f6-b5d2-343d-8b36-72607fffb74b","gender":null,"area":{"name":"United Kingdom","iso-3166-1-codes":["GB"]},"begin-area":{"name":"Liverpool"},"end-area":null,"ipis":[],"isnis":["0000000121707484"],"life-span":{"begin":"1960","end":"1970-04-10","ended":true}}`
+
+		labelMBID = "1ca5ed29-e00b-4ea5-b817-0bcca0e04946"
+		labelData = `{"id":"1ca5ed29-e00b-4ea5-b817-0bcca0e04946","name":"Warp","disambiguation":"UK label","type":"Original Production","label-code":2070,"area":{"name":"United Kingdom"},"ipis":[],"isnis":[],"life-span":{"begin":"1989","end":null,"ended":false}}`
+
+		placeMBID = "bd2f56b3-8e5c-4d8b-b88d-a4a12f58c0a7"
+		placeData = `{"id":"bd2f56b3-8e5c-4d8b-b88d-a4a12f58c0a7","name":"Abbey Road Studios","disambiguation":"","type":"Studio","address":"3 Abbey Road, St John's Wood, London, NW8 9AY","area":{"name":"City of Westminster"},"coordinates":{"latitude":51.5319,"longitude":-0.1779},"life-span":{"begin":"1931-11-12","end":null,"ended":false}}`
+
+		recMBID = "bd5ae3f5-3c3b-4b8e-9d40-2b3f0a07d0f3"
+		recData = `{"id":"bd5ae3f5-3c3b-4b8e-9d40-2b3f0a07d0f3","title":"Song","disambiguation":"","length":225123,"video":false,"isrcs":["GBAAA0000001"],"artist-credit":[{"name":"Someone","joinphrase":" & ","artist":{"id":"65389277-491a-4055-8e71-0a9be1c9c99c","name":"Someone"}},{"name":"Other","joinphrase":"","artist":{"id":"0096a0bf-804e-4e47-bf2a-e0878dbb3eb7","name":"Another"}}]}`
+
+		relMBID = "7f4c5b9e-0d0e-4ae5-9b0a-fb6f6c4a0e7a"
+		relData = `{"id":"7f4c5b9e-0d0e-4ae5-9b0a-fb6f6c4a0e7a","title":"Album","disambiguation":"","barcode":"0123456789012","status":"Official","packaging":"Jewel Case","text-representation":{"language":"eng","script":"Latn"},"release-group":{"id":"e8ba1a80-a1a2-43b1-9b2e-0fea3e9f1a05"},"release-events":[{"date":"2001-02-03","area":{"name":"United Kingdom","iso-3166-1-codes":["GB"]}},{"date":"2001","area":null}],"label-info":[{"catalog-number":"WARP 1","label":{"id":"1ca5ed29-e00b-4ea5-b817-0bcca0e04946","name":"Warp"}},{"catalog-number":"X","label":null}],"artist-credit":[{"name":"Someone","joinphrase":"","artist":{"id":"65389277-491a-4055-8e71-0a9be1c9c99c","name":"Someone"}}],"media":[{"format":"CD","title":"","tracks":[{"number":"1","title":"First","length":180000,"recording":{"id":"bd5ae3f5-3c3b-4b8e-9d40-2b3f0a07d0f3"},"artist-credit":[{"name":"Someone","joinphrase":"","artist":{"id":"65389277-491a-4055-8e71-0a9be1c9c99c","name":"Someone"}}]},{"number":"2","title":"Second","length":null,"recording":{"id":"0096a0bf-804e-4e47-bf2a-e0878dbb3eb7"},"artist-credit":[]}]}]}`
+
+		rgMBID = "e8ba1a80-a1a2-43b1-9b2e-0fea3e9f1a05"
+		rgData = `{"id":"e8ba1a80-a1a2-43b1-9b2e-0fea3e9f1a05","title":"Album","disambiguation":"","primary-type":"Album","primary-type-id":"f529b476-6e62-324f-b0aa-1f3e33d313fc","secondary-types":["Live"],"artist-credit":[{"name":"Someone","joinphrase":"","artist":{"id":"65389277-491a-4055-8e71-0a9be1c9c99c","name":"Someone"}}]}`
+
+		seriesMBID = "d977f7fd-96c9-4e3e-83db-7e4ea3b9c7ef"
+		seriesData = `{"id":"d977f7fd-96c9-4e3e-83db-7e4ea3b9c7ef","name":"Some Series","disambiguation":"for testing","type":"Release series","type-id":"52b90f1e-ff62-3bd0-b254-5d91ced5d757"}`
+	)
+
+	paths := map[string]string{
+		"/ws/2/artist/" + artistMBID + "?fmt=json":                                                   artistData,
+		"/ws/2/label/" + labelMBID + "?fmt=json":                                                     labelData,
+		"/ws/2/place/" + placeMBID + "?fmt=json":                                                     placeData,
+		"/ws/2/recording/" + recMBID + "?fmt=json&inc=artist-credits+isrcs":                          recData,
+		"/ws/2/release/" + relMBID + "?fmt=json&inc=artist-credits+labels+recordings+release-groups": relData,
+		"/ws/2/release-group/" + rgMBID + "?fmt=json&inc=artist-credits":                             rgData,
+		"/ws/2/series/" + seriesMBID + "?fmt=json":                                                   seriesData,
+	}
+	srv := newTestServer(paths)
+	defer srv.Close()
+
+	db := mbdb.NewDB(mbdb.ServerURL(srv.URL), mbdb.MaxQPS(100))
+	for _, tc := range []struct {
+		typ  seed.Entity
+		mbid string
+		want seed.Edit
+	}{
+		{seed.ArtistEntity, artistMBID, &seed.Artist{
+			MBID:          artistMBID,
+			Name:          "The Beatles",
+			SortName:      "Beatles, The",
+			Type:          seed.ArtistType_Group,
+			AreaName:      "United Kingdom",
+			IPICodes:      []string{},
+			ISNICodes:     []string{"0000000121707484"},
+			BeginDate:     seed.MakeDate(1960, 0, 0),
+			BeginAreaName: "Liverpool",
+			EndDate:       seed.MakeDate(1970, 4, 10),
+			Ended:         true,
+		}},
+		{seed.LabelEntity, labelMBID, &seed.Label{
+			MBID:           labelMBID,
+			Name:           "Warp",
+			Disambiguation: "UK label",
+			Type:           seed.LabelType_OriginalProduction,
+			AreaName:       "United Kingdom",
+			LabelCode:      "02070",
+			IPICodes:       []string{},
+			ISNICodes:      []string{},
+			BeginDate:      seed.MakeDate(1989, 0, 0),
+		}},
+		{seed.PlaceEntity, placeMBID, &seed.Place{
+			MBID:        placeMBID,
+			Name:        "Abbey Road Studios",
+			Type:        seed.PlaceType_Studio,
+			Address:     "3 Abbey Road, St John's Wood, London, NW8 9AY",
+			AreaName:    "City of Westminster",
+			Coordinates: "51.5319, -0.1779",
+			BeginDate:   seed.MakeDate(1931, 11, 12),
+		}},
+		{seed.RecordingEntity, recMBID, &seed.Recording{
+			MBID: recMBID,
+			Name: "Song",
+			Artists: []seed.ArtistCredit{
+				{MBID: "65389277-491a-4055-8e71-0a9be1c9c99c", Name: "Someone", JoinPhrase: " & "},
+				{MBID: "0096a0bf-804e-4e47-bf2a-e0878dbb3eb7", Name: "Another", NameAsCredited: "Other"},
+			},
+			Length: 225123 * time.Millisecond,
+			ISRCs:  []string{"GBAAA0000001"},
+		}},
+		{seed.ReleaseEntity, relMBID, &seed.Release{
+			MBID:         relMBID,
+			Title:        "Album",
+			ReleaseGroup: "e8ba1a80-a1a2-43b1-9b2e-0fea3e9f1a05",
+			Barcode:      "0123456789012",
+			Language:     "eng",
+			Script:       "Latn",
+			Status:       seed.ReleaseStatus_Official,
+			Packaging:    seed.ReleasePackaging_JewelCase,
+			Events: []seed.ReleaseEvent{
+				{Date: seed.MakeDate(2001, 2, 3), Country: "GB"},
+				{Date: seed.MakeDate(2001, 0, 0)},
+			},
+			Labels: []seed.ReleaseLabel{
+				{MBID: "1ca5ed29-e00b-4ea5-b817-0bcca0e04946", CatalogNumber: "WARP 1", Name: "Warp"},
+				{CatalogNumber: "X"},
+			},
+			Artists: []seed.ArtistCredit{{MBID: "65389277-491a-4055-8e71-0a9be1c9c99c", Name: "Someone"}},
+			Mediums: []seed.Medium{{
+				Format: seed.MediumFormat_CD,
+				Tracks: []seed.Track{
+					{
+						Title:     "First",
+						Number:    "1",
+						Recording: "bd5ae3f5-3c3b-4b8e-9d40-2b3f0a07d0f3",
+						Length:    3 * time.Minute,
+						Artists:   []seed.ArtistCredit{{MBID: "65389277-491a-4055-8e71-0a9be1c9c99c", Name: "Someone"}},
+					},
+					{
+						Title:     "Second",
+						Number:    "2",
+						Recording: "0096a0bf-804e-4e47-bf2a-e0878dbb3eb7",
+					},
+				},
+			}},
+		}},
+		{seed.ReleaseGroupEntity, rgMBID, &seed.ReleaseGroup{
+			MBID:    rgMBID,
+			Title:   "Album",
+			Types:   []seed.ReleaseGroupType{seed.ReleaseGroupType_Album, seed.ReleaseGroupType_Live},
+			Artists: []seed.ArtistCredit{{MBID: "65389277-491a-4055-8e71-0a9be1c9c99c", Name: "Someone"}},
+		}},
+		{seed.SeriesEntity, seriesMBID, &seed.Series{
+			MBID:           seriesMBID,
+			Name:           "Some Series",
+			Disambiguation: "for testing",
+			Type:           seed.SeriesType_ReleaseSeries,
+		}},
+	} {
+		got, err := Fetch(context.Background(), db, tc.typ, tc.mbid)
+		if err != nil {
+			t.Errorf("Fetch(ctx, db, %v, %v) failed: %v", tc.typ, tc.mbid, err)
+		} else if diff := cmp.Diff(tc.want, got); diff != "" {
+			t.Errorf("Fetch(ctx, db, %v, %v) returned wrong edit:\n%s", tc.typ, tc.mbid, diff)
+		}
+	}
+
+	if _, err := Fetch(context.Background(), db, seed.WorkEntity, artistMBID); err == nil {
+		t.Error("Fetch unexpectedly succeeded for missing work")
+	}
+}
+
+func TestParseURL(t *testing.T) {
+	const mbid = "7f4c5b9e-0d0e-4ae5-9b0a-fb6f6c4a0e7a"
+	for _, tc := range []struct {
+		url  string
+		typ  seed.Entity
+		mbid string
+		ok   bool
+	}{
+		{"https://musicbrainz.org/release/" + mbid, seed.ReleaseEntity, mbid, true},
+		{"https://test.musicbrainz.org/artist/" + mbid + "/edit", seed.ArtistEntity, mbid, true},
+		{"http://musicbrainz.org/Work/" + mbid + "?foo=bar", seed.WorkEntity, mbid, true},
+		{"https://musicbrainz.org/release-group/" + mbid, seed.ReleaseGroupEntity, mbid, true},
+		{"https://musicbrainz.org/series/" + mbid, seed.SeriesEntity, mbid, true},
+		{"https://musicbrainz.org/area/" + mbid, "", "", false},
+		{"https://example.org/release/" + mbid, "", "", false},
+		{"https://musicbrainz.org/release/1234", "", "", false},
+	} {
+		if typ, mbid, ok := ParseURL(tc.url); typ != tc.typ || mbid != tc.mbid || ok != tc.ok {
+			t.Errorf("ParseURL(%q) = %q, %q, %v; want %q, %q, %v",
+				tc.url, typ, mbid, ok, tc.typ, tc.mbid, tc.ok)
+		}
+	}
+}
+
+// newTestServer returns an HTTP server that serves the supplied responses.
+// paths is keyed by paths with query strings, e.g. "/ws/2/artist/<MBID>?fmt=json".
+func newTestServer(paths map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p := r.URL.Path + "?" + r.URL.RawQuery
+		if data, ok := paths[p]; ok {
+			io.WriteString(w, data)
+		} else {
+			http.NotFound(w, r)
+		}
+	}))
+}
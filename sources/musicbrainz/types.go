@@ -0,0 +1,105 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package musicbrainz
+
+import "github.com/derat/yambs/seed"
+
+// These maps translate the type names returned by the /ws/2 API into the
+// database IDs that are used to seed edit forms. Unknown names map to zero,
+// which leaves the corresponding form field unset.
+
+var artistTypes = map[string]seed.ArtistType{
+	"Person":    seed.ArtistType_Person,
+	"Group":     seed.ArtistType_Group,
+	"Other":     seed.ArtistType_Other,
+	"Character": seed.ArtistType_Character,
+	"Orchestra": seed.ArtistType_Orchestra,
+	"Choir":     seed.ArtistType_Choir,
+}
+
+var eventTypes = map[string]seed.EventType{
+	"Concert":            seed.EventType_Concert,
+	"Festival":           seed.EventType_Festival,
+	"Launch event":       seed.EventType_LaunchEvent,
+	"Convention/Expo":    seed.EventType_ConventionExpo,
+	"Masterclass/Clinic": seed.EventType_MasterclassClinic,
+	"Stage performance":  seed.EventType_StagePerformance,
+	"Award ceremony":     seed.EventType_AwardCeremony,
+}
+
+var genders = map[string]seed.Gender{
+	"Female":         seed.Gender_Female,
+	"Male":           seed.Gender_Male,
+	"Non-binary":     seed.Gender_NonBinary,
+	"Not applicable": seed.Gender_NotApplicable,
+	"Other":          seed.Gender_Other,
+}
+
+var labelTypes = map[string]seed.LabelType{
+	"Distributor":         seed.LabelType_Distributor,
+	"Holding":             seed.LabelType_Holding,
+	"Production":          seed.LabelType_Production,
+	"Original Production": seed.LabelType_OriginalProduction,
+	"Bootleg Production":  seed.LabelType_BootlegProduction,
+	"Reissue Production":  seed.LabelType_ReissueProduction,
+	"Publisher":           seed.LabelType_Publisher,
+	"Rights Society":      seed.LabelType_RightsSociety,
+	"Imprint":             seed.LabelType_Imprint,
+	"Manufacturer":        seed.LabelType_Manufacturer,
+}
+
+var placeTypes = map[string]seed.PlaceType{
+	"Studio":                  seed.PlaceType_Studio,
+	"Venue":                   seed.PlaceType_Venue,
+	"Other":                   seed.PlaceType_Other,
+	"Stadium":                 seed.PlaceType_Stadium,
+	"Indoor arena":            seed.PlaceType_IndoorArena,
+	"Religious building":      seed.PlaceType_ReligiousBuilding,
+	"Educational institution": seed.PlaceType_EducationalInstitution,
+	"Pressing plant":          seed.PlaceType_PressingPlant,
+}
+
+var seriesTypes = map[string]seed.SeriesType{
+	"Release group series": seed.SeriesType_ReleaseGroupSeries,
+	"Release series":       seed.SeriesType_ReleaseSeries,
+	"Recording series":     seed.SeriesType_RecordingSeries,
+	"Work series":          seed.SeriesType_WorkSeries,
+	"Catalogue":            seed.SeriesType_Catalogue,
+	"Event series":         seed.SeriesType_EventSeries,
+	"Tour":                 seed.SeriesType_Tour,
+	"Festival":             seed.SeriesType_Festival,
+	"Run":                  seed.SeriesType_Run,
+}
+
+var workTypes = map[string]seed.WorkType{
+	"Aria":             seed.WorkType_Aria,
+	"Audio drama":      seed.WorkType_AudioDrama,
+	"Ballet":           seed.WorkType_Ballet,
+	"Beijing opera":    seed.WorkType_BeijingOpera,
+	"Cantata":          seed.WorkType_Cantata,
+	"Concerto":         seed.WorkType_Concerto,
+	"Étude":            seed.WorkType_Etude,
+	"Incidental music": seed.WorkType_IncidentalMusic,
+	"Madrigal":         seed.WorkType_Madrigal,
+	"Mass":             seed.WorkType_Mass,
+	"Motet":            seed.WorkType_Motet,
+	"Musical":          seed.WorkType_Musical,
+	"Opera":            seed.WorkType_Opera,
+	"Operetta":         seed.WorkType_Operetta,
+	"Oratorio":         seed.WorkType_Oratorio,
+	"Overture":         seed.WorkType_Overture,
+	"Partita":          seed.WorkType_Partita,
+	"Play":             seed.WorkType_Play,
+	"Poem":             seed.WorkType_Poem,
+	"Prose":            seed.WorkType_Prose,
+	"Quartet":          seed.WorkType_Quartet,
+	"Sonata":           seed.WorkType_Sonata,
+	"Song":             seed.WorkType_Song,
+	"Song-cycle":       seed.WorkType_SongCycle,
+	"Soundtrack":       seed.WorkType_Soundtrack,
+	"Suite":            seed.WorkType_Suite,
+	"Symphonic poem":   seed.WorkType_SymphonicPoem,
+	"Symphony":         seed.WorkType_Symphony,
+	"Zarzuela":         seed.WorkType_Zarzuela,
+}
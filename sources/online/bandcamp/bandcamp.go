@@ -55,8 +55,9 @@ func (p *Provider) Release(ctx context.Context, page *web.Page, pageURL string,
 		// The userscript checks if all tracks have titles like "artist - tracktitle" with
 		// non-numeric artists (which would instead be a track number) and tests the album
 		// artist against '^various(?: artists)?$'.
-		// TODO: Consider passing this to parseArtists. There are a bunch of group names that
-		// would be incorrectly split, though, so maybe not.
+		// TODO: Consider passing this to seed.ParseArtistCredits. There are a bunch of group
+		// names that would be incorrectly split, though, and the MBID lookup below assumes
+		// that the page belongs to a single artist.
 		Artists:   []seed.ArtistCredit{{Name: album.Artist}},
 		Status:    seed.ReleaseStatus_Official,
 		Packaging: seed.ReleasePackaging_None,
@@ -160,17 +161,30 @@ func (p *Provider) Release(ctx context.Context, page *web.Page, pageURL string,
 			labelName = strings.TrimSpace(n.Data)
 		}
 	}
+	var labelURLs []string
 	if val, err := page.Query("a.back-to-label-link").Attr("href"); err == nil {
 		if labelURL, err := url.Parse(val); err == nil {
 			labelURL.RawQuery = "" // clear "?from=btl"
-			labelMBID = internal.GetLabelMBIDFromURL(shortCtx, db, labelURL.String(), labelName)
+			labelURLs = append(labelURLs, labelURL.String())
 		}
 	}
-	// If we didn't find a label MBID yet, check if the base URL corresponds to a label.
-	// Do this even if it already got matched to an artist, since sometimes the same Bandcamp
-	// page gets used for an artist-owned label.
-	if labelMBID == "" && baseURL != "" {
-		labelMBID = internal.GetLabelMBIDFromURL(shortCtx, db, baseURL, labelName)
+	// If we don't find a label MBID from the back link, check if the base URL corresponds to
+	// a label. Do this even if it already got matched to an artist, since sometimes the same
+	// Bandcamp page gets used for an artist-owned label.
+	if baseURL != "" {
+		labelURLs = append(labelURLs, baseURL)
+	}
+	if len(labelURLs) > 0 {
+		names := make([]string, len(labelURLs))
+		for i := range names {
+			names[i] = labelName
+		}
+		for _, mbid := range internal.GetLabelMBIDsFromURLs(shortCtx, db, labelURLs, names) {
+			if mbid != "" {
+				labelMBID = mbid
+				break
+			}
+		}
 	}
 	if labelName != "" || labelMBID != "" {
 		rel.Labels = append(rel.Labels, seed.ReleaseLabel{
@@ -297,60 +311,9 @@ func extractTrackArtists(orig string) (track string, artists []seed.ArtistCredit
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
 		return orig, nil
 	}
-	return parts[1], parseArtists(parts[0])
+	return parts[1], seed.ParseArtistCredits(parts[0])
 }
 
-// parseArtists splits a string like "A, B & C" into individual artist credits.
-func parseArtists(orig string) []seed.ArtistCredit {
-	if orig == "" {
-		return nil
-	}
-
-	// Split on join phrases and get the artist name from the part before each join phrase.
-	ms := joinPhraseRegexp.FindAllStringIndex(orig, -1)
-	if len(ms) == 0 {
-		return []seed.ArtistCredit{{Name: orig}}
-	}
-	artists := make([]seed.ArtistCredit, len(ms)+1)
-	for i, rng := range ms {
-		start, end := rng[0], rng[1]
-		artists[i].JoinPhrase = orig[start:end]
-
-		var prev int
-		if i > 0 {
-			prev = ms[i-1][1]
-		}
-		if prev < start {
-			artists[i].Name = orig[prev:start]
-		}
-	}
-
-	// Add the artist after the final join phrase.
-	if last := ms[len(ms)-1][1]; last < len(orig) {
-		artists[len(artists)-1].Name = orig[last:]
-	}
-
-	// If any of the artist names were blank, just give up.
-	for i := range artists {
-		if artists[i].Name == "" {
-			return []seed.ArtistCredit{{Name: orig}}
-		}
-	}
-
-	return artists
-}
-
-// joinPhraseRegexp matches join phrases appearing in artist names.
-var joinPhraseRegexp = regexp.MustCompile(`(?i)` + strings.Join([]string{
-	` & `,
-	`, `,
-	` feat\. `,
-	` ft\. `,
-	// TODO: Add more? I think that these are freeform based on whatever the artist
-	// enters when uploading their music. I've seen " x " used occasionally, but I'm
-	// a bit worried about false positives.
-}, "|"))
-
 // CleanURL returns a cleaned version of a Bandcamp URL like
 // "https://artist-name.bandcamp.com/album/album-name" or
 // "https://artist-name.bandcamp.com/track/track-name".
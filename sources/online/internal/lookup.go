@@ -13,7 +13,7 @@ import (
 )
 
 // maxEditDist contains the maximum edit distance between a name passed to
-// Get*MBIDFromURL and a name in the MusicBrainz database.
+// a Get*FromURL(s) function and a name in the MusicBrainz database.
 const maxEditDist = 2
 
 // GetArtistMBIDFromURL attempts to find the MBID of the artist corresponding to url.
@@ -27,15 +27,35 @@ func GetArtistMBIDFromURL(ctx context.Context, db *mbdb.DB, url, name string) st
 	return getBestMBID(artists, name)
 }
 
-// GetLabelBIDFromURL attempts to find the MBID of the label corresponding to url.
-// name should contain the label name as seen online.
-func GetLabelMBIDFromURL(ctx context.Context, db *mbdb.DB, url, name string) string {
-	labels, err := db.GetLabelsFromURL(ctx, url)
+// GetArtistMBIDsFromURLs is a batched version of GetArtistMBIDFromURL that looks up
+// multiple URLs using as few queries as possible. names should contain the artist name
+// corresponding to each URL. The returned slice contains an MBID (or an empty string)
+// for each URL.
+func GetArtistMBIDsFromURLs(ctx context.Context, db *mbdb.DB, urls, names []string) []string {
+	res, err := db.GetArtistsFromURLs(ctx, urls)
 	if err != nil {
-		log.Printf("Failed getting label MBID from %s: %v", url, err)
-		return ""
+		log.Printf("Failed getting artist MBIDs from %d URL(s): %v", len(urls), err)
+	}
+	return getBestMBIDs(res, urls, names)
+}
+
+// GetLabelMBIDsFromURLs is like GetArtistMBIDsFromURLs but finds the MBIDs of labels.
+func GetLabelMBIDsFromURLs(ctx context.Context, db *mbdb.DB, urls, names []string) []string {
+	res, err := db.GetLabelsFromURLs(ctx, urls)
+	if err != nil {
+		log.Printf("Failed getting label MBIDs from %d URL(s): %v", len(urls), err)
+	}
+	return getBestMBIDs(res, urls, names)
+}
+
+// getBestMBIDs calls getBestMBID for each of urls (with the corresponding name from names)
+// using the entities in res, which is keyed by URL.
+func getBestMBIDs(res map[string][]mbdb.EntityInfo, urls, names []string) []string {
+	mbids := make([]string, len(urls))
+	for i, u := range urls {
+		mbids[i] = getBestMBID(res[u], names[i])
 	}
-	return getBestMBID(labels, name)
+	return mbids
 }
 
 // getBestMBID returns the MBID of the entity in infos with a name closest to the supplied name
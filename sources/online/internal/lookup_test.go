@@ -4,9 +4,12 @@
 package internal
 
 import (
+	"context"
 	"testing"
 
 	"github.com/derat/yambs/mbdb"
+	"github.com/derat/yambs/mbdb/mbdbtest"
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestGetBestMBID(t *testing.T) {
@@ -38,3 +41,25 @@ func TestGetBestMBID(t *testing.T) {
 		}
 	}
 }
+
+func TestGetArtistMBIDsFromURLs(t *testing.T) {
+	const (
+		url1 = "https://www.example.org/a"
+		url2 = "https://www.example.org/b"
+		url3 = "https://www.example.org/c"
+	)
+	srv := mbdbtest.NewServer(t)
+	srv.AddURLRel(url1, "artist", "mbid-a", "Artist A")
+	srv.AddURLRel(url2, "artist", "mbid-b", "Artist B")
+	srv.AddURLRel(url2, "artist", "mbid-c", "Artist C")
+	db := srv.NewDB()
+
+	got := GetArtistMBIDsFromURLs(context.Background(), db,
+		[]string{url1, url2, url3}, []string{"Artist A", "Artist C", "Artist D"})
+	if diff := cmp.Diff([]string{"mbid-a", "mbid-c", ""}, got); diff != "" {
+		t.Error("GetArtistMBIDsFromURLs returned wrong MBIDs:\n" + diff)
+	}
+	if reqs := srv.Requests(); len(reqs) != 1 {
+		t.Errorf("GetArtistMBIDsFromURLs sent %d requests; want 1: %v", len(reqs), reqs)
+	}
+}
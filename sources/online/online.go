@@ -61,13 +61,21 @@ func Fetch(ctx context.Context, url string, rawSetCmds []string,
 	if err != nil {
 		return nil, err
 	}
-	rel.EditNote = url + editNote
+	// Preserve any note that was added by the provider (e.g. by seed.Release.Autofill).
+	if rel.EditNote != "" {
+		rel.EditNote = url + "\n\n" + rel.EditNote + editNote
+	} else {
+		rel.EditNote = url + editNote
+	}
 
 	for _, cmd := range setCmds {
 		if err := text.SetField(rel, cmd[0], cmd[1]); err != nil {
 			return nil, err
 		}
 	}
+	if err := rel.Finish(ctx, db); err != nil {
+		return nil, err
+	}
 	edits := []seed.Edit{rel}
 
 	if img != nil {
@@ -99,7 +99,7 @@ func (p *Provider) Release(ctx context.Context, page *web.Page, pageURL string,
 	} else if data.Brand.Name == "" {
 		return nil, nil, errors.New("structured data is missing artist")
 	}
-	rel.Artists = []seed.ArtistCredit{{Name: data.Brand.Name}}
+	rel.Artists = seed.ParseArtistCredits(data.Brand.Name)
 
 	// Use the release date if it's plausible (i.e. not before Qobuz's launch).
 	if t, err := time.Parse(`2006-01-02`, data.ReleaseDate); err == nil && !t.Before(qobuzLaunch) {
@@ -165,7 +165,7 @@ func (p *Provider) Release(ctx context.Context, page *web.Page, pageURL string,
 			}
 			tr := seed.Track{Title: title, Length: dur}
 			if len(artists) > 0 {
-				tr.Artists = []seed.ArtistCredit{{Name: artists[i]}}
+				tr.Artists = seed.ParseArtistCredits(artists[i])
 			}
 			rel.Mediums[0].Tracks = append(rel.Mediums[0].Tracks, tr)
 		}
@@ -6,17 +6,14 @@ package qobuz
 import (
 	"context"
 	"fmt"
-	"os"
-	"path/filepath"
-	"strings"
 	"testing"
 
 	"github.com/derat/yambs/mbdb"
 	"github.com/derat/yambs/seed"
 	"github.com/derat/yambs/sources/online/internal"
 	"github.com/derat/yambs/web"
+	"github.com/derat/yambs/web/webtest"
 	"github.com/google/go-cmp/cmp"
-	"golang.org/x/net/html"
 )
 
 func TestRelease(t *testing.T) {
@@ -24,6 +21,10 @@ func TestRelease(t *testing.T) {
 	db := mbdb.NewDB(mbdb.DisallowQueries)
 	var pr Provider
 
+	// Pages are loaded from testdata. Pass -record to refetch them.
+	client := web.NewPageClient(web.Transport(
+		webtest.NewCassette("testdata", webtest.DefaultName, webtest.DefaultMode())))
+
 	for _, tc := range []struct {
 		url string
 		rel *seed.Release
@@ -154,8 +155,15 @@ func TestRelease(t *testing.T) {
 			url: "https://www.qobuz.com/us-en/album/waynes-world-various-artists/0093624963714",
 			rel: &seed.Release{
 				// The extra space here is present throughout the page.
-				Title:     "Wayne's World  (Music From The Motion Picture)",
-				Types:     []seed.ReleaseGroupType{seed.ReleaseGroupType_Album},
+				Title: "Wayne's World  (Music From The Motion Picture)",
+				Types: []seed.ReleaseGroupType{
+					seed.ReleaseGroupType_Album,
+					seed.ReleaseGroupType_Compilation,
+					seed.ReleaseGroupType_Soundtrack,
+				},
+				EditNote: "Guessed release group types:\n" +
+					"* Compilation: 12 different track artists\n" +
+					"* Soundtrack: title contains \"Music From The Motion Picture\"",
 				Script:    "Latn",
 				Status:    seed.ReleaseStatus_Official,
 				Packaging: seed.ReleasePackaging_None,
@@ -194,17 +202,10 @@ func TestRelease(t *testing.T) {
 		},
 	} {
 		t.Run(tc.url, func(t *testing.T) {
-			f, err := os.Open(getFilename(tc.url))
-			if err != nil {
-				t.Fatal("Failed opening page:", err)
-			}
-			defer f.Close()
-
-			root, err := html.Parse(f)
+			page, err := web.FetchPageWithClient(ctx, client, tc.url)
 			if err != nil {
-				t.Fatal("Failed parsing HTML:", err)
+				t.Fatal("Failed fetching page:", err)
 			}
-			page := &web.Page{Root: root}
 			cfg := internal.Config{DisallowNetwork: true}
 			rel, img, err := pr.Release(ctx, page, tc.url, db, &cfg)
 			if err != nil {
@@ -226,14 +227,6 @@ func TestRelease(t *testing.T) {
 	}
 }
 
-// getFilename converts a URL to a file in the testdata directory.
-func getFilename(url string) string {
-	fn := strings.TrimPrefix(url, "https://")
-	fn = strings.ReplaceAll(fn, "/", "_")
-	fn += ".html"
-	return filepath.Join("testdata", fn)
-}
-
 // track constructs a seed.Track from the supplied title and "HH:MM:SS" duration.
 func track(title, dur string) seed.Track {
 	d, err := parseDuration(dur)
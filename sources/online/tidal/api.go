@@ -15,13 +15,15 @@ import (
 	"sort"
 	"sync"
 	"time"
+
+	"github.com/derat/yambs/web"
 )
 
 const (
 	// apiTimeout is the maximum time for a request to the (unreliable) Tidal API.
 	apiTimeout = 5 * time.Second
-	// apiRetries contains the number of times to retry a failed (non-404) API call.
-	apiRetries = 1
+	// apiRetries contains the number of times to retry a transiently-failed API call.
+	apiRetries = 2
 )
 
 // apiCaller calls the Tidal API. This interface exists so fake instances can be injected by tests.
@@ -32,19 +34,26 @@ type apiCaller interface {
 
 // realAPICaller is an apiCaller implementation that calls the real Tidal API.
 type realAPICaller struct {
-	client *http.Client
+	client *web.Client
 	token  string
 }
 
-func newRealAPICaller(token string) *realAPICaller {
+// newRealAPICaller returns a realAPICaller that sends requests using rt.
+// If rt is nil, a default transport is used.
+func newRealAPICaller(token string, rt http.RoundTripper) *realAPICaller {
+	if rt == nil {
+		rt = &http.Transport{
+			// TODO: Find some way to avoid needing this.
+			// I (sometimes?) get "net/http: TLS handshake timeout" when I use http.DefaultClient.
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}
+	}
 	return &realAPICaller{
-		client: &http.Client{
-			Transport: &http.Transport{
-				// TODO: Find some way to avoid needing this.
-				// I (sometimes?) get "net/http: TLS handshake timeout" when I use http.DefaultClient.
-				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-			},
-		},
+		client: web.NewClient(
+			web.HTTPClient(&http.Client{Transport: rt}),
+			web.MaxRetries(apiRetries),
+			web.AttemptTimeout(apiTimeout),
+		),
 		token: token,
 	}
 }
@@ -54,42 +63,25 @@ var notFoundErr = errors.New("not found")
 func (api *realAPICaller) call(ctx context.Context, path string) ([]byte, error) {
 	url := "https://api.tidal.com" + path
 
-	var tries int
-	for {
-		b, err, fatal := func() ([]byte, error, bool) {
-			ctx, cancel := context.WithTimeout(ctx, apiTimeout)
-			defer cancel()
-
-			log.Print("Fetching ", url)
-			req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-			if err != nil {
-				return nil, err, false
-			}
-			req.Header.Set("X-Tidal-Token", api.token)
-			res, err := api.client.Do(req)
-			if err != nil {
-				return nil, err, false
-			}
-			defer res.Body.Close()
-
-			switch res.StatusCode {
-			case http.StatusOK:
-				b, err := io.ReadAll(res.Body)
-				return b, err, false
-			case http.StatusNotFound:
-				return nil, notFoundErr, true
-			default:
-				return nil, fmt.Errorf("status %v: %v", res.StatusCode, res.Status), false
-			}
-		}()
-		tries++
+	log.Print("Fetching ", url)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Tidal-Token", api.token)
+	res, err := api.client.Do(req) // retries transient errors
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
 
-		if err == nil {
-			return b, nil
-		} else if fatal || tries >= 1+apiRetries || ctx.Err() != nil {
-			return nil, err // can't retry
-		}
-		log.Printf("Retryable error for %v: %v", url, err)
+	switch res.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(res.Body)
+	case http.StatusNotFound:
+		return nil, notFoundErr
+	default:
+		return nil, fmt.Errorf("status %v: %v", res.StatusCode, res.Status)
 	}
 }
 
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"net/url"
 	"reflect"
 	"regexp"
@@ -49,12 +50,12 @@ func (p *Provider) Release(ctx context.Context, page *web.Page, pageURL string,
 	if cfg.DisallowNetwork {
 		return nil, nil, errors.New("network is disallowed")
 	}
-	api := newRealAPICaller(defaultToken)
+	api := newRealAPICaller(defaultToken, nil)
 	return getRelease(ctx, pageURL, api, db, cfg, time.Now())
 }
 
 // getRelease is called by Release.
-// This helper function exists so that unit tests can inject fake apiCallers.
+// This helper function exists so that unit tests can inject apiCallers.
 func getRelease(ctx context.Context, pageURL string, api apiCaller, db *mbdb.DB, cfg *internal.Config,
 	now time.Time) (rel *seed.Release, img *seed.Info, err error) {
 	albumURL, err := cleanURL(pageURL)
@@ -162,6 +163,13 @@ func getRelease(ctx context.Context, pageURL string, api apiCaller, db *mbdb.DB,
 	shortCtx, shortCancel := mbdb.ShortenContext(ctx, finishTime)
 	defer shortCancel()
 
+	// Look up all of the artists' URLs at once so we don't need to send a query per artist.
+	allArtists := append([]artistData{}, album.Artists...)
+	for _, tr := range tracklist.Items {
+		allArtists = append(allArtists, tr.Artists...)
+	}
+	prefetchArtistURLs(shortCtx, allArtists, db)
+
 	rel.Artists = makeArtistCredits(shortCtx, album.Artists, db)
 
 	var vol int // last-seen volume number
@@ -178,7 +186,7 @@ func getRelease(ctx context.Context, pageURL string, api apiCaller, db *mbdb.DB,
 			// from the title when it's also in the artist list.
 			Title:  tr.Title,
 			Length: time.Duration(tr.Duration) * time.Second,
-			// TODO: Find some way to seed ISRCs.
+			ISRC:   tr.ISRC,
 		}
 		// Don't assign artist credits to the track if they'd be identical to the album credits.
 		if !reflect.DeepEqual(tr.Artists, album.Artists) {
@@ -207,19 +215,49 @@ func getRelease(ctx context.Context, pageURL string, api apiCaller, db *mbdb.DB,
 	return rel, img, nil
 }
 
+// artistURL returns the canonical URL for the Tidal artist with the supplied ID.
+func artistURL(id int) string { return fmt.Sprintf("https://tidal.com/artist/%d", id) }
+
+// prefetchArtistURLs looks up the supplied artists' URLs in a batch so that the results
+// will be cached when makeArtistCredits is called later.
+func prefetchArtistURLs(ctx context.Context, artists []artistData, db *mbdb.DB) {
+	var urls []string
+	for _, a := range artists {
+		if a.ID != 0 {
+			urls = append(urls, artistURL(a.ID))
+		}
+	}
+	if len(urls) == 0 {
+		return
+	}
+	if _, err := db.GetArtistsFromURLs(ctx, urls); err != nil {
+		log.Print("Failed getting artists from URLs: ", err)
+	}
+}
+
 // makeArtistCredits constructs a slice of seed.ArtistCredit objects
 // based on the supplied artist list from the API.
 func makeArtistCredits(ctx context.Context, artists []artistData, db *mbdb.DB) []seed.ArtistCredit {
 	credits := make([]seed.ArtistCredit, len(artists))
-	for i, a := range artists {
-		credits[i].Name = a.Name
 
-		// Try to look up the artist's MBID based on their canonical URL.
+	// Try to look up the artists' MBIDs based on their canonical URLs.
+	var urls, names []string
+	var idxs []int // indexes into credits
+	for i, a := range artists {
 		if a.ID != 0 {
-			aurl := fmt.Sprintf("https://tidal.com/artist/%d", a.ID)
-			credits[i].MBID = internal.GetArtistMBIDFromURL(ctx, db, aurl, a.Name)
+			urls = append(urls, artistURL(a.ID))
+			names = append(names, a.Name)
+			idxs = append(idxs, i)
 		}
+	}
+	if len(urls) > 0 {
+		for j, mbid := range internal.GetArtistMBIDsFromURLs(ctx, db, urls, names) {
+			credits[idxs[j]].MBID = mbid
+		}
+	}
 
+	for i, a := range artists {
+		credits[i].Name = a.Name
 		if i > 0 {
 			// TODO: Handle other types if they exist.
 			switch a.Type {
@@ -5,10 +5,7 @@ package tidal
 
 import (
 	"context"
-	"fmt"
-	"io"
-	"os"
-	"path/filepath"
+	"net/http"
 	"regexp"
 	"testing"
 	"time"
@@ -16,12 +13,15 @@ import (
 	"github.com/derat/yambs/mbdb"
 	"github.com/derat/yambs/seed"
 	"github.com/derat/yambs/sources/online/internal"
+	"github.com/derat/yambs/web/webtest"
 	"github.com/google/go-cmp/cmp"
 )
 
 func TestGetRelease(t *testing.T) {
 	ctx := context.Background()
-	api := &fakeAPICaller{}
+	// API responses are loaded from testdata. Pass -record to refetch them.
+	api := newRealAPICaller(defaultToken,
+		webtest.NewCassette("testdata", apiFixtureName, webtest.DefaultMode()))
 	now := time.Date(2015, 2, 10, 0, 0, 0, 0, time.UTC)
 
 	db := mbdb.NewDB(mbdb.DisallowQueries)
@@ -55,7 +55,14 @@ func TestGetRelease(t *testing.T) {
 			country: "XW",
 			rel: &seed.Release{
 				Title: "Step Up 2 The Streets Original Motion Picture Soundtrack",
-				Types: []seed.ReleaseGroupType{seed.ReleaseGroupType_Album},
+				Types: []seed.ReleaseGroupType{
+					seed.ReleaseGroupType_Album,
+					seed.ReleaseGroupType_Compilation,
+					seed.ReleaseGroupType_Soundtrack,
+				},
+				EditNote: "Guessed release group types:\n" +
+					"* Compilation: 15 different track artists\n" +
+					"* Soundtrack: title contains \"Original Motion Picture Soundtrack\"",
 				Annotation: "© 2008 Atlantic Recording Corporation for the United States and " +
 					"WEA International Inc. for the world outside of the United States.\n\n" +
 					"Regions with all tracks on Tidal (as of 2015-02-10 UTC):\n" +
@@ -74,25 +81,25 @@ func TestGetRelease(t *testing.T) {
 				Mediums: []seed.Medium{{
 					Format: seed.MediumFormat_DigitalMedia,
 					Tracks: []seed.Track{
-						track("Low (feat. T-Pain) [Step Up 2 the Streets O.S.T. Version]", sec(230), "Flo Rida", " feat. ", "T-Pain"),
-						track("Shake Your Pom Pom", sec(240), "Missy Elliott"),
-						track("Killa", sec(231), "Cherish featuring Yung Joc"),
-						track("Hypnotized (feat. Akon) [Step up 2 the Streets Original Soundtrack Version]", sec(188),
+						track("Low [Step Up 2 the Streets O.S.T. Version]", sec(230), "USAT20706761", "Flo Rida", " feat. ", "T-Pain"),
+						track("Shake Your Pom Pom", sec(240), "USEE10701891", "Missy Elliott"),
+						track("Killa", sec(231), "USCA20706002", "Cherish featuring Yung Joc"),
+						track("Hypnotized [Step up 2 the Streets Original Soundtrack Version]", sec(188), "USAT20706945",
 							"Plies", " feat. ", "Akon"),
-						track("Is It You (Step Up 2 the Streets O.S.T. Version)", sec(238), "Cassie"),
-						track("Can't Help but Wait", sec(205), "Trey Songz"),
-						track("Church (feat. Teddy Verseti) [Step Up 2 the Streets Original Soundtrack Version]", sec(241),
+						track("Is It You (Step Up 2 the Streets O.S.T. Version)", sec(238), "USBB40707201", "Cassie"),
+						track("Can't Help but Wait", sec(205), "USAT20706765", "Trey Songz"),
+						track("Church [Step Up 2 the Streets Original Soundtrack Version]", sec(241), "USJI10700576",
 							"T-Pain", " feat. ", "Teddy Verseti"),
-						track("Ching-A-Ling", sec(219), "Missy Elliott"),
-						track("Push (Step Up 2 the Streets O.S.T. Version)", sec(208), "Enrique Iglesias"),
-						track("369 (feat. B.o.B.) [Step up 2 the Streets Original Soundtrack Version]", sec(211), "Cupid", " & ", "B.o.B"),
-						track("Impossible (Step Up 2 the Streets O.S.T. Version)", sec(218), "Bayje"),
-						track("Lives in da Club (feat. Jay Lyriq) [Step Up 2 the Streets O.S.T. Version]", sec(209),
+						track("Ching-A-Ling", sec(219), "USEE10701892", "Missy Elliott"),
+						track("Push (Step Up 2 the Streets O.S.T. Version)", sec(208), "USUM70734409", "Enrique Iglesias"),
+						track("369 [Step up 2 the Streets Original Soundtrack Version]", sec(211), "USAT20706826", "Cupid", " & ", "B.o.B"),
+						track("Impossible (Step Up 2 the Streets O.S.T. Version)", sec(218), "USHR10723693", "Bayje"),
+						track("Lives in da Club [Step Up 2 the Streets O.S.T. Version]", sec(209), "USAT20706770",
 							"Sophia Fresh", " feat. ", "Jay Lyriq"),
-						track("Girl You Know (Feat. Trey Songz) [Step Up 2 The Streets O.S.T. Version]", sec(254), "Scarface"),
-						track("Say Cheese (Step Up 2 the Streets O.S.T. Version)", sec(245), "K.C."),
-						track("Let It Go (Step Up 2 the Streets O.S.T. Version)", sec(202), "Brit & Alex"),
-						track("Ain't No Stressin (Step Up 2 the Streets O.S.T. Version)", sec(260), "Montana Tucker, Sikora, Denial"),
+						track("Girl You Know [Step Up 2 The Streets O.S.T. Version]", sec(254), "USEA20701073", "Scarface", " feat. ", "Trey Songz"),
+						track("Say Cheese (Step Up 2 the Streets O.S.T. Version)", sec(245), "USHR10723694", "K.C."),
+						track("Let It Go (Step Up 2 the Streets O.S.T. Version)", sec(202), "USUM70767291", "Brit & Alex"),
+						track("Ain't No Stressin (Step Up 2 the Streets O.S.T. Version)", sec(260), "USHR10723690", "Montana Tucker, Sikora, Denial"),
 					},
 				}},
 			},
@@ -113,11 +120,11 @@ func TestGetRelease(t *testing.T) {
 				Mediums: []seed.Medium{{
 					Format: seed.MediumFormat_DigitalMedia,
 					Tracks: []seed.Track{
-						{Title: "Brother", Length: sec(267)},
-						{Title: "Got Me Wrong", Length: sec(250)},
-						{Title: "Right Turn", Length: sec(194)},
-						{Title: "Am I Inside", Length: sec(308)},
-						{Title: "Love Song", Length: sec(225)},
+						{Title: "Brother", Length: sec(267), ISRC: "USSM10024274"},
+						{Title: "Got Me Wrong", Length: sec(250), ISRC: "USSM19100538"},
+						{Title: "Right Turn", Length: sec(194), ISRC: "USSM19100555"},
+						{Title: "Am I Inside", Length: sec(308), ISRC: "USSM19100537"},
+						{Title: "Love Song", Length: sec(225), ISRC: "USSM10700075"},
 					},
 				}},
 				URLs: []seed.URL{{
@@ -143,42 +150,42 @@ func TestGetRelease(t *testing.T) {
 				Mediums: []seed.Medium{{
 					Format: seed.MediumFormat_DigitalMedia,
 					Tracks: []seed.Track{
-						{Title: "Do It, Try It", Length: sec(217)},
-						{Title: "Go! (feat. Mai Lan)", Length: sec(236), Artists: []seed.ArtistCredit{
+						{Title: "Do It, Try It", Length: sec(217), ISRC: "GB55H1600001"},
+						{Title: "Go!", Length: sec(236), ISRC: "GB55H1600002", Artists: []seed.ArtistCredit{
 							{Name: "M83", MBID: "6d7b7cd4-254b-4c25-83f6-dd20f98ceacd", JoinPhrase: " feat. "},
 							{Name: "Mai Lan", MBID: "65b1de19-50cb-49fe-b802-d1d8616f9ebe"},
 						}},
-						{Title: "Walkway Blues (feat. J Laser)", Length: sec(289), Artists: []seed.ArtistCredit{
+						{Title: "Walkway Blues", Length: sec(289), ISRC: "GB55H1600003", Artists: []seed.ArtistCredit{
 							{Name: "M83", MBID: "6d7b7cd4-254b-4c25-83f6-dd20f98ceacd", JoinPhrase: " feat. "},
 							{Name: "J Laser"},
 						}},
-						{Title: "Bibi The Dog (feat. Mai Lan)", Length: sec(234), Artists: []seed.ArtistCredit{
+						{Title: "Bibi The Dog", Length: sec(234), ISRC: "GB55H1600004", Artists: []seed.ArtistCredit{
 							{Name: "M83", MBID: "6d7b7cd4-254b-4c25-83f6-dd20f98ceacd", JoinPhrase: " feat. "},
 							{Name: "Mai Lan", MBID: "65b1de19-50cb-49fe-b802-d1d8616f9ebe"},
 						}},
-						{Title: "Moon Crystal", Length: sec(147)},
-						{Title: "For The Kids (feat. Susanne Sundfør)", Length: sec(281), Artists: []seed.ArtistCredit{
+						{Title: "Moon Crystal", Length: sec(147), ISRC: "GB55H1600005"},
+						{Title: "For The Kids", Length: sec(281), ISRC: "GB55H1600006", Artists: []seed.ArtistCredit{
 							{Name: "M83", MBID: "6d7b7cd4-254b-4c25-83f6-dd20f98ceacd", JoinPhrase: " feat. "},
 							{Name: "Susanne Sundfør"},
 						}},
-						{Title: "Solitude", Length: sec(364)},
-						{Title: "The Wizard", Length: sec(145)},
-						{Title: "Laser Gun (feat. Mai Lan)", Length: sec(257), Artists: []seed.ArtistCredit{
+						{Title: "Solitude", Length: sec(364), ISRC: "GB55H1600007"},
+						{Title: "The Wizard", Length: sec(145), ISRC: "GB55H1600008"},
+						{Title: "Laser Gun", Length: sec(257), ISRC: "GB55H1600009", Artists: []seed.ArtistCredit{
 							{Name: "M83", MBID: "6d7b7cd4-254b-4c25-83f6-dd20f98ceacd", JoinPhrase: " feat. "},
 							{Name: "Mai Lan", MBID: "65b1de19-50cb-49fe-b802-d1d8616f9ebe"},
 						}},
-						{Title: "Road Blaster", Length: sec(262)},
-						{Title: "Tension", Length: sec(126)},
-						{Title: "Atlantique Sud (feat. Mai Lan)", Length: sec(204), Artists: []seed.ArtistCredit{
+						{Title: "Road Blaster", Length: sec(262), ISRC: "GB55H1600010"},
+						{Title: "Tension", Length: sec(126), ISRC: "GB55H1600011"},
+						{Title: "Atlantique Sud", Length: sec(204), ISRC: "GB55H1600012", Artists: []seed.ArtistCredit{
 							{Name: "M83", MBID: "6d7b7cd4-254b-4c25-83f6-dd20f98ceacd", JoinPhrase: " feat. "},
 							{Name: "Mai Lan", MBID: "65b1de19-50cb-49fe-b802-d1d8616f9ebe"},
 						}},
-						{Title: "Time Wind (feat. Beck)", Length: sec(249), Artists: []seed.ArtistCredit{
+						{Title: "Time Wind", Length: sec(249), ISRC: "GB55H1600013", Artists: []seed.ArtistCredit{
 							{Name: "M83", MBID: "6d7b7cd4-254b-4c25-83f6-dd20f98ceacd", JoinPhrase: " feat. "},
 							{Name: "Beck", MBID: "309c62ba-7a22-4277-9f67-4a162526d18a"},
 						}},
-						{Title: "Ludivine", Length: sec(95)},
-						{Title: "Sunday Night 1987", Length: sec(240)},
+						{Title: "Ludivine", Length: sec(95), ISRC: "GB55H1600014"},
+						{Title: "Sunday Night 1987", Length: sec(240), ISRC: "GB55H1600015"},
 					},
 				}},
 				URLs: []seed.URL{{
@@ -203,7 +210,7 @@ func TestGetRelease(t *testing.T) {
 				Artists:    []seed.ArtistCredit{{Name: "Alice In Chains", MBID: "4bd95eea-b9f6-4d70-a36c-cfea77431553"}},
 				Mediums: []seed.Medium{{
 					Format: seed.MediumFormat_DigitalMedia,
-					Tracks: []seed.Track{{Title: "Never Fade", Length: sec(280)}},
+					Tracks: []seed.Track{{Title: "Never Fade", Length: sec(280), ISRC: "QMRSZ1800858"}},
 				}},
 				URLs: []seed.URL{{
 					URL:      "https://tidal.com/album/93071188",
@@ -237,22 +244,22 @@ func TestGetRelease(t *testing.T) {
 				Mediums: []seed.Medium{{
 					Format: "Digital Media",
 					Tracks: []seed.Track{
-						track("Like Drawing Blood", sec(22)),
-						track("The Only Way", sec(284)),
-						track("Hearts A Mess", sec(365)),
-						track("Coming Back", sec(360)),
-						track("Thanks For Your Time", sec(260)),
-						track("Learnalilgivinanlovin", sec(173)),
-						track("Puzzle With A Piece Missing", sec(341)),
-						track("Seven Hours With A Backseat Driver", sec(283)),
-						track("The Only Thing I Know", sec(423)),
-						track("Night Drive", sec(310)),
-						track("Worn Out Blues", sec(38)),
-						track("Coming Back", sec(201), "Gotye", " & ", "Inga Liljestrom"),
-						track("Hearts A Mess", sec(499)),
-						track("Puzzle With A Piece Missing", sec(261)),
-						track("Learnalilgivinanlovin", sec(275)),
-						track("Thanks For Your Time", sec(252)),
+						track("Like Drawing Blood", sec(22), "AUZS20800001"),
+						track("The Only Way", sec(284), "AUZS20800027"),
+						track("Hearts A Mess", sec(365), "AUZS20800003"),
+						track("Coming Back", sec(360), "AUZS20800028"),
+						track("Thanks For Your Time", sec(260), "AUZS20800029"),
+						track("Learnalilgivinanlovin", sec(173), "AUZS20800006"),
+						track("Puzzle With A Piece Missing", sec(341), "AUZS20800007"),
+						track("Seven Hours With A Backseat Driver", sec(283), "AUZS20800008"),
+						track("The Only Thing I Know", sec(423), "AUZS20800009"),
+						track("Night Drive", sec(310), "AUZS20800010"),
+						track("Worn Out Blues", sec(38), "AUZS20800011"),
+						track("Coming Back", sec(201), "AUGFO0700428", "Gotye", " & ", "Inga Liljestrom"),
+						track("Hearts A Mess", sec(499), "GBJET0800002"),
+						track("Puzzle With A Piece Missing", sec(261), "AUGFO0700418"),
+						track("Learnalilgivinanlovin", sec(275), "GBJET0900021"),
+						track("Thanks For Your Time", sec(252), "AUGFO0700422"),
 					},
 				}},
 				URLs: []seed.URL{{
@@ -299,8 +306,8 @@ func sec(sec float64) time.Duration {
 	return time.Duration(sec * float64(time.Second))
 }
 
-func track(title string, length time.Duration, creds ...string) seed.Track {
-	tr := seed.Track{Title: title, Length: length}
+func track(title string, length time.Duration, isrc string, creds ...string) seed.Track {
+	tr := seed.Track{Title: title, Length: length, ISRC: isrc}
 	for i := 0; i < len(creds); i += 2 {
 		ac := seed.ArtistCredit{Name: creds[i]}
 		if i+1 < len(creds) {
@@ -311,31 +318,20 @@ func track(title string, length time.Duration, creds ...string) seed.Track {
 	return tr
 }
 
-type fakeAPICaller struct{}
-
-func (*fakeAPICaller) call(ctx context.Context, path string) ([]byte, error) {
-	read := func(p string) ([]byte, error) {
-		f, err := os.Open(p)
-		if os.IsNotExist(err) {
-			return nil, notFoundErr
-		} else if err != nil {
-			return nil, err
-		}
-		defer f.Close()
-		return io.ReadAll(f)
-	}
-
-	if ms := apiAlbumRegexp.FindStringSubmatch(path); ms != nil {
-		return read(filepath.Join("testdata", "album_"+ms[1]+"_"+ms[2]+".json"))
-	} else if ms := apiCreditsRegexp.FindStringSubmatch(path); ms != nil {
-		return read(filepath.Join("testdata", "credits_"+ms[1]+"_"+ms[2]+".json"))
-	} else if ms := apiTracksRegexp.FindStringSubmatch(path); ms != nil {
-		return read(filepath.Join("testdata", "tracks_"+ms[1]+"_"+ms[2]+".json"))
+// apiFixtureName is a webtest.NameFunc that returns testdata filenames for
+// API paths requested by getRelease().
+func apiFixtureName(req *http.Request) string {
+	p := req.URL.RequestURI()
+	if ms := apiAlbumRegexp.FindStringSubmatch(p); ms != nil {
+		return "album_" + ms[1] + "_" + ms[2] + ".json"
+	} else if ms := apiCreditsRegexp.FindStringSubmatch(p); ms != nil {
+		return "credits_" + ms[1] + "_" + ms[2] + ".json"
+	} else if ms := apiTracksRegexp.FindStringSubmatch(p); ms != nil {
+		return "tracks_" + ms[1] + "_" + ms[2] + ".json"
 	}
-	return nil, fmt.Errorf("unhandled path %q", path)
+	return ""
 }
 
-// These match API paths requested by getRelease().
 var apiAlbumRegexp = regexp.MustCompile(`^/v1/albums/(\d+)\?countryCode=([A-Z]{2})$`)
 var apiCreditsRegexp = regexp.MustCompile(`^/v1/albums/(\d+)/credits\?countryCode=([A-Z]{2})$`)
 var apiTracksRegexp = regexp.MustCompile(`^/v1/albums/(\d+)/tracks\?countryCode=([A-Z]{2})$`)
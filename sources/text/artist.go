@@ -61,10 +61,18 @@ var artistFields = map[string]fieldInfo{
 		"Artist's name",
 		func(a *seed.Artist, k, v string) error { return setString(&a.Name, v) },
 	},
+	"rating": {
+		"Rating between 1 and 5 to give to existing artist via API (requires mbid and -action=submit)",
+		func(a *seed.Artist, k, v string) error { return setInt(&a.Rating, v) },
+	},
 	"sort_name": {
 		"Artist's sort name",
 		func(a *seed.Artist, k, v string) error { return setString(&a.SortName, v) },
 	},
+	"tags": {
+		"Comma-separated tags to add to existing artist via API (requires mbid and -action=submit)",
+		func(a *seed.Artist, k, v string) error { return setStringSlice(&a.Tags, v, ",") },
+	},
 	"type": {
 		"Integer [artist type](" + artistTypeURL + ")",
 		func(a *seed.Artist, k, v string) error { return setInt((*int)(&a.Type), v) },
@@ -73,14 +81,14 @@ var artistFields = map[string]fieldInfo{
 
 func init() {
 	// Add common fields.
-	addRelationshipFields(artistFields,
+	addRelationshipFields(artistFields, seed.ArtistEntity,
 		func(fn relFunc) interface{} {
 			return func(a *seed.Artist, k, v string) error {
 				return indexedField(&a.Relationships, k, "rel",
 					func(rel *seed.Relationship) error { return fn(rel, k, v) })
 			}
 		})
-	addURLFields(artistFields,
+	addURLFields(artistFields, seed.ArtistEntity,
 		func(fn urlFunc) interface{} {
 			return func(a *seed.Artist, k, v string) error {
 				return indexedField(&a.URLs, k, "url",
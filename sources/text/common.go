@@ -4,7 +4,7 @@
 package text
 
 import (
-	"errors"
+	"strings"
 
 	"github.com/derat/yambs/seed"
 )
@@ -40,9 +40,10 @@ func addArtistCreditFields(fields map[string]fieldInfo, prefix string, fn func(a
 }
 
 // addRelationshipFields adds "rel*_"-prefixed fields for seed.Relationship.
+// typ is the type of the entity being edited and is used to look up link types by name.
 // fn should return an appropriately-typed fieldInfo.Fn that invokes the relFunc
 // with the seed.Relationship and user-supplied key and value.
-func addRelationshipFields(fields map[string]fieldInfo, fn func(relFunc) interface{}) {
+func addRelationshipFields(fields map[string]fieldInfo, typ seed.Entity, fn func(relFunc) interface{}) {
 	fields["rel*_backward"] = fieldInfo{
 		`Whether the relationship direction is reversed ("1" or "true" if true)`,
 		fn(func(rel *seed.Relationship, k, v string) error { return setBool(&rel.Backward, v) }),
@@ -72,14 +73,23 @@ func addRelationshipFields(fields map[string]fieldInfo, fn func(relFunc) interfa
 		fn(func(rel *seed.Relationship, k, v string) error { return setString(&rel.TargetCredit, v) }),
 	}
 	fields["rel*_type"] = fieldInfo{
-		"Integer [link type](" + linkTypeURL + ") or UUID describing the relationship type",
+		"[Link type](" + linkTypeURL + ") describing the relationship type as integer, UUID, " +
+			`or name (e.g. "producer", or "recording:producer" to specify the target entity type)`,
 		fn(func(rel *seed.Relationship, k, v string) error {
-			if err := setInt((*int)(&rel.Type), v); err != nil {
-				if err := setMBID(&rel.TypeUUID, v); err != nil {
-					return errors.New("not integer or UUID")
-				}
+			if err := setInt((*int)(&rel.Type), v); err == nil {
+				return nil
 			}
-			return nil
+			if err := setMBID(&rel.TypeUUID, v); err == nil {
+				return nil
+			}
+			var target seed.Entity
+			name := v
+			if i := strings.IndexByte(v, ':'); i >= 0 {
+				target, name = seed.Entity(strings.TrimSpace(v[:i])), v[i+1:]
+			}
+			var err error
+			rel.Type, err = seed.FindLinkType(typ, target, name)
+			return err
 		}),
 	}
 
@@ -102,28 +112,41 @@ func addRelationshipFields(fields map[string]fieldInfo, fn func(relFunc) interfa
 		attrFn(func(attr *seed.RelationshipAttribute, v string) error { return setString(&attr.TextValue, v) }),
 	}
 	fields["rel*_attr*_type"] = fieldInfo{
-		"Integer [link attribute type](" + linkAttrTypeURL + ") or UUID describing the relationship attribute type",
+		"[Link attribute type](" + linkAttrTypeURL + ") describing the relationship attribute type " +
+			`as integer, UUID, or name (e.g. "guitar")`,
 		attrFn(func(attr *seed.RelationshipAttribute, v string) error {
-			if err := setInt((*int)(&attr.Type), v); err != nil {
-				if err := setMBID(&attr.TypeUUID, v); err != nil {
-					return errors.New("not integer or UUID")
-				}
+			if err := setInt((*int)(&attr.Type), v); err == nil {
+				return nil
+			}
+			if err := setMBID(&attr.TypeUUID, v); err == nil {
+				return nil
 			}
-			return nil
+			var err error
+			attr.Type, err = seed.FindLinkAttributeType(v)
+			return err
 		}),
 	}
 }
 
 // addURLFields adds "url*_"-prefixed fields for seed.URL.
+// typ is the type of the entity being edited and is used to look up link types by name.
 // fn should return an appropriately-typed fieldInfo.Fn that invokes the urlFunc
 // with the seed.URL and user-supplied value.
-func addURLFields(fields map[string]fieldInfo, fn func(urlFunc) interface{}) {
+func addURLFields(fields map[string]fieldInfo, typ seed.Entity, fn func(urlFunc) interface{}) {
 	fields["url*_url"] = fieldInfo{
 		"URL related to entity",
 		fn(func(u *seed.URL, v string) error { return setString(&u.URL, v) }),
 	}
 	fields["url*_type"] = fieldInfo{
-		"Integer [link type](" + linkTypeURL + ") describing how URL is related to entity",
-		fn(func(u *seed.URL, v string) error { return setInt((*int)(&u.LinkType), v) }),
+		"[Link type](" + linkTypeURL + `) describing how URL is related to entity as integer or name (e.g. "discogs"); ` +
+			"guessed for some sites if unset",
+		fn(func(u *seed.URL, v string) error {
+			if err := setInt((*int)(&u.LinkType), v); err == nil {
+				return nil
+			}
+			var err error
+			u.LinkType, err = seed.FindURLLinkType(typ, v)
+			return err
+		}),
 	}
 }
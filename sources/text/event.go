@@ -54,14 +54,14 @@ var eventFields = map[string]fieldInfo{
 
 func init() {
 	// Add common fields.
-	addRelationshipFields(eventFields,
+	addRelationshipFields(eventFields, seed.EventEntity,
 		func(fn relFunc) interface{} {
 			return func(e *seed.Event, k, v string) error {
 				return indexedField(&e.Relationships, k, "rel",
 					func(rel *seed.Relationship) error { return fn(rel, k, v) })
 			}
 		})
-	addURLFields(eventFields,
+	addURLFields(eventFields, seed.EventEntity,
 		func(fn urlFunc) interface{} {
 			return func(e *seed.Event, k, v string) error {
 				return indexedField(&e.URLs, k, "url",
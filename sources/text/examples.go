@@ -21,10 +21,16 @@ func SetExample(typ seed.Entity) string {
 		return "type=1\n" + editNote
 	case seed.LabelEntity:
 		return "type=7\n" + editNote
+	case seed.PlaceEntity:
+		return "type=2\narea_name=London\n" + editNote
 	case seed.RecordingEntity:
 		return "artist=7e84f845-ac16-41fe-9ff8-df12eb32af55\n" + editNote
 	case seed.ReleaseEntity:
 		return "language=Eng\nscript=Latn\n" + editNote
+	case seed.ReleaseGroupEntity:
+		return "types=Album,Live\n" + editNote
+	case seed.SeriesEntity:
+		return "type=2\nordering_type=1\n" + editNote
 	case seed.WorkEntity:
 		return "languages=120,1739\n" + editNote
 	}
@@ -40,10 +46,16 @@ func FieldsExample(typ seed.Entity) string {
 		return "name,begin_date,time"
 	case seed.LabelEntity:
 		return "name,begin_date"
+	case seed.PlaceEntity:
+		return "name,address,coordinates"
 	case seed.RecordingEntity:
 		return "name,length"
 	case seed.ReleaseEntity:
 		return "artist0_name,title,status"
+	case seed.ReleaseGroupEntity:
+		return "artist0_name,title,types"
+	case seed.SeriesEntity:
+		return "name,type,ordering_type"
 	case seed.WorkEntity:
 		return "name,type"
 	}
@@ -74,7 +86,17 @@ mbid=02442aba-cf00-445c-877e-f0eaa504d8c2
 rel0_target=43bcfb95-f26c-4f8d-84f8-7b2ac5b8ab72
 rel0_type=362
 rel1_target=a9d8b538-c20a-4025-aea1-5530d616a20a
-rel1_type=362
+rel1_type=published
+edit_note=https://www.example.org/`, "\n")
+		case seed.PlaceEntity:
+			return strings.TrimLeft(`
+name=Venue Name
+type=2
+address=123 Main Street, Springfield
+area_name=Springfield
+coordinates=39.7817, -89.6501
+begin_date=1998-04
+url0_url=https://www.example.org/
 edit_note=https://www.example.org/`, "\n")
 		case seed.RecordingEntity:
 			return strings.TrimLeft(`
@@ -101,6 +123,25 @@ medium1_format=CD
 medium1_track0_title=First Track on Second Disc
 url0_url=https://www.example.org/
 url0_type=75
+edit_note=https://www.example.org/`, "\n")
+		case seed.ReleaseGroupEntity:
+			return strings.TrimLeft(`
+title=Album Title
+artist0_name=Artist Name
+types=Album,Soundtrack
+url0_url=https://www.wikidata.org/wiki/Q123
+url0_type=353
+edit_note=https://www.example.org/`, "\n")
+		case seed.SeriesEntity:
+			return strings.TrimLeft(`
+name=Series Name
+type=2
+ordering_type=1
+rel0_target=7f4c5b9e-0d0e-4ae5-9b0a-fb6f6c4a0e7a
+rel0_type=741
+rel0_backward=1
+rel0_attr0_type=788
+rel0_attr0_text=1
 edit_note=https://www.example.org/`, "\n")
 		case seed.WorkEntity:
 			return strings.TrimLeft(`
@@ -129,6 +170,11 @@ edit_note=https://www.example.org/`, "\n")
 			{"Some Label", "1985-02-13"},
 			{"Another Label", "2016"},
 		}
+	case seed.PlaceEntity:
+		rows = [][]string{
+			{"The Venue", "123 Main Street, Springfield", "39.7817, -89.6501"},
+			{"Another Club", "45 Elm Street, Springfield", "39.8012, -89.6437"},
+		}
 	case seed.RecordingEntity:
 		rows = [][]string{
 			{"Recording Name", "4:35.16"},
@@ -139,6 +185,16 @@ edit_note=https://www.example.org/`, "\n")
 			{"Artist Name", "Album Title", "Official,Soundtrack"},
 			{"Another Artist", "Another Album", "Bootleg"},
 		}
+	case seed.ReleaseGroupEntity:
+		rows = [][]string{
+			{"Artist Name", "Album Title", "Album,Soundtrack"},
+			{"Another Artist", "Another Album", "Single"},
+		}
+	case seed.SeriesEntity:
+		rows = [][]string{
+			{"Some Release Series", "2", "1"},
+			{"A Tour", "7", "1"},
+		}
 	case seed.WorkEntity:
 		rows = [][]string{
 			{"A Musical", "29"},
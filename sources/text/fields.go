@@ -53,12 +53,15 @@ type fieldInfo struct {
 }
 
 var typeFields = map[seed.Entity]map[string]fieldInfo{
-	seed.ArtistEntity:    artistFields,
-	seed.EventEntity:     eventFields,
-	seed.LabelEntity:     labelFields,
-	seed.RecordingEntity: recordingFields,
-	seed.ReleaseEntity:   releaseFields,
-	seed.WorkEntity:      workFields,
+	seed.ArtistEntity:       artistFields,
+	seed.EventEntity:        eventFields,
+	seed.LabelEntity:        labelFields,
+	seed.PlaceEntity:        placeFields,
+	seed.RecordingEntity:    recordingFields,
+	seed.ReleaseEntity:      releaseFields,
+	seed.ReleaseGroupEntity: releaseGroupFields,
+	seed.SeriesEntity:       seriesFields,
+	seed.WorkEntity:         workFields,
 }
 
 // SetField sets the named field in edit.
@@ -77,10 +80,16 @@ func SetField(edit seed.Edit, field, val string) error {
 		return fn.(func(*seed.Event, string, string) error)(tedit, field, val)
 	case *seed.Label:
 		return fn.(func(*seed.Label, string, string) error)(tedit, field, val)
+	case *seed.Place:
+		return fn.(func(*seed.Place, string, string) error)(tedit, field, val)
 	case *seed.Recording:
 		return fn.(func(*seed.Recording, string, string) error)(tedit, field, val)
 	case *seed.Release:
 		return fn.(func(*seed.Release, string, string) error)(tedit, field, val)
+	case *seed.ReleaseGroup:
+		return fn.(func(*seed.ReleaseGroup, string, string) error)(tedit, field, val)
+	case *seed.Series:
+		return fn.(func(*seed.Series, string, string) error)(tedit, field, val)
 	case *seed.Work:
 		return fn.(func(*seed.Work, string, string) error)(tedit, field, val)
 	default:
@@ -150,6 +159,11 @@ func setStringSlice(dst *[]string, val, sep string) error {
 	return nil
 }
 
+func setArtistCredits(dst *[]seed.ArtistCredit, val string) error {
+	*dst = seed.ParseArtistCredits(val)
+	return nil
+}
+
 func setMBID(dst *string, val string) error {
 	if !mbdb.IsMBID(val) {
 		return errors.New("not MBID")
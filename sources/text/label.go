@@ -53,6 +53,14 @@ var labelFields = map[string]fieldInfo{
 		"Label's name",
 		func(l *seed.Label, k, v string) error { return setString(&l.Name, v) },
 	},
+	"rating": {
+		"Rating between 1 and 5 to give to existing label via API (requires mbid and -action=submit)",
+		func(l *seed.Label, k, v string) error { return setInt(&l.Rating, v) },
+	},
+	"tags": {
+		"Comma-separated tags to add to existing label via API (requires mbid and -action=submit)",
+		func(l *seed.Label, k, v string) error { return setStringSlice(&l.Tags, v, ",") },
+	},
 	"type": {
 		"Integer [label type](" + labelTypeURL + ") describing label's main activity",
 		func(l *seed.Label, k, v string) error { return setInt((*int)(&l.Type), v) },
@@ -61,14 +69,14 @@ var labelFields = map[string]fieldInfo{
 
 func init() {
 	// Add common fields.
-	addRelationshipFields(labelFields,
+	addRelationshipFields(labelFields, seed.LabelEntity,
 		func(fn relFunc) interface{} {
 			return func(l *seed.Label, k, v string) error {
 				return indexedField(&l.Relationships, k, "rel",
 					func(rel *seed.Relationship) error { return fn(rel, k, v) })
 			}
 		})
-	addURLFields(labelFields,
+	addURLFields(labelFields, seed.LabelEntity,
 		func(fn urlFunc) interface{} {
 			return func(l *seed.Label, k, v string) error {
 				return indexedField(&l.URLs, k, "url",
@@ -0,0 +1,74 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package text
+
+import (
+	"github.com/derat/yambs/seed"
+)
+
+// placeFields defines fields that can be set in a seed.Place.
+var placeFields = map[string]fieldInfo{
+	"address": {
+		"Place's street address",
+		func(p *seed.Place, k, v string) error { return setString(&p.Address, v) },
+	},
+	"area_name": {
+		"Area name to prefill search",
+		func(p *seed.Place, k, v string) error { return setString(&p.AreaName, v) },
+	},
+	"begin_date": {
+		`Date when place was founded as "YYYY-MM-DD", "YYYY-MM", or "YYYY"`,
+		func(p *seed.Place, k, v string) error { return setDate(&p.BeginDate, v) },
+	},
+	"coordinates": {
+		`Place's latitude and longitude, e.g. "51.5319, -0.1779"`,
+		func(p *seed.Place, k, v string) error { return setString(&p.Coordinates, v) },
+	},
+	"disambiguation": {
+		"Comment disambiguating this place from others with similar names",
+		func(p *seed.Place, k, v string) error { return setString(&p.Disambiguation, v) },
+	},
+	"edit_note": {
+		"Note attached to edit",
+		func(p *seed.Place, k, v string) error { return setString(&p.EditNote, v) },
+	},
+	"end_date": {
+		`Date when place closed as "YYYY-MM-DD", "YYYY-MM", or "YYYY"`,
+		func(p *seed.Place, k, v string) error { return setDate(&p.EndDate, v) },
+	},
+	"ended": {
+		`Whether the place has closed ("1" or "true" if true)`,
+		func(p *seed.Place, k, v string) error { return setBool(&p.Ended, v) },
+	},
+	"mbid": {
+		"MBID of existing place to edit (if empty, create place)",
+		func(p *seed.Place, k, v string) error { return setMBID(&p.MBID, v) },
+	},
+	"name": {
+		"Place's name",
+		func(p *seed.Place, k, v string) error { return setString(&p.Name, v) },
+	},
+	"type": {
+		"Integer [place type](" + placeTypeURL + ") describing place's main function",
+		func(p *seed.Place, k, v string) error { return setInt((*int)(&p.Type), v) },
+	},
+}
+
+func init() {
+	// Add common fields.
+	addRelationshipFields(placeFields, seed.PlaceEntity,
+		func(fn relFunc) interface{} {
+			return func(p *seed.Place, k, v string) error {
+				return indexedField(&p.Relationships, k, "rel",
+					func(rel *seed.Relationship) error { return fn(rel, k, v) })
+			}
+		})
+	addURLFields(placeFields, seed.PlaceEntity,
+		func(fn urlFunc) interface{} {
+			return func(p *seed.Place, k, v string) error {
+				return indexedField(&p.URLs, k, "url",
+					func(url *seed.URL) error { return fn(url, v) })
+			}
+		})
+}
@@ -0,0 +1,117 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package text
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"strconv"
+	"testing"
+
+	"github.com/derat/yambs/mbdb"
+	"github.com/derat/yambs/seed"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRead_Place_All(t *testing.T) {
+	const (
+		address     = "123 Main Street, Springfield"
+		areaName    = "Springfield"
+		beginDate   = "1998-04"
+		coordinates = "39.7817, -89.6501"
+		disambig    = "downtown"
+		editNote    = "here's my edit"
+		endDate     = "2015"
+		mbid        = "0096a0bf-804e-4e47-bf2a-e0878dbb3eb7"
+		name        = "The Venue"
+		placeType   = seed.PlaceType_Venue
+		relTarget   = "dd141ce3-5e5a-48f2-a774-66ba427fca99"
+		relType     = seed.LinkType_HeldAt_Event_Place
+		url         = "https://www.example.org/venue"
+	)
+
+	var input bytes.Buffer
+	if err := csv.NewWriter(&input).WriteAll([][]string{{
+		address,
+		areaName,
+		beginDate,
+		coordinates,
+		disambig,
+		editNote,
+		endDate,
+		"1",
+		mbid,
+		name,
+		relTarget,
+		strconv.Itoa(int(relType)),
+		"true",
+		strconv.Itoa(int(placeType)),
+		url,
+	}}); err != nil {
+		t.Fatal("Failed writing input:", err)
+	}
+	got, err := Read(context.Background(), &input, CSV, seed.PlaceEntity, []string{
+		"address",
+		"area_name",
+		"begin_date",
+		"coordinates",
+		"disambiguation",
+		"edit_note",
+		"end_date",
+		"ended",
+		"mbid",
+		"name",
+		"rel0_target",
+		"rel0_type",
+		"rel0_backward",
+		"type",
+		"url0_url",
+	}, nil, mbdb.NewDB(mbdb.DisallowQueries))
+	if err != nil {
+		t.Fatal("Read failed:", err)
+	}
+
+	want := []seed.Edit{
+		&seed.Place{
+			Address:        address,
+			AreaName:       areaName,
+			BeginDate:      seed.MakeDate(1998, 4, 0),
+			Coordinates:    coordinates,
+			Disambiguation: disambig,
+			EditNote:       editNote,
+			EndDate:        seed.MakeDate(2015, 0, 0),
+			Ended:          true,
+			MBID:           mbid,
+			Name:           name,
+			Relationships: []seed.Relationship{{
+				Target:   relTarget,
+				Type:     relType,
+				Backward: true,
+			}},
+			Type: placeType,
+			URLs: []seed.URL{{URL: url}},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error("Read returned wrong edits:\n" + diff)
+	}
+}
+
+func TestRead_Place_URLType(t *testing.T) {
+	const (
+		name    = "The Venue"
+		url     = "https://www.example.org/venue"
+		urlType = 9001 // integer types are passed through
+	)
+	got, err := Read(context.Background(), bytes.NewBufferString(name+"\t"+url+"\t"+strconv.Itoa(urlType)),
+		TSV, seed.PlaceEntity, []string{"name", "url0_url", "url0_type"}, nil, mbdb.NewDB(mbdb.DisallowQueries))
+	if err != nil {
+		t.Fatal("Read failed:", err)
+	}
+	want := []seed.Edit{&seed.Place{Name: name, URLs: []seed.URL{{URL: url, LinkType: urlType}}}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error("Read returned wrong edits:\n" + diff)
+	}
+}
@@ -35,6 +35,7 @@ const (
 type config struct {
 	maxEdits  int
 	maxFields int
+	skipEmpty bool
 }
 
 // Read reads one or more edits of the specified type from r in the specified format.
@@ -100,6 +101,11 @@ func Read(ctx context.Context, r io.Reader, format Format, typ seed.Entity,
 			if field == "" {
 				continue
 			}
+			// Also skip empty values if requested so that rows can omit fields that other
+			// rows use (e.g. when a track only appears on some releases).
+			if cfg.skipEmpty && cols[j] == "" {
+				continue
+			}
 			for _, fd := range strings.Split(field, "/") {
 				val := cols[j]
 				err := SetField(edit, fd, val)
@@ -134,6 +140,11 @@ func MaxEdits(max int) Option { return func(c *config) { c.maxEdits = max } }
 // "field=value" directives are included in the count.
 func MaxFields(max int) Option { return func(c *config) { c.maxFields = max } }
 
+// SkipEmptyValues returns an Option that makes Read ignore empty CSV and TSV values instead
+// of setting the corresponding fields to them. This is needed to read edits written by Write,
+// which leaves columns empty when an edit doesn't use a field that another edit uses.
+func SkipEmptyValues() Option { return func(c *config) { c.skipEmpty = true } }
+
 // rowReader is used by Read to read entity data row-by-row.
 type rowReader interface {
 	Read() ([]string, error)
@@ -214,10 +225,16 @@ func newEdit(typ seed.Entity) seed.Edit {
 		return &seed.Event{}
 	case seed.LabelEntity:
 		return &seed.Label{}
+	case seed.PlaceEntity:
+		return &seed.Place{}
 	case seed.RecordingEntity:
 		return &seed.Recording{}
 	case seed.ReleaseEntity:
 		return &seed.Release{}
+	case seed.ReleaseGroupEntity:
+		return &seed.ReleaseGroup{}
+	case seed.SeriesEntity:
+		return &seed.Series{}
 	case seed.WorkEntity:
 		return &seed.Work{}
 	default:
@@ -13,6 +13,10 @@ var recordingFields = map[string]fieldInfo{
 		"MBID of artist receiving primary credit for recording",
 		func(r *seed.Recording, k, v string) error { return setMBID(&r.Artist, v) },
 	},
+	"artists": {
+		`Artist credit string to split into individual artists (e.g. "A feat. B & C")`,
+		func(r *seed.Recording, k, v string) error { return setArtistCredits(&r.Artists, v) },
+	},
 	"disambiguation": {
 		"Comment disambiguating this recording from others with similar names",
 		func(r *seed.Recording, k, v string) error { return setString(&r.Disambiguation, v) },
@@ -37,6 +41,14 @@ var recordingFields = map[string]fieldInfo{
 		"Recording's name",
 		func(r *seed.Recording, k, v string) error { return setString(&r.Name, v) },
 	},
+	"rating": {
+		"Rating between 1 and 5 to give to existing recording via API (requires mbid and -action=submit)",
+		func(r *seed.Recording, k, v string) error { return setInt(&r.Rating, v) },
+	},
+	"tags": {
+		"Comma-separated tags to add to existing recording via API (requires mbid and -action=submit)",
+		func(r *seed.Recording, k, v string) error { return setStringSlice(&r.Tags, v, ",") },
+	},
 	"video": {
 		`Whether this is a video recording ("1" or "true" if true)`,
 		func(r *seed.Recording, k, v string) error { return setBool(&r.Video, v) },
@@ -52,14 +64,14 @@ func init() {
 					func(ac *seed.ArtistCredit) error { return fn(ac, v) })
 			}
 		})
-	addRelationshipFields(recordingFields,
+	addRelationshipFields(recordingFields, seed.RecordingEntity,
 		func(fn relFunc) interface{} {
 			return func(r *seed.Recording, k, v string) error {
 				return indexedField(&r.Relationships, k, "rel",
 					func(rel *seed.Relationship) error { return fn(rel, k, v) })
 			}
 		})
-	addURLFields(recordingFields,
+	addURLFields(recordingFields, seed.RecordingEntity,
 		func(fn urlFunc) interface{} {
 			return func(r *seed.Recording, k, v string) error {
 				return indexedField(&r.URLs, k, "url",
@@ -232,6 +232,30 @@ func TestRead_Recording_MaxEdits(t *testing.T) {
 	}
 }
 
+func TestRead_Recording_SkipEmptyValues(t *testing.T) {
+	ctx := context.Background()
+	db := mbdb.NewDB(mbdb.DisallowQueries)
+	fields := []string{"name", "disambiguation"}
+	setCmds := []string{"disambiguation=Default"}
+	const input = "Name 1\tComment\nName 2\t\n"
+
+	// By default, empty values should be set.
+	if got, err := Read(ctx, strings.NewReader(input), TSV, seed.RecordingEntity,
+		fields, setCmds, db); err != nil {
+		t.Error("Read failed:", err)
+	} else if dis := got[1].(*seed.Recording).Disambiguation; dis != "" {
+		t.Errorf("Read set disambiguation %q; want empty", dis)
+	}
+
+	// With SkipEmptyValues, empty values should be ignored.
+	if got, err := Read(ctx, strings.NewReader(input), TSV, seed.RecordingEntity,
+		fields, setCmds, db, SkipEmptyValues()); err != nil {
+		t.Error("Read failed:", err)
+	} else if dis := got[1].(*seed.Recording).Disambiguation; dis != "Default" {
+		t.Errorf("Read with SkipEmptyValues set disambiguation %q; want %q", dis, "Default")
+	}
+}
+
 func TestRead_Recording_MaxFields(t *testing.T) {
 	// Read should accept input matching the maximum number of fields.
 	ctx := context.Background()
@@ -302,3 +326,68 @@ func TestRead_Recording_SkipField(t *testing.T) {
 		t.Error("Read returned wrong edits:\n" + diff)
 	}
 }
+
+func TestRead_Recording_Artists(t *testing.T) {
+	got, err := Read(context.Background(),
+		strings.NewReader("name=Name\nartists=A feat. B & C\n"),
+		KeyVal, seed.RecordingEntity, nil, nil, mbdb.NewDB(mbdb.DisallowQueries))
+	if err != nil {
+		t.Fatal("Read failed:", err)
+	}
+	want := []seed.Edit{&seed.Recording{
+		Name: "Name",
+		Artists: []seed.ArtistCredit{
+			{Name: "A", JoinPhrase: " feat. "},
+			{Name: "B", JoinPhrase: " & "},
+			{Name: "C"},
+		},
+	}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error("Read returned wrong edits:\n" + diff)
+	}
+}
+
+func TestRead_Recording_TypeNames(t *testing.T) {
+	got, err := Read(context.Background(),
+		strings.NewReader(strings.Join([]string{
+			"name=Name",
+			"rel0_target=Producer",
+			"rel0_type=producer",
+			"rel1_target=Guitarist",
+			"rel1_type=artist:Instrument",
+			"rel1_attr0_type=acoustic guitar",
+			"url0_url=https://www.example.org/",
+			"url0_type=Purchase for download",
+		}, "\n")),
+		KeyVal, seed.RecordingEntity, nil, nil, mbdb.NewDB(mbdb.DisallowQueries))
+	if err != nil {
+		t.Fatal("Read failed:", err)
+	}
+	want := []seed.Edit{&seed.Recording{
+		Name: "Name",
+		Relationships: []seed.Relationship{
+			{Target: "Producer", Type: seed.LinkType_Producer_Artist_Recording},
+			{
+				Target:     "Guitarist",
+				Type:       seed.LinkType_Instrument_Artist_Recording,
+				Attributes: []seed.RelationshipAttribute{{Type: seed.LinkAttributeType_AcousticGuitar}},
+			},
+		},
+		URLs: []seed.URL{{
+			URL:      "https://www.example.org/",
+			LinkType: seed.LinkType_PurchaseForDownload_Recording_URL,
+		}},
+	}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error("Read returned wrong edits:\n" + diff)
+	}
+
+	// Unknown names should produce errors listing the valid names.
+	_, err = Read(context.Background(), strings.NewReader("rel0_type=bogus\n"),
+		KeyVal, seed.RecordingEntity, nil, nil, mbdb.NewDB(mbdb.DisallowQueries))
+	if err == nil {
+		t.Error("Read unexpectedly succeeded for unknown link type")
+	} else if want := "valid types: "; !strings.Contains(err.Error(), want) {
+		t.Errorf("Read failed with %q; want error containing %q", err, want)
+	}
+}
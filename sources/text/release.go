@@ -28,6 +28,10 @@ var releaseFields = map[string]fieldInfo{
 			return nil
 		},
 	},
+	"artists": {
+		`Artist credit string to split into individual artists (e.g. "A feat. B & C")`,
+		func(r *seed.Release, k, v string) error { return setArtistCredits(&r.Artists, v) },
+	},
 	"disambiguation": {
 		"Comment disambiguating this release from others with similar names",
 		func(r *seed.Release, k, v string) error { return setString(&r.Disambiguation, v) },
@@ -138,12 +142,28 @@ var releaseFields = map[string]fieldInfo{
 			return releaseMediumTrack(rel, k, func(tr *seed.Track) error { return setMBID(&tr.Recording, v) })
 		},
 	},
+	"medium*_track*_isrc": {
+		"ISRC used to find existing recording if recording MBID is unset",
+		func(rel *seed.Release, k, v string) error {
+			return releaseMediumTrack(rel, k, func(tr *seed.Track) error { return setString(&tr.ISRC, v) })
+		},
+	},
+	"medium*_track*_artists": {
+		`Artist credit string to split into individual artists (e.g. "A feat. B & C")`,
+		func(rel *seed.Release, k, v string) error {
+			return releaseMediumTrack(rel, k, func(tr *seed.Track) error { return setArtistCredits(&tr.Artists, v) })
+		},
+	},
 	"medium*_track*_length": {
 		`Track length as e.g. "3:45.01" or total milliseconds`,
 		func(rel *seed.Release, k, v string) error {
 			return releaseMediumTrack(rel, k, func(tr *seed.Track) error { return setDuration(&tr.Length, v) })
 		},
 	},
+	"tags": {
+		"Comma-separated tags to add to existing release via API (requires mbid and -action=submit)",
+		func(r *seed.Release, k, v string) error { return setStringSlice(&r.Tags, v, ",") },
+	},
 	"edit_note": {
 		"Note attached to edit",
 		func(r *seed.Release, k, v string) error { return setString(&r.EditNote, v) },
@@ -159,7 +179,7 @@ func init() {
 					func(ac *seed.ArtistCredit) error { return fn(ac, v) })
 			}
 		})
-	addURLFields(releaseFields,
+	addURLFields(releaseFields, seed.ReleaseEntity,
 		func(fn urlFunc) interface{} {
 			return func(r *seed.Release, k, v string) error {
 				return indexedField(&r.URLs, k, "url",
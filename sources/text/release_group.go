@@ -0,0 +1,76 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package text
+
+import (
+	"github.com/derat/yambs/seed"
+)
+
+// releaseGroupFields defines fields that can be set in a seed.ReleaseGroup.
+var releaseGroupFields = map[string]fieldInfo{
+	"artists": {
+		`Artist credit string to split into individual artists (e.g. "A feat. B & C")`,
+		func(rg *seed.ReleaseGroup, k, v string) error { return setArtistCredits(&rg.Artists, v) },
+	},
+	"disambiguation": {
+		"Comment disambiguating this release group from others with similar names",
+		func(rg *seed.ReleaseGroup, k, v string) error { return setString(&rg.Disambiguation, v) },
+	},
+	"edit_note": {
+		"Note attached to edit",
+		func(rg *seed.ReleaseGroup, k, v string) error { return setString(&rg.EditNote, v) },
+	},
+	"mbid": {
+		"MBID of existing release group to edit (if empty, create release group)",
+		func(rg *seed.ReleaseGroup, k, v string) error { return setMBID(&rg.MBID, v) },
+	},
+	"rating": {
+		"Rating between 1 and 5 to give to existing release group via API (requires mbid and -action=submit)",
+		func(rg *seed.ReleaseGroup, k, v string) error { return setInt(&rg.Rating, v) },
+	},
+	"tags": {
+		"Comma-separated tags to add to existing release group via API (requires mbid and -action=submit)",
+		func(rg *seed.ReleaseGroup, k, v string) error { return setStringSlice(&rg.Tags, v, ",") },
+	},
+	"title": {
+		"Release group title",
+		func(rg *seed.ReleaseGroup, k, v string) error { return setString(&rg.Title, v) },
+	},
+	"types": {
+		`Comma-separated [types](` + rgTypeURL + `) (e.g. "Album,Live")`,
+		func(rg *seed.ReleaseGroup, k, v string) error {
+			var vals []string
+			setStringSlice(&vals, v, ",")
+			for _, v := range vals {
+				rg.Types = append(rg.Types, seed.ReleaseGroupType(v))
+			}
+			return nil
+		},
+	},
+}
+
+func init() {
+	// Add common fields.
+	addArtistCreditFields(releaseGroupFields, "",
+		func(fn artistFunc) interface{} {
+			return func(rg *seed.ReleaseGroup, k, v string) error {
+				return indexedField(&rg.Artists, k, "artist",
+					func(ac *seed.ArtistCredit) error { return fn(ac, v) })
+			}
+		})
+	addRelationshipFields(releaseGroupFields, seed.ReleaseGroupEntity,
+		func(fn relFunc) interface{} {
+			return func(rg *seed.ReleaseGroup, k, v string) error {
+				return indexedField(&rg.Relationships, k, "rel",
+					func(rel *seed.Relationship) error { return fn(rel, k, v) })
+			}
+		})
+	addURLFields(releaseGroupFields, seed.ReleaseGroupEntity,
+		func(fn urlFunc) interface{} {
+			return func(rg *seed.ReleaseGroup, k, v string) error {
+				return indexedField(&rg.URLs, k, "url",
+					func(url *seed.URL) error { return fn(url, v) })
+			}
+		})
+}
@@ -0,0 +1,68 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package text
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/derat/yambs/mbdb"
+	"github.com/derat/yambs/seed"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRead_ReleaseGroup_All(t *testing.T) {
+	const (
+		artistMBID = "cd72c13c-a74e-4617-af5f-658409a36894"
+		artistID   = 123
+	)
+	const input = `
+mbid=4b52bddc-0587-4bcf-9e05-5c9fca260a21
+title=Album Title
+types=Album,Soundtrack
+disambiguation=Not the same
+artist0_mbid=cd72c13c-a74e-4617-af5f-658409a36894
+artist0_credited=First Artist
+artist0_join= feat. 
+artist1_name=Second Artist
+rel0_target=e8ba1a80-a1a2-43b1-9b2e-0fea3e9f1a05
+rel0_type=15
+url0_url=https://www.example.org/review
+url0_type=94
+edit_note=https://www.example.org/
+`
+	db := mbdb.NewDB(mbdb.DisallowQueries)
+	db.SetDatabaseIDForTest(artistMBID, artistID)
+	got, err := Read(context.Background(),
+		strings.NewReader(strings.TrimLeft(input, "\n")),
+		KeyVal, seed.ReleaseGroupEntity, nil, nil, db)
+	if err != nil {
+		t.Fatal("Read failed:", err)
+	}
+	want := []seed.Edit{
+		&seed.ReleaseGroup{
+			MBID:           "4b52bddc-0587-4bcf-9e05-5c9fca260a21",
+			Title:          "Album Title",
+			Types:          []seed.ReleaseGroupType{seed.ReleaseGroupType_Album, seed.ReleaseGroupType_Soundtrack},
+			Disambiguation: "Not the same",
+			Artists: []seed.ArtistCredit{
+				{ID: artistID, NameAsCredited: "First Artist", JoinPhrase: " feat. "},
+				{Name: "Second Artist"},
+			},
+			Relationships: []seed.Relationship{{
+				Target: "e8ba1a80-a1a2-43b1-9b2e-0fea3e9f1a05",
+				Type:   seed.LinkType_Cover_ReleaseGroup_ReleaseGroup,
+			}},
+			URLs: []seed.URL{{
+				URL:      "https://www.example.org/review",
+				LinkType: seed.LinkType_Review_ReleaseGroup_URL,
+			}},
+			EditNote: "https://www.example.org/",
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error("Read returned wrong edits:\n" + diff)
+	}
+}
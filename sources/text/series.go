@@ -0,0 +1,54 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package text
+
+import (
+	"github.com/derat/yambs/seed"
+)
+
+// seriesFields defines fields that can be set in a seed.Series.
+var seriesFields = map[string]fieldInfo{
+	"disambiguation": {
+		"Comment disambiguating this series from others with similar names",
+		func(s *seed.Series, k, v string) error { return setString(&s.Disambiguation, v) },
+	},
+	"edit_note": {
+		"Note attached to edit",
+		func(s *seed.Series, k, v string) error { return setString(&s.EditNote, v) },
+	},
+	"mbid": {
+		"MBID of existing series to edit (if empty, create series)",
+		func(s *seed.Series, k, v string) error { return setMBID(&s.MBID, v) },
+	},
+	"name": {
+		"Series's name",
+		func(s *seed.Series, k, v string) error { return setString(&s.Name, v) },
+	},
+	"ordering_type": {
+		"Integer [series ordering type](" + seriesOrderURL + ")",
+		func(s *seed.Series, k, v string) error { return setInt((*int)(&s.OrderingType), v) },
+	},
+	"type": {
+		"Integer [series type](" + seriesTypeURL + ")",
+		func(s *seed.Series, k, v string) error { return setInt((*int)(&s.Type), v) },
+	},
+}
+
+func init() {
+	// Add common fields.
+	addRelationshipFields(seriesFields, seed.SeriesEntity,
+		func(fn relFunc) interface{} {
+			return func(s *seed.Series, k, v string) error {
+				return indexedField(&s.Relationships, k, "rel",
+					func(rel *seed.Relationship) error { return fn(rel, k, v) })
+			}
+		})
+	addURLFields(seriesFields, seed.SeriesEntity,
+		func(fn urlFunc) interface{} {
+			return func(s *seed.Series, k, v string) error {
+				return indexedField(&s.URLs, k, "url",
+					func(url *seed.URL) error { return fn(url, v) })
+			}
+		})
+}
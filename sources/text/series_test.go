@@ -0,0 +1,122 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package text
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/derat/yambs/mbdb"
+	"github.com/derat/yambs/seed"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRead_Series_All(t *testing.T) {
+	const (
+		disambig     = "this one"
+		editNote     = "here's my edit"
+		mbid         = "0096a0bf-804e-4e47-bf2a-e0878dbb3eb7"
+		name         = "The Series"
+		orderingType = seed.SeriesOrderingType_Automatic
+		relTarget    = "7f4c5b9e-0d0e-4ae5-9b0a-fb6f6c4a0e7a"
+		relType      = seed.LinkType_PartOf_Release_Series
+		relAttrText  = "2"
+		relAttrType  = seed.LinkAttributeType_Number
+		seriesType   = seed.SeriesType_ReleaseSeries
+		url          = "https://www.example.org/foo"
+	)
+
+	input := strings.Join([]string{
+		disambig,
+		editNote,
+		mbid,
+		name,
+		strconv.Itoa(int(orderingType)),
+		relTarget,
+		strconv.Itoa(int(relType)),
+		"1",
+		relAttrText,
+		strconv.Itoa(int(relAttrType)),
+		strconv.Itoa(int(seriesType)),
+		url,
+	}, "\t")
+	got, err := Read(context.Background(), bytes.NewBufferString(input), TSV, seed.SeriesEntity, []string{
+		"disambiguation",
+		"edit_note",
+		"mbid",
+		"name",
+		"ordering_type",
+		"rel0_target",
+		"rel0_type",
+		"rel0_backward",
+		"rel0_attr0_text",
+		"rel0_attr0_type",
+		"type",
+		"url0_url",
+	}, nil, mbdb.NewDB(mbdb.DisallowQueries))
+	if err != nil {
+		t.Fatal("Read failed:", err)
+	}
+
+	want := []seed.Edit{
+		&seed.Series{
+			Disambiguation: disambig,
+			EditNote:       editNote,
+			MBID:           mbid,
+			Name:           name,
+			OrderingType:   orderingType,
+			Relationships: []seed.Relationship{{
+				Target: relTarget,
+				Type:   relType,
+				Attributes: []seed.RelationshipAttribute{{
+					TextValue: relAttrText,
+					Type:      relAttrType,
+				}},
+				Backward: true,
+			}},
+			Type: seriesType,
+			URLs: []seed.URL{{URL: url}},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error("Read returned wrong edits:\n" + diff)
+	}
+}
+
+func TestRead_Series_PartOf(t *testing.T) {
+	const (
+		name    = "The Series"
+		relMBID = "7f4c5b9e-0d0e-4ae5-9b0a-fb6f6c4a0e7a"
+		url     = "https://www.wikidata.org/wiki/Q42"
+		urlType = 9001 // integer types are passed through
+	)
+	fields := []string{"name", "rel0_target", "rel0_type", "rel0_backward", "rel0_attr0_type", "rel0_attr0_text",
+		"url0_url", "url0_type"}
+	vals := []string{name, relMBID, "release:part of", "1", "number", "3", url, strconv.Itoa(urlType)}
+	want := &seed.Series{
+		Name: name,
+		Relationships: []seed.Relationship{{
+			Target:   relMBID,
+			Type:     seed.LinkType_PartOf_Release_Series,
+			Backward: true,
+			Attributes: []seed.RelationshipAttribute{{
+				Type:      seed.LinkAttributeType_Number,
+				TextValue: "3",
+			}},
+		}},
+		URLs: []seed.URL{{URL: url, LinkType: urlType}},
+	}
+
+	got, err := Read(context.Background(), strings.NewReader(strings.Join(vals, "\t")),
+		TSV, seed.SeriesEntity, fields, nil, mbdb.NewDB(mbdb.DisallowQueries))
+	if err != nil {
+		t.Fatal("Read failed:", err)
+	}
+	if diff := cmp.Diff([]seed.Edit{want}, got); diff != "" {
+		t.Error("Read returned wrong edits:\n" + diff)
+	}
+}
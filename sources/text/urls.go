@@ -14,7 +14,10 @@ const (
 	linkAttrTypeURL = godocURL + "#LinkAttributeType"
 	linkTypeURL     = godocURL + "#LinkType"
 	packagingURL    = godocURL + "#ReleasePackaging"
+	placeTypeURL    = godocURL + "#PlaceType"
 	rgTypeURL       = godocURL + "#ReleaseGroupType"
+	seriesOrderURL  = godocURL + "#SeriesOrderingType"
+	seriesTypeURL   = godocURL + "#SeriesType"
 	statusURL       = godocURL + "#ReleaseStatus"
 	workAttrTypeURL = godocURL + "#WorkAttributeType"
 	workTypeURL     = godocURL + "#WorkType"
@@ -57,6 +57,14 @@ var workFields = map[string]fieldInfo{
 		"Work's name",
 		func(w *seed.Work, k, v string) error { return setString(&w.Name, v) },
 	},
+	"rating": {
+		"Rating between 1 and 5 to give to existing work via API (requires mbid and -action=submit)",
+		func(w *seed.Work, k, v string) error { return setInt(&w.Rating, v) },
+	},
+	"tags": {
+		"Comma-separated tags to add to existing work via API (requires mbid and -action=submit)",
+		func(w *seed.Work, k, v string) error { return setStringSlice(&w.Tags, v, ",") },
+	},
 	"type": {
 		"Integer [work type](" + workTypeURL + ")",
 		func(w *seed.Work, k, v string) error { return setInt((*int)(&w.Type), v) },
@@ -65,14 +73,14 @@ var workFields = map[string]fieldInfo{
 
 func init() {
 	// Add common fields.
-	addRelationshipFields(workFields,
+	addRelationshipFields(workFields, seed.WorkEntity,
 		func(fn relFunc) interface{} {
 			return func(w *seed.Work, k, v string) error {
 				return indexedField(&w.Relationships, k, "rel",
 					func(rel *seed.Relationship) error { return fn(rel, k, v) })
 			}
 		})
-	addURLFields(workFields,
+	addURLFields(workFields, seed.WorkEntity,
 		func(fn urlFunc) interface{} {
 			return func(w *seed.Work, k, v string) error {
 				return indexedField(&w.URLs, k, "url",
@@ -0,0 +1,393 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package text
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derat/yambs/seed"
+)
+
+// Write writes edits to w in the specified format so they can later be passed to Read.
+// For the CSV and TSV formats, each edit is written as a row and the returned slice
+// contains the field associated with each column. All edits must be of the same type,
+// and SkipEmptyValues must be passed to Read.
+// The KeyVal format only supports a single edit.
+func Write(w io.Writer, edits []seed.Edit, format Format) (fields []string, err error) {
+	if len(edits) == 0 {
+		return nil, errors.New("no edits")
+	}
+	rows := make([]map[string]string, len(edits))
+	seen := make(map[string]struct{})
+	for i, ed := range edits {
+		if ed.Entity() != edits[0].Entity() {
+			return nil, fmt.Errorf("can't mix %v and %v edits", edits[0].Entity(), ed.Entity())
+		}
+		pairs, err := Marshal(ed)
+		if err != nil {
+			return nil, err
+		}
+		rows[i] = make(map[string]string, len(pairs))
+		for _, p := range pairs {
+			// Columns are ordered by their first appearance, which ensures that indexed fields
+			// (e.g. "artist0_name" and "artist1_name") are always set in order by Read.
+			if _, ok := seen[p[0]]; !ok {
+				fields = append(fields, p[0])
+				seen[p[0]] = struct{}{}
+			}
+			rows[i][p[0]] = p[1]
+		}
+	}
+
+	switch format {
+	case CSV:
+		cw := csv.NewWriter(w)
+		for _, row := range rows {
+			if err := cw.Write(makeRow(row, fields)); err != nil {
+				return nil, err
+			}
+		}
+		cw.Flush()
+		return fields, cw.Error()
+	case KeyVal:
+		if len(edits) != 1 {
+			return nil, errors.New("keyval format only supports a single edit")
+		}
+		for _, f := range fields {
+			v := rows[0][f]
+			if strings.ContainsAny(v, "\r\n") {
+				return nil, fmt.Errorf("%v value contains newline", f)
+			}
+			if _, err := fmt.Fprintf(w, "%s=%s\n", f, v); err != nil {
+				return nil, err
+			}
+		}
+		return fields, nil
+	case TSV:
+		for _, row := range rows {
+			cols := makeRow(row, fields)
+			for i, v := range cols {
+				if strings.ContainsAny(v, "\t\r\n") {
+					return nil, fmt.Errorf("%v value contains tab or newline", fields[i])
+				}
+			}
+			if _, err := io.WriteString(w, strings.Join(cols, "\t")+"\n"); err != nil {
+				return nil, err
+			}
+		}
+		return fields, nil
+	default:
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+}
+
+// makeRow returns the values from row corresponding to fields.
+// Missing values are returned as empty strings, which are ignored by Read if
+// SkipEmptyValues is passed.
+func makeRow(row map[string]string, fields []string) []string {
+	cols := make([]string, len(fields))
+	for i, f := range fields {
+		cols[i] = row[f]
+	}
+	return cols
+}
+
+// Marshal returns "field=value" pairs that can be passed to SetField to recreate edit.
+// Only non-empty fields are included.
+func Marshal(edit seed.Edit) ([][2]string, error) {
+	var pw pairWriter
+	switch ed := edit.(type) {
+	case *seed.Artist:
+		pw.add("mbid", ed.MBID)
+		pw.add("name", ed.Name)
+		pw.add("sort_name", ed.SortName)
+		pw.add("disambiguation", ed.Disambiguation)
+		pw.addInt("type", int(ed.Type))
+		pw.addInt("gender", int(ed.Gender))
+		pw.add("area_name", ed.AreaName)
+		pw.add("ipi_codes", strings.Join(ed.IPICodes, ","))
+		pw.add("isni_codes", strings.Join(ed.ISNICodes, ","))
+		pw.addDate("begin_date", ed.BeginDate)
+		pw.add("begin_area_name", ed.BeginAreaName)
+		pw.addDate("end_date", ed.EndDate)
+		pw.addBool("ended", ed.Ended)
+		pw.add("end_area_name", ed.EndAreaName)
+		pw.addRelationships(ed.Relationships)
+		pw.addURLs(ed.URLs)
+		pw.add("tags", strings.Join(ed.Tags, ","))
+		pw.addInt("rating", ed.Rating)
+		pw.add("edit_note", ed.EditNote)
+	case *seed.Event:
+		pw.add("mbid", ed.MBID)
+		pw.add("name", ed.Name)
+		pw.add("disambiguation", ed.Disambiguation)
+		pw.addInt("type", int(ed.Type))
+		pw.addBool("cancelled", ed.Cancelled)
+		pw.add("setlist", ed.Setlist)
+		pw.addDate("begin_date", ed.BeginDate)
+		pw.addDate("end_date", ed.EndDate)
+		pw.add("time", ed.Time)
+		pw.addRelationships(ed.Relationships)
+		pw.addURLs(ed.URLs)
+		pw.add("edit_note", ed.EditNote)
+	case *seed.Label:
+		pw.add("mbid", ed.MBID)
+		pw.add("name", ed.Name)
+		pw.add("disambiguation", ed.Disambiguation)
+		pw.addInt("type", int(ed.Type))
+		pw.add("area_name", ed.AreaName)
+		pw.add("label_code", ed.LabelCode)
+		pw.add("ipi_codes", strings.Join(ed.IPICodes, ","))
+		pw.add("isni_codes", strings.Join(ed.ISNICodes, ","))
+		pw.addDate("begin_date", ed.BeginDate)
+		pw.addDate("end_date", ed.EndDate)
+		pw.addBool("ended", ed.Ended)
+		pw.addRelationships(ed.Relationships)
+		pw.addURLs(ed.URLs)
+		pw.add("tags", strings.Join(ed.Tags, ","))
+		pw.addInt("rating", ed.Rating)
+		pw.add("edit_note", ed.EditNote)
+	case *seed.Place:
+		pw.add("mbid", ed.MBID)
+		pw.add("name", ed.Name)
+		pw.add("disambiguation", ed.Disambiguation)
+		pw.addInt("type", int(ed.Type))
+		pw.add("address", ed.Address)
+		pw.add("area_name", ed.AreaName)
+		pw.add("coordinates", ed.Coordinates)
+		pw.addDate("begin_date", ed.BeginDate)
+		pw.addDate("end_date", ed.EndDate)
+		pw.addBool("ended", ed.Ended)
+		pw.addRelationships(ed.Relationships)
+		pw.addURLs(ed.URLs)
+		pw.add("edit_note", ed.EditNote)
+	case *seed.Recording:
+		pw.add("mbid", ed.MBID)
+		pw.add("name", ed.Name)
+		pw.add("artist", ed.Artist)
+		pw.addArtistCredits("", ed.Artists)
+		pw.add("disambiguation", ed.Disambiguation)
+		pw.addDuration("length", ed.Length)
+		pw.addBool("video", ed.Video)
+		pw.add("isrcs", strings.Join(ed.ISRCs, ","))
+		pw.addRelationships(ed.Relationships)
+		pw.addURLs(ed.URLs)
+		pw.add("tags", strings.Join(ed.Tags, ","))
+		pw.addInt("rating", ed.Rating)
+		pw.add("edit_note", ed.EditNote)
+	case *seed.Release:
+		pw.add("mbid", ed.MBID)
+		pw.add("title", ed.Title)
+		pw.add("release_group", ed.ReleaseGroup)
+		var types []string
+		for _, t := range ed.Types {
+			types = append(types, string(t))
+		}
+		pw.add("types", strings.Join(types, ","))
+		pw.add("disambiguation", ed.Disambiguation)
+		pw.add("annotation", ed.Annotation)
+		pw.add("barcode", ed.Barcode)
+		pw.add("language", ed.Language)
+		pw.add("script", ed.Script)
+		pw.add("status", string(ed.Status))
+		pw.add("packaging", string(ed.Packaging))
+		for i, ev := range ed.Events {
+			prefix := fmt.Sprintf("event%d_", i)
+			if ev.Date.Year > 0 {
+				pw.addDate(prefix+"date", ev.Date)
+			} else {
+				pw.addInt(prefix+"month", ev.Date.Month)
+				pw.addInt(prefix+"day", ev.Date.Day)
+			}
+			pw.add(prefix+"country", ev.Country)
+		}
+		for i, lab := range ed.Labels {
+			prefix := fmt.Sprintf("label%d_", i)
+			pw.add(prefix+"mbid", lab.MBID)
+			pw.add(prefix+"catalog", lab.CatalogNumber)
+			pw.add(prefix+"name", lab.Name)
+		}
+		pw.addArtistCredits("", ed.Artists)
+		for i, med := range ed.Mediums {
+			prefix := fmt.Sprintf("medium%d_", i)
+			pw.add(prefix+"format", string(med.Format))
+			pw.add(prefix+"name", med.Name)
+			for j, tr := range med.Tracks {
+				prefix := fmt.Sprintf("medium%d_track%d_", i, j)
+				pw.add(prefix+"title", tr.Title)
+				pw.add(prefix+"number", tr.Number)
+				pw.add(prefix+"recording", tr.Recording)
+				pw.add(prefix+"isrc", tr.ISRC)
+				pw.addDuration(prefix+"length", tr.Length)
+				pw.addArtistCredits(prefix, tr.Artists)
+			}
+		}
+		pw.addURLs(ed.URLs)
+		pw.add("tags", strings.Join(ed.Tags, ","))
+		pw.add("edit_note", ed.EditNote)
+	case *seed.ReleaseGroup:
+		pw.add("mbid", ed.MBID)
+		pw.add("title", ed.Title)
+		var types []string
+		for _, t := range ed.Types {
+			types = append(types, string(t))
+		}
+		pw.add("types", strings.Join(types, ","))
+		pw.add("disambiguation", ed.Disambiguation)
+		pw.addArtistCredits("", ed.Artists)
+		pw.addRelationships(ed.Relationships)
+		pw.addURLs(ed.URLs)
+		pw.add("tags", strings.Join(ed.Tags, ","))
+		pw.addInt("rating", ed.Rating)
+		pw.add("edit_note", ed.EditNote)
+	case *seed.Series:
+		pw.add("mbid", ed.MBID)
+		pw.add("name", ed.Name)
+		pw.add("disambiguation", ed.Disambiguation)
+		pw.addInt("type", int(ed.Type))
+		pw.addInt("ordering_type", int(ed.OrderingType))
+		pw.addRelationships(ed.Relationships)
+		pw.addURLs(ed.URLs)
+		pw.add("edit_note", ed.EditNote)
+	case *seed.Work:
+		pw.add("mbid", ed.MBID)
+		pw.add("name", ed.Name)
+		pw.add("disambiguation", ed.Disambiguation)
+		pw.addInt("type", int(ed.Type))
+		var langs []string
+		for _, lang := range ed.Languages {
+			langs = append(langs, strconv.Itoa(int(lang)))
+		}
+		pw.add("languages", strings.Join(langs, ","))
+		pw.add("iswcs", strings.Join(ed.ISWCs, ","))
+		for i, attr := range ed.Attributes {
+			prefix := fmt.Sprintf("attr%d_", i)
+			pw.addInt(prefix+"type", int(attr.Type))
+			pw.add(prefix+"value", attr.Value)
+		}
+		pw.addRelationships(ed.Relationships)
+		pw.addURLs(ed.URLs)
+		pw.add("tags", strings.Join(ed.Tags, ","))
+		pw.addInt("rating", ed.Rating)
+		pw.add("edit_note", ed.EditNote)
+	default:
+		return nil, fmt.Errorf("unsupported edit type %q", edit.Entity())
+	}
+	return pw.pairs, pw.err
+}
+
+// pairWriter accumulates "field=value" pairs for Marshal.
+type pairWriter struct {
+	pairs [][2]string
+	err   error // first error that was encountered
+}
+
+func (pw *pairWriter) add(field, val string) {
+	if val != "" {
+		pw.pairs = append(pw.pairs, [2]string{field, val})
+	}
+}
+
+func (pw *pairWriter) addInt(field string, val int) {
+	if val != 0 {
+		pw.add(field, strconv.Itoa(val))
+	}
+}
+
+func (pw *pairWriter) addBool(field string, val bool) {
+	if val {
+		pw.add(field, "1")
+	}
+}
+
+func (pw *pairWriter) addDate(field string, d seed.Date) {
+	if d.Year <= 0 {
+		if (d.Month != 0 || d.Day != 0) && pw.err == nil {
+			pw.err = fmt.Errorf("%v lacks year", field)
+		}
+		return
+	}
+	s := fmt.Sprintf("%04d", d.Year)
+	if d.Month > 0 {
+		s += fmt.Sprintf("-%02d", d.Month)
+		if d.Day > 0 {
+			s += fmt.Sprintf("-%02d", d.Day)
+		}
+	}
+	pw.add(field, s)
+}
+
+// addDuration adds d in a format understood by parseDuration.
+// Whole seconds are written as e.g. "3:45" and anything else is written as milliseconds.
+func (pw *pairWriter) addDuration(field string, d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	if d%time.Second != 0 {
+		pw.add(field, strconv.FormatInt(d.Milliseconds(), 10))
+		return
+	}
+	sec := int64(d / time.Second)
+	if sec >= 3600 {
+		pw.add(field, fmt.Sprintf("%d:%02d:%02d", sec/3600, (sec/60)%60, sec%60))
+	} else {
+		pw.add(field, fmt.Sprintf("%d:%02d", sec/60, sec%60))
+	}
+}
+
+func (pw *pairWriter) addArtistCredits(prefix string, acs []seed.ArtistCredit) {
+	for i, ac := range acs {
+		p := fmt.Sprintf("%sartist%d_", prefix, i)
+		if ac.ID != 0 && ac.MBID == "" && pw.err == nil {
+			// Database IDs (as set by seed.Recording.Finish) can't be supplied via text fields.
+			pw.err = fmt.Errorf("%vmbid unavailable for artist with database ID %d", p, ac.ID)
+		}
+		pw.add(p+"mbid", ac.MBID)
+		pw.add(p+"name", ac.Name)
+		pw.add(p+"credited", ac.NameAsCredited)
+		pw.add(p+"join", ac.JoinPhrase)
+	}
+}
+
+func (pw *pairWriter) addRelationships(rels []seed.Relationship) {
+	for i, rel := range rels {
+		p := fmt.Sprintf("rel%d_", i)
+		pw.add(p+"target", rel.Target)
+		if rel.Type != 0 {
+			pw.addInt(p+"type", int(rel.Type))
+		} else {
+			pw.add(p+"type", rel.TypeUUID)
+		}
+		pw.add(p+"source_credit", rel.SourceCredit)
+		pw.add(p+"target_credit", rel.TargetCredit)
+		for j, attr := range rel.Attributes {
+			ap := fmt.Sprintf("%sattr%d_", p, j)
+			if attr.Type != 0 {
+				pw.addInt(ap+"type", int(attr.Type))
+			} else {
+				pw.add(ap+"type", attr.TypeUUID)
+			}
+			pw.add(ap+"credited", attr.CreditedAs)
+			pw.add(ap+"text", attr.TextValue)
+		}
+		pw.addDate(p+"begin_date", rel.BeginDate)
+		pw.addDate(p+"end_date", rel.EndDate)
+		pw.addBool(p+"ended", rel.Ended)
+		pw.addBool(p+"backward", rel.Backward)
+	}
+}
+
+func (pw *pairWriter) addURLs(urls []seed.URL) {
+	for i, u := range urls {
+		p := fmt.Sprintf("url%d_", i)
+		pw.add(p+"url", u.URL)
+		pw.addInt(p+"type", int(u.LinkType))
+	}
+}
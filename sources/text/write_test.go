@@ -0,0 +1,236 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package text
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/derat/yambs/mbdb"
+	"github.com/derat/yambs/seed"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWrite_RoundTrip(t *testing.T) {
+	const (
+		mbid1 = "0096a0bf-804e-4e47-bf2a-e0878dbb3eb7"
+		mbid2 = "65389277-491a-4055-8e71-0a9be1c9c99c"
+		mbid3 = "b92d909c-243d-4146-bfd5-2703c9dd1c99"
+	)
+
+	rels := []seed.Relationship{{
+		Target:       mbid2,
+		Type:         seed.LinkType_Arranger_Artist_Release,
+		SourceCredit: "Source",
+		TargetCredit: "Target",
+		Attributes: []seed.RelationshipAttribute{
+			{Type: seed.LinkAttributeType_Number, TextValue: "4"},
+			{TypeUUID: mbid3, CreditedAs: "Credit"},
+		},
+		BeginDate: seed.MakeDate(2001, 4, 0),
+		EndDate:   seed.MakeDate(2002, 0, 0),
+		Ended:     true,
+		Backward:  true,
+	}}
+	urls := []seed.URL{
+		{URL: "https://www.example.org/a", LinkType: seed.LinkType_Bandcamp_Artist_URL},
+		{URL: "https://www.example.org/b"},
+	}
+
+	for _, tc := range []struct {
+		edits  []seed.Edit
+		format Format
+	}{
+		{[]seed.Edit{&seed.Artist{
+			MBID:           mbid1,
+			Name:           "Artist",
+			SortName:       "Artist, The",
+			Disambiguation: "Comment",
+			Type:           seed.ArtistType_Person,
+			Gender:         seed.Gender_Female,
+			AreaName:       "Area",
+			IPICodes:       []string{"123456789", "987654321"},
+			ISNICodes:      []string{"000000012146438X"},
+			BeginDate:      seed.MakeDate(1956, 4, 5),
+			BeginAreaName:  "Begin",
+			EndDate:        seed.MakeDate(2018, 12, 31),
+			Ended:          true,
+			EndAreaName:    "End",
+			Relationships:  rels,
+			URLs:           urls,
+			Tags:           []string{"rock", "pop"},
+			Rating:         4,
+			EditNote:       "Note",
+		}}, KeyVal},
+		{[]seed.Edit{&seed.Event{
+			MBID:           mbid1,
+			Name:           "Event",
+			Disambiguation: "Comment",
+			Type:           seed.EventType_Concert,
+			Cancelled:      true,
+			Setlist:        "* Song",
+			BeginDate:      seed.MakeDate(2020, 1, 2),
+			EndDate:        seed.MakeDate(2020, 1, 3),
+			Time:           "19:30",
+			Relationships:  rels,
+			URLs:           urls,
+		}}, KeyVal},
+		{[]seed.Edit{
+			&seed.Label{
+				MBID:      mbid1,
+				Name:      "Label",
+				Type:      seed.LabelType_Imprint,
+				AreaName:  "Area",
+				LabelCode: "1234",
+				IPICodes:  []string{"123456789"},
+				BeginDate: seed.MakeDate(1990, 0, 0),
+				Ended:     true,
+			},
+			&seed.Label{Name: "Other Label", URLs: urls},
+		}, TSV},
+		{[]seed.Edit{
+			&seed.Recording{
+				MBID:           mbid1,
+				Name:           "Recording",
+				Artist:         mbid3,
+				Artists:        []seed.ArtistCredit{{Name: "A", JoinPhrase: " & "}, {Name: "B"}},
+				Disambiguation: "Comment",
+				Length:         3*time.Minute + 45*time.Second,
+				Video:          true,
+				ISRCs:          []string{"UKAAA0500001", "USBBB0400002"},
+				Relationships:  rels,
+				URLs:           urls,
+				EditNote:       "Line 1\nLine 2",
+			},
+			&seed.Recording{
+				Name:   "Long, \"quoted\" name",
+				Length: time.Hour + 2*time.Minute + 3*time.Second + 456*time.Millisecond,
+			},
+		}, CSV},
+		{[]seed.Edit{&seed.Release{
+			MBID:           mbid1,
+			Title:          "Release",
+			ReleaseGroup:   mbid2,
+			Types:          []seed.ReleaseGroupType{seed.ReleaseGroupType_Album, seed.ReleaseGroupType_Live},
+			Disambiguation: "Comment",
+			Annotation:     "Annotation",
+			Barcode:        "none",
+			Language:       "eng",
+			Script:         "Latn",
+			Status:         seed.ReleaseStatus_Official,
+			Packaging:      seed.ReleasePackaging_JewelCase,
+			Events: []seed.ReleaseEvent{
+				{Date: seed.MakeDate(2021, 5, 3), Country: "GB"},
+				{Date: seed.MakeDate(0, 6, 7), Country: "XW"},
+			},
+			Labels: []seed.ReleaseLabel{{MBID: mbid2, CatalogNumber: "CAT-1", Name: "Label"}},
+			Artists: []seed.ArtistCredit{
+				{MBID: mbid2, Name: "Artist", NameAsCredited: "Credit", JoinPhrase: " feat. "},
+				{Name: "Other"},
+			},
+			Mediums: []seed.Medium{
+				{Format: seed.MediumFormat_CD, Name: "Disc 1", Tracks: []seed.Track{
+					{Title: "One", Number: "A1", Recording: mbid3, Length: 4 * time.Minute},
+					{Title: "Two", Artists: []seed.ArtistCredit{{MBID: mbid2}}},
+				}},
+				{Tracks: []seed.Track{{Title: "Three", Length: 1234 * time.Millisecond}}},
+			},
+			URLs:     urls,
+			Tags:     []string{"live"},
+			EditNote: "Note",
+		}}, KeyVal},
+		{[]seed.Edit{
+			&seed.Place{
+				MBID:           mbid1,
+				Name:           "Place",
+				Disambiguation: "Comment",
+				Type:           seed.PlaceType_Venue,
+				Address:        "123 Main Street",
+				AreaName:       "Area",
+				Coordinates:    "39.7817, -89.6501",
+				BeginDate:      seed.MakeDate(1998, 4, 0),
+				EndDate:        seed.MakeDate(2015, 0, 0),
+				Ended:          true,
+				Relationships:  rels,
+				URLs:           urls,
+				EditNote:       "Note",
+			},
+			&seed.Place{Name: "Another Place", Type: seed.PlaceType_Studio},
+		}, TSV},
+		{[]seed.Edit{&seed.ReleaseGroup{
+			MBID:           mbid1,
+			Title:          "Release Group",
+			Types:          []seed.ReleaseGroupType{seed.ReleaseGroupType_Album, seed.ReleaseGroupType_Live},
+			Disambiguation: "Comment",
+			Artists: []seed.ArtistCredit{
+				{Name: "Artist", NameAsCredited: "Credited", JoinPhrase: " & "},
+				{Name: "Other"},
+			},
+			Relationships: rels,
+			URLs:          urls,
+			Rating:        2,
+			EditNote:      "Note",
+		}}, KeyVal},
+		{[]seed.Edit{&seed.Series{
+			MBID:           mbid1,
+			Name:           "Series",
+			Disambiguation: "Comment",
+			Type:           seed.SeriesType_ReleaseSeries,
+			OrderingType:   seed.SeriesOrderingType_Automatic,
+			Relationships:  rels,
+			URLs:           urls,
+			EditNote:       "Note",
+		}}, CSV},
+		{[]seed.Edit{&seed.Work{
+			MBID:           mbid1,
+			Name:           "Work",
+			Disambiguation: "Comment",
+			Type:           seed.WorkType_Song,
+			Languages:      []seed.Language{seed.Language_English, seed.Language_German},
+			ISWCs:          []string{"T-345.246.800-1"},
+			Attributes:     []seed.WorkAttribute{{Type: seed.WorkAttributeType_ACAM_ID, Value: "123"}},
+			Relationships:  rels,
+			URLs:           urls,
+		}}, TSV},
+	} {
+		typ := tc.edits[0].Entity()
+		var b bytes.Buffer
+		fields, err := Write(&b, tc.edits, tc.format)
+		if err != nil {
+			t.Errorf("Write(%v, %v) failed: %v", typ, tc.format, err)
+			continue
+		}
+		got, err := Read(context.Background(), &b, tc.format, typ, fields, nil,
+			mbdb.NewDB(mbdb.DisallowQueries), SkipEmptyValues())
+		if err != nil {
+			t.Errorf("Read(%v, %v) failed: %v", typ, tc.format, err)
+			continue
+		}
+		if diff := cmp.Diff(tc.edits, got); diff != "" {
+			t.Errorf("%v edits changed after %v round trip:\n%s", typ, tc.format, diff)
+		}
+	}
+}
+
+func TestWrite_Errors(t *testing.T) {
+	for _, tc := range []struct {
+		edits  []seed.Edit
+		format Format
+	}{
+		{nil, TSV},
+		{[]seed.Edit{&seed.Artist{Name: "A"}, &seed.Label{Name: "B"}}, TSV},
+		{[]seed.Edit{&seed.Artist{Name: "A"}, &seed.Artist{Name: "B"}}, KeyVal},
+		{[]seed.Edit{&seed.Artist{Name: "A", EditNote: "1\n2"}}, KeyVal},
+		{[]seed.Edit{&seed.Artist{Name: "A\tB"}}, TSV},
+		{[]seed.Edit{&seed.Artist{BeginDate: seed.MakeDate(0, 5, 1)}}, TSV},
+		{[]seed.Edit{&seed.Recording{Artists: []seed.ArtistCredit{{ID: 123}}}}, TSV},
+	} {
+		var b bytes.Buffer
+		if _, err := Write(&b, tc.edits, tc.format); err == nil {
+			t.Errorf("Write(%v, %v) unexpectedly succeeded", tc.edits, tc.format)
+		}
+	}
+}
@@ -0,0 +1,291 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package web
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	defaultMaxRetries    = 4
+	defaultInitialDelay  = time.Second
+	defaultMaxDelay      = 30 * time.Second
+	defaultMaxRetryAfter = 2 * time.Minute
+)
+
+// Client sends HTTP requests. Transient failures (e.g. 503 responses sent by servers
+// that are throttling clients) are retried with exponential backoff, and requests
+// can be rate-limited on a per-host basis. It is safe for concurrent use.
+type Client struct {
+	client         *http.Client
+	maxRetries     int           // maximum number of retries after the initial attempt
+	initialDelay   time.Duration // delay before first retry
+	maxDelay       time.Duration // maximum delay computed via backoff
+	maxRetryAfter  time.Duration // maximum honored "Retry-After" delay
+	attemptTimeout time.Duration // timeout for each attempt; 0 for none
+	userAgent      string        // value for "User-Agent" header
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter // keyed by host (possibly including port)
+}
+
+// NewClient returns a new Client configured by opts.
+func NewClient(opts ...ClientOption) *Client {
+	c := Client{
+		client:        http.DefaultClient,
+		maxRetries:    defaultMaxRetries,
+		initialDelay:  defaultInitialDelay,
+		maxDelay:      defaultMaxDelay,
+		maxRetryAfter: defaultMaxRetryAfter,
+		limiters:      make(map[string]*rate.Limiter),
+	}
+	for _, o := range opts {
+		o(&c)
+	}
+	return &c
+}
+
+// ClientOption can be passed to NewClient to configure the client.
+type ClientOption func(c *Client)
+
+// HTTPClient returns a ClientOption that configures Client to send requests using hc
+// instead of http.DefaultClient.
+func HTTPClient(hc *http.Client) ClientOption { return func(c *Client) { c.client = hc } }
+
+// Transport returns a ClientOption that configures Client to send requests using rt.
+// Other settings from the http.Client (see HTTPClient) are preserved.
+// If rt is nil, http.DefaultTransport is used.
+func Transport(rt http.RoundTripper) ClientOption {
+	return func(c *Client) {
+		hc := *c.client
+		hc.Transport = rt
+		c.client = &hc
+	}
+}
+
+// MaxRetries returns a ClientOption that sets the maximum number of times that a failed
+// request will be retried.
+func MaxRetries(n int) ClientOption { return func(c *Client) { c.maxRetries = n } }
+
+// Backoff returns a ClientOption that configures the delay before the first retry.
+// The delay is doubled for each successive retry, up to max.
+func Backoff(initial, max time.Duration) ClientOption {
+	return func(c *Client) {
+		c.initialDelay = initial
+		c.maxDelay = max
+	}
+}
+
+// MaxRetryAfter returns a ClientOption that sets the longest delay requested via a
+// "Retry-After" header that will be honored. If a server asks for a longer delay,
+// its response is returned to the caller instead of being retried.
+func MaxRetryAfter(d time.Duration) ClientOption { return func(c *Client) { c.maxRetryAfter = d } }
+
+// AttemptTimeout returns a ClientOption that sets a timeout for each individual attempt,
+// including reading the response body. Attempts that time out are retried.
+func AttemptTimeout(d time.Duration) ClientOption { return func(c *Client) { c.attemptTimeout = d } }
+
+// UserAgent returns a ClientOption that sets the "User-Agent" header for requests that
+// don't already have one.
+func UserAgent(ua string) ClientOption { return func(c *Client) { c.userAgent = ua } }
+
+// HostRateLimit returns a ClientOption that limits requests to host (e.g. "musicbrainz.org"
+// or "127.0.0.1:8080") to qps, with the supplied burst size. Retries are also limited.
+// Requests to other hosts are not rate-limited.
+func HostRateLimit(host string, qps rate.Limit, burst int) ClientOption {
+	return func(c *Client) { c.limiters[host] = rate.NewLimiter(qps, burst) }
+}
+
+// SetHostRateLimit updates or adds a rate limit for host. See HostRateLimit.
+func (c *Client) SetHostRateLimit(host string, qps rate.Limit, burst int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if lim, ok := c.limiters[host]; ok {
+		lim.SetLimit(qps)
+		lim.SetBurst(burst)
+	} else {
+		c.limiters[host] = rate.NewLimiter(qps, burst)
+	}
+}
+
+// limiter returns the rate limiter for host, or nil if requests to host aren't limited.
+func (c *Client) limiter(host string) *rate.Limiter {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.limiters[host]
+}
+
+// Get sends a GET request for url. See Do.
+func (c *Client) Get(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.Do(req)
+}
+
+// Do sends req and returns its response, retrying transient failures.
+//
+// 429 and 503 responses are always retried, while network errors and other 5xx responses
+// are only retried for idempotent methods. Requests with bodies are only retried if
+// req.GetBody is set (as it is by http.NewRequest for common body types). If the final
+// attempt receives an error status, the response is returned with a nil error, just as
+// with http.Client. Waits are abandoned if req's context is cancelled.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	canRetry := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+	lim := c.limiter(req.URL.Host)
+
+	for attempt := 0; ; attempt++ {
+		if lim != nil {
+			if err := lim.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+		res, err := c.doAttempt(ctx, req, attempt)
+		if !canRetry || attempt >= c.maxRetries || ctx.Err() != nil || !shouldRetry(req, res, err) {
+			return res, err
+		}
+
+		delay := c.backoff(attempt)
+		var reason string
+		if err != nil {
+			reason = err.Error()
+		} else {
+			reason = res.Status
+			if ra, ok := parseRetryAfter(res.Header.Get("Retry-After"), time.Now()); ok {
+				if ra > c.maxRetryAfter {
+					log.Printf("Not retrying %v; server asked to wait %v", req.URL, ra)
+					return res, nil
+				}
+				delay = ra
+			}
+			// Drain the body so the connection can be reused.
+			io.Copy(io.Discard, io.LimitReader(res.Body, 64*1024))
+			res.Body.Close()
+		}
+		log.Printf("Retrying %v in %v after %v", req.URL, delay, reason)
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// doAttempt sends a single copy of req.
+func (c *Client) doAttempt(ctx context.Context, req *http.Request, attempt int) (*http.Response, error) {
+	cancel := func() {}
+	if c.attemptTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, c.attemptTimeout)
+	}
+	r := req.Clone(ctx)
+	if attempt > 0 && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		r.Body = body
+	}
+	if c.userAgent != "" && r.Header.Get("User-Agent") == "" {
+		r.Header.Set("User-Agent", c.userAgent)
+	}
+	res, err := c.client.Do(r)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	res.Body = &cancelBody{res.Body, cancel}
+	return res, nil
+}
+
+// backoff returns the delay to use before the retry following attempt.
+func (c *Client) backoff(attempt int) time.Duration {
+	d := c.initialDelay
+	for i := 0; i < attempt && d < c.maxDelay; i++ {
+		d *= 2
+	}
+	if d > c.maxDelay {
+		d = c.maxDelay
+	}
+	return d
+}
+
+// shouldRetry returns true if the result of sending req should be retried.
+func shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	idempotent := false
+	switch req.Method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		idempotent = true
+	}
+	if err != nil {
+		return idempotent && !errors.Is(err, context.Canceled)
+	}
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout:
+		return idempotent
+	default:
+		return false
+	}
+}
+
+// parseRetryAfter parses the value of a "Retry-After" header, which may contain
+// either a number of seconds or an HTTP date. now is used to compute the delay for dates.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// cancelBody wraps a response body and cancels the attempt's context when it's closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
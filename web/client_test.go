@@ -0,0 +1,177 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package web
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClient_Do(t *testing.T) {
+	for _, tc := range []struct {
+		method   string
+		statuses []int // statuses returned by server for successive requests
+		header   string
+		retries  int
+		want     int // final status
+		reqs     int // expected number of requests
+	}{
+		{"GET", []int{200}, "", 3, 200, 1},
+		{"GET", []int{503, 200}, "", 3, 200, 2},
+		{"GET", []int{503, 429, 502, 200}, "", 3, 200, 4},
+		{"GET", []int{503, 503, 503}, "", 2, 503, 3},
+		{"GET", []int{404, 200}, "", 3, 404, 1},
+		{"GET", []int{503, 200}, "0", 3, 200, 2},
+		{"GET", []int{503, 200}, "3600", 3, 503, 1}, // Retry-After exceeds max
+		{"POST", []int{503, 200}, "", 3, 200, 2},
+		{"POST", []int{500, 200}, "", 3, 500, 1}, // non-idempotent
+	} {
+		var mu sync.Mutex
+		var reqs int
+		var bodies []string
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			b, _ := io.ReadAll(r.Body)
+			bodies = append(bodies, string(b))
+			st := tc.statuses[len(tc.statuses)-1]
+			if reqs < len(tc.statuses) {
+				st = tc.statuses[reqs]
+			}
+			reqs++
+			if tc.header != "" {
+				w.Header().Set("Retry-After", tc.header)
+			}
+			w.WriteHeader(st)
+		}))
+
+		c := NewClient(MaxRetries(tc.retries), Backoff(time.Millisecond, 4*time.Millisecond),
+			MaxRetryAfter(time.Minute))
+		var body io.Reader
+		if tc.method == "POST" {
+			body = strings.NewReader("data")
+		}
+		req, err := http.NewRequest(tc.method, srv.URL, body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res, err := c.Do(req); err != nil {
+			t.Errorf("%v %v failed: %v", tc.method, tc.statuses, err)
+		} else {
+			res.Body.Close()
+			if res.StatusCode != tc.want {
+				t.Errorf("%v %v returned %v; want %v", tc.method, tc.statuses, res.StatusCode, tc.want)
+			}
+		}
+		srv.Close()
+
+		if reqs != tc.reqs {
+			t.Errorf("%v %v sent %d request(s); want %d", tc.method, tc.statuses, reqs, tc.reqs)
+		}
+		if tc.method == "POST" {
+			for i, b := range bodies {
+				if b != "data" {
+					t.Errorf("%v %v request %d had body %q; want %q", tc.method, tc.statuses, i, b, "data")
+				}
+			}
+		}
+	}
+}
+
+func TestClient_Do_Cancel(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	// The context should be checked while waiting to retry.
+	c := NewClient(Backoff(time.Hour, time.Hour))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := c.Get(ctx, srv.URL); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Get returned %v; want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestClient_Do_AttemptTimeout(t *testing.T) {
+	var mu sync.Mutex
+	var reqs int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		reqs++
+		n := reqs
+		mu.Unlock()
+		if n == 1 {
+			<-r.Context().Done() // hang until the client gives up
+			return
+		}
+		io.WriteString(w, "ok")
+	}))
+	defer srv.Close()
+
+	c := NewClient(AttemptTimeout(50*time.Millisecond), Backoff(time.Millisecond, time.Millisecond))
+	res, err := c.Get(context.Background(), srv.URL)
+	if err != nil {
+		t.Fatal("Get failed: ", err)
+	}
+	defer res.Body.Close()
+	if b, err := io.ReadAll(res.Body); err != nil {
+		t.Error("Reading body failed: ", err)
+	} else if string(b) != "ok" {
+		t.Errorf("Got body %q; want %q", b, "ok")
+	}
+}
+
+func TestClient_HostRateLimit(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	const (
+		qps  = 20
+		reqs = 4
+	)
+	host := strings.TrimPrefix(srv.URL, "http://")
+	c := NewClient(HostRateLimit(host, qps, 1))
+	start := time.Now()
+	for i := 0; i < reqs; i++ {
+		res, err := c.Get(context.Background(), srv.URL)
+		if err != nil {
+			t.Fatal("Get failed: ", err)
+		}
+		res.Body.Close()
+	}
+	// The first request shouldn't wait.
+	if elapsed, min := time.Since(start), (reqs-1)*time.Second/qps; elapsed < min {
+		t.Errorf("%d requests took %v; want at least %v", reqs, elapsed, min)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2023, 4, 10, 12, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		val  string
+		want time.Duration
+		ok   bool
+	}{
+		{"", 0, false},
+		{"0", 0, true},
+		{"120", 2 * time.Minute, true},
+		{" 5 ", 5 * time.Second, true},
+		{"-1", 0, false},
+		{"Mon, 10 Apr 2023 12:00:30 GMT", 30 * time.Second, true},
+		{"Mon, 10 Apr 2023 11:00:00 GMT", 0, true},
+		{"soon", 0, false},
+	} {
+		if got, ok := parseRetryAfter(tc.val, now); got != tc.want || ok != tc.ok {
+			t.Errorf("parseRetryAfter(%q) = %v, %v; want %v, %v", tc.val, got, ok, tc.want, tc.ok)
+		}
+	}
+}
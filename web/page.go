@@ -1,7 +1,7 @@
 // Copyright 2022 Daniel Erat.
 // All rights reserved.
 
-// Package web interacts with web pages.
+// Package web interacts with web pages and servers.
 package web
 
 import (
@@ -16,13 +16,37 @@ import (
 	"golang.org/x/net/html"
 )
 
+// pageClient is used by FetchPage.
+var pageClient = NewPageClient()
+
+// NewPageClient returns a new Client that logs redirects and is suitable for
+// passing to FetchPageWithClient. opts are applied after the default configuration,
+// so e.g. Transport can be passed to send requests via a different http.RoundTripper.
+func NewPageClient(opts ...ClientOption) *Client {
+	return NewClient(append([]ClientOption{HTTPClient(&http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			log.Print("Got redirect to ", req.URL)
+			if len(via) >= 10 {
+				return errors.New("stopped after 10 redirects")
+			}
+			return nil
+		},
+	})}, opts...)...)
+}
+
 // Page represents a parsed HTML page.
 type Page struct {
 	Root *html.Node
 }
 
-// FetchPage fetches and parses the HTML page at the supplied URL.
+// FetchPage fetches and parses the HTML page at the supplied URL using a shared Client.
 func FetchPage(ctx context.Context, url string) (*Page, error) {
+	return FetchPageWithClient(ctx, pageClient, url)
+}
+
+// FetchPageWithClient is like FetchPage but sends requests using c,
+// which was typically created by NewPageClient.
+func FetchPageWithClient(ctx context.Context, c *Client, url string) (*Page, error) {
 	log.Print("Fetching ", url)
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
@@ -32,16 +56,7 @@ func FetchPage(ctx context.Context, url string) (*Page, error) {
 		req.Header.Set("User-Agent", userAgent)
 	}
 
-	client := http.Client{
-		CheckRedirect: func(req *http.Request, via []*http.Request) error {
-			log.Print("Got redirect to ", req.URL)
-			if len(via) >= 10 {
-				return errors.New("stopped after 10 redirects")
-			}
-			return nil
-		},
-	}
-	res, err := client.Do(req)
+	res, err := c.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,43 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package web
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestFetchPageWithClient(t *testing.T) {
+	// Pages should be fetched via the supplied client's transport without affecting FetchPage.
+	var urls []string
+	rt := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		urls = append(urls, req.URL.String())
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("<html><body><p>Hello</p></body></html>")),
+			Request:    req,
+		}, nil
+	})
+	const u = "https://www.example.org/page"
+	page, err := FetchPageWithClient(context.Background(), NewPageClient(Transport(rt)), u)
+	if err != nil {
+		t.Fatal("FetchPageWithClient failed: ", err)
+	}
+	if got, err := page.Query("p").Text(false); err != nil || got != "Hello" {
+		t.Errorf("Query returned %q, %v; want %q", got, err, "Hello")
+	}
+	if len(urls) != 1 || urls[0] != u {
+		t.Errorf("Transport got %q; want %q", urls, []string{u})
+	}
+	if pageClient.client.Transport != nil {
+		t.Errorf("Shared page client has transport %v", pageClient.client.Transport)
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
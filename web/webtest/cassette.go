@@ -0,0 +1,176 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+// Package webtest helps tests exercise code that fetches data from the web.
+package webtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+var record = flag.Bool("record", false, "Re-record HTTP fixtures in testdata against live servers")
+
+// Mode describes how a Cassette handles requests.
+type Mode int
+
+const (
+	// Replay serves saved responses from disk without using the network.
+	Replay Mode = iota
+	// Record sends requests to live servers and saves non-transient responses to disk.
+	Record
+)
+
+// DefaultMode returns Record if the -record flag was passed to the test binary
+// (e.g. "go test ./sources/online/bandcamp -record") and Replay otherwise.
+func DefaultMode() Mode {
+	if *record {
+		return Record
+	}
+	return Replay
+}
+
+// NameFunc returns the name of the file within a Cassette's directory that holds the
+// response to req. An empty string is returned if req isn't handled.
+type NameFunc func(req *http.Request) string
+
+// DefaultName is a NameFunc that converts a URL like "https://www.example.org/a/b?c=d"
+// to "www.example.org_a_b_c_d.html". Characters other than letters, digits, dots,
+// and hyphens are replaced by underscores.
+func DefaultName(req *http.Request) string {
+	s := req.URL.Host + req.URL.Path
+	if req.URL.RawQuery != "" {
+		s += "?" + req.URL.RawQuery
+	}
+	return unsafeNameRegexp.ReplaceAllString(s, "_") + ".html"
+}
+
+var unsafeNameRegexp = regexp.MustCompile(`[^-.A-Za-z0-9]`)
+
+// metaSuffix is appended to a response body's filename to get the name of the
+// file holding the response's status code and headers.
+const metaSuffix = ".meta"
+
+// meta contains the parts of a saved response other than its body.
+type meta struct {
+	Status int         `json:"status"`
+	Header http.Header `json:"header,omitempty"`
+}
+
+// Cassette is an http.RoundTripper that stores responses in a directory, typically
+// testdata. Each response's body is saved to a file named by the Cassette's NameFunc,
+// and its status code and headers are saved alongside it in a file with a ".meta" suffix.
+// Bodies without ".meta" files (e.g. ones written by hand) are served with 200 status.
+//
+// In Replay mode, requests for which no file exists receive 404 responses.
+// In Record mode, 429 and 5xx responses are not saved, since they're likely transient.
+// Redirects are saved like other responses and replayed to the http.Client.
+type Cassette struct {
+	dir  string
+	name NameFunc
+	mode Mode
+
+	// Real is used to send requests in Record mode.
+	// If nil, http.DefaultTransport is used.
+	Real http.RoundTripper
+}
+
+// NewCassette returns a new Cassette that stores files in dir using names from name.
+func NewCassette(dir string, name NameFunc, mode Mode) *Cassette {
+	return &Cassette{dir: dir, name: name, mode: mode}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, fmt.Errorf("can't handle %v request for %v", req.Method, req.URL)
+	}
+	fn := c.name(req)
+	if fn == "" {
+		return nil, fmt.Errorf("no fixture for %v", req.URL)
+	}
+	p := filepath.Join(c.dir, fn)
+
+	if c.mode == Record {
+		return c.record(req, p)
+	}
+
+	b, err := os.ReadFile(p)
+	if errors.Is(err, os.ErrNotExist) {
+		return makeResponse(req, meta{Status: http.StatusNotFound}, nil), nil
+	} else if err != nil {
+		return nil, err
+	}
+	m := meta{Status: http.StatusOK}
+	if mb, err := os.ReadFile(p + metaSuffix); err == nil {
+		if err := json.Unmarshal(mb, &m); err != nil {
+			return nil, fmt.Errorf("%v: %v", p+metaSuffix, err)
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	return makeResponse(req, m, b), nil
+}
+
+// record sends req to the real server and writes the response to p and p+metaSuffix.
+func (c *Cassette) record(req *http.Request, p string) (*http.Response, error) {
+	real := c.Real
+	if real == nil {
+		real = http.DefaultTransport
+	}
+	res, err := real.RoundTrip(req)
+	if err != nil || res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500 {
+		return res, err
+	}
+	defer res.Body.Close()
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	m := meta{Status: res.StatusCode, Header: res.Header.Clone()}
+	m.Header.Del("Set-Cookie")
+	mb, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Recording %v to %v", req.URL, p)
+	if err := os.WriteFile(p, b, 0644); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(p+metaSuffix, append(mb, '\n'), 0644); err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(b))
+	res.ContentLength = int64(len(b))
+	return res, nil
+}
+
+func makeResponse(req *http.Request, m meta, body []byte) *http.Response {
+	header := m.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", m.Status, http.StatusText(m.Status)),
+		StatusCode:    m.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
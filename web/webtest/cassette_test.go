@@ -0,0 +1,103 @@
+// Copyright 2023 Daniel Erat.
+// All rights reserved.
+
+package webtest
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCassette(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/missing":
+			http.NotFound(w, r)
+		case "/unavailable":
+			http.Error(w, "try later", http.StatusServiceUnavailable)
+		default:
+			w.Header().Set("X-Test", "value")
+			w.Header().Set("Set-Cookie", "secret=1")
+			io.WriteString(w, "body for "+r.URL.RequestURI())
+		}
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	get := func(c *Cassette, path string) (*http.Response, string) {
+		t.Helper()
+		res, err := (&http.Client{Transport: c}).Get(srv.URL + path)
+		if err != nil {
+			t.Fatalf("GET %v failed: %v", path, err)
+		}
+		defer res.Body.Close()
+		b, err := io.ReadAll(res.Body)
+		if err != nil {
+			t.Fatalf("Reading %v failed: %v", path, err)
+		}
+		return res, string(b)
+	}
+
+	rec := NewCassette(dir, DefaultName, Record)
+	if res, body := get(rec, "/a/b?c=d"); res.StatusCode != http.StatusOK || body != "body for /a/b?c=d" {
+		t.Errorf("Recording /a/b?c=d returned %d %q", res.StatusCode, body)
+	}
+	if res, _ := get(rec, "/missing"); res.StatusCode != http.StatusNotFound {
+		t.Errorf("Recording /missing returned %d; want %d", res.StatusCode, http.StatusNotFound)
+	}
+	if res, _ := get(rec, "/unavailable"); res.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Recording /unavailable returned %d; want %d", res.StatusCode, http.StatusServiceUnavailable)
+	}
+	fn := filepath.Join(dir, DefaultName(httptest.NewRequest("GET", srv.URL+"/a/b?c=d", nil)))
+	if b, err := os.ReadFile(fn); err != nil {
+		t.Error("Failed reading recorded response: ", err)
+	} else if string(b) != "body for /a/b?c=d" {
+		t.Errorf("Recorded %q; want %q", b, "body for /a/b?c=d")
+	}
+	// The 503 response shouldn't have been recorded.
+	if entries, err := os.ReadDir(dir); err != nil {
+		t.Fatal(err)
+	} else if len(entries) != 4 {
+		t.Errorf("Recorded %d file(s); want 4", len(entries))
+	}
+
+	// The server shouldn't be contacted when replaying.
+	srv.Close()
+	rep := NewCassette(dir, DefaultName, Replay)
+	if res, body := get(rep, "/a/b?c=d"); res.StatusCode != http.StatusOK || body != "body for /a/b?c=d" {
+		t.Errorf("Replaying /a/b?c=d returned %d %q", res.StatusCode, body)
+	} else if got := res.Header.Get("X-Test"); got != "value" {
+		t.Errorf("Replaying /a/b?c=d returned X-Test %q; want %q", got, "value")
+	} else if got := res.Header.Get("Set-Cookie"); got != "" {
+		t.Errorf("Replaying /a/b?c=d returned Set-Cookie %q", got)
+	}
+	if res, _ := get(rep, "/missing"); res.StatusCode != http.StatusNotFound {
+		t.Errorf("Replaying /missing returned %d; want %d", res.StatusCode, http.StatusNotFound)
+	}
+	if res, _ := get(rep, "/other"); res.StatusCode != http.StatusNotFound {
+		t.Errorf("Replaying /other returned %d; want %d", res.StatusCode, http.StatusNotFound)
+	}
+
+	// Bodies without metadata should be served with 200 status.
+	if err := os.WriteFile(filepath.Join(dir, "manual.html"), []byte("manual"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	man := NewCassette(dir, func(*http.Request) string { return "manual.html" }, Replay)
+	if res, body := get(man, "/manual"); res.StatusCode != http.StatusOK || body != "manual" {
+		t.Errorf("Replaying /manual returned %d %q", res.StatusCode, body)
+	}
+}
+
+func TestDefaultName(t *testing.T) {
+	const (
+		u    = "https://artist.bandcamp.com/album/some-album?from=search"
+		want = "artist.bandcamp.com_album_some-album_from_search.html"
+	)
+	if got := DefaultName(httptest.NewRequest("GET", u, nil)); got != want {
+		t.Errorf("DefaultName(%q) = %q; want %q", u, got, want)
+	}
+}